@@ -1,26 +1,35 @@
 package main
 
 import (
+	"context"
 	"log"
 	"log/slog"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/caarlos0/env/v10"
 	clerk "github.com/clerk/clerk-sdk-go/v2"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
+	"github.com/leaguefindr/backend/internal/database"
 	"github.com/supabase-community/postgrest-go"
 )
 
 type config struct {
-	SupabaseURL       string `env:"SUPABASE_URL,required"`
-	SupabaseAnonKey   string `env:"SUPABASE_ANON_KEY,required"`
-	SupabaseSecretKey string `env:"SUPABASE_SECRET_KEY,required"`
+	DataStore         string        `env:"DATA_STORE" envDefault:"supabase"` // "supabase", "postgres" or "memory"
+	SupabaseURL       string        `env:"SUPABASE_URL"`                     // Required for the supabase and postgres data stores
+	SupabaseAnonKey   string        `env:"SUPABASE_ANON_KEY"`
+	SupabaseSecretKey string        `env:"SUPABASE_SECRET_KEY"`
+	DatabaseURL       string        `env:"DATABASE_URL"`                     // Postgres connection string, required for the postgres data store
+	DatabaseMaxConns  int32         `env:"DATABASE_MAX_CONNS"`               // Connection pool size, 0 keeps the pgx default
+	IdempotencyTTL    time.Duration `env:"IDEMPOTENCY_TTL" envDefault:"24h"` // How long an Idempotency-Key replays its response
 }
 
 var cfg config
 var postgrestClient *postgrest.Client
 var postgrestServiceClient *postgrest.Client
+var pool *pgxpool.Pool
 
 func init() {
 	var err error
@@ -55,6 +64,24 @@ func init() {
 		panic(err)
 	}
 
+	switch cfg.DataStore {
+	case dataStoreMemory:
+		slog.Warn("DATA_STORE=memory: data is kept in memory and lost when the server stops")
+		return
+	case dataStoreSupabase, dataStorePostgres:
+		if cfg.SupabaseURL == "" || cfg.SupabaseAnonKey == "" || cfg.SupabaseSecretKey == "" {
+			slog.Error("config", "err", "SUPABASE_URL, SUPABASE_ANON_KEY and SUPABASE_SECRET_KEY are required for DATA_STORE="+cfg.DataStore)
+			panic("Supabase environment variables are required")
+		}
+		if cfg.DataStore == dataStorePostgres && cfg.DatabaseURL == "" {
+			slog.Error("config", "err", "DATABASE_URL is required for DATA_STORE=postgres")
+			panic("DATABASE_URL environment variable is required")
+		}
+	default:
+		slog.Error("config", "err", "unknown DATA_STORE", "dataStore", cfg.DataStore)
+		panic("DATA_STORE must be supabase, postgres or memory")
+	}
+
 	// Create PostgREST client with publishable key (for user-facing operations with RLS)
 	// Note: JWT token will be added per-request via context in middleware
 	postgrestClient = postgrest.NewClient(
@@ -75,10 +102,28 @@ func init() {
 	)
 
 	slog.Info("PostgREST clients initialized", "url", cfg.SupabaseURL)
+
+	if cfg.DataStore == dataStorePostgres {
+		pool, err = database.NewPool(context.Background(), cfg.DatabaseURL, cfg.DatabaseMaxConns)
+		if err != nil {
+			slog.Error("database", "err", err)
+			panic(err)
+		}
+		slog.Info("Postgres connection pool initialized", "maxConns", pool.Config().MaxConns)
+	}
 }
 
 func main() {
-	r := newRouter(postgrestClient, postgrestServiceClient)
+	var svc *services
+	switch cfg.DataStore {
+	case dataStoreMemory:
+		svc = newMemoryServices()
+	case dataStorePostgres:
+		svc = newPostgresServices(postgrestClient, postgrestServiceClient, pool)
+	default:
+		svc = newSupabaseServices(postgrestClient, postgrestServiceClient)
+	}
+	r := newRouter(svc)
 
 	slog.Info("Starting server...", "port", "8080")
 	log.Fatal(http.ListenAndServe(":8080", r))
@@ -8,8 +8,8 @@ import (
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
-	"github.com/supabase-community/postgrest-go"
 	"github.com/leaguefindr/backend/internal/auth"
+	"github.com/leaguefindr/backend/internal/idempotency"
 	"github.com/leaguefindr/backend/internal/leagues"
 	"github.com/leaguefindr/backend/internal/notifications"
 	"github.com/leaguefindr/backend/internal/organizations"
@@ -25,7 +25,7 @@ func isAllowedOrigin(db *http.Request, origin string) bool {
 	return slices.Contains(allowedDomains, origin)
 }
 
-func newRouter(postgrestClient *postgrest.Client, postgrestServiceClient *postgrest.Client) *chi.Mux {
+func newRouter(svc *services) *chi.Mux {
 	r := chi.NewRouter()
 
 	var corsOptions cors.Options
@@ -34,8 +34,8 @@ func newRouter(postgrestClient *postgrest.Client, postgrestServiceClient *postgr
 		corsOptions = cors.Options{
 			AllowedOrigins:   []string{"*"},
 			AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Clerk-User-ID"},
-			ExposedHeaders:   []string{"Link"},
+			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Clerk-User-ID", "Idempotency-Key"},
+			ExposedHeaders:   []string{"Link", "Idempotent-Replayed"},
 			AllowCredentials: true,
 			MaxAge:           300, // Maximum value not ignored by any of major browsers
 		}
@@ -43,8 +43,8 @@ func newRouter(postgrestClient *postgrest.Client, postgrestServiceClient *postgr
 		corsOptions = cors.Options{
 			AllowOriginFunc:  isAllowedOrigin,
 			AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Clerk-User-ID"},
-			ExposedHeaders:   []string{"Link"},
+			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Clerk-User-ID", "Idempotency-Key"},
+			ExposedHeaders:   []string{"Link", "Idempotent-Replayed"},
 			AllowCredentials: true,
 			MaxAge:           300, // Maximum value not ignored by any of major browsers
 		}
@@ -56,29 +56,19 @@ func newRouter(postgrestClient *postgrest.Client, postgrestServiceClient *postgr
 
 	r.Get("/", health)
 
-	// Auth
-	authService := auth.NewServiceWithConfig(postgrestClient, postgrestServiceClient, cfg.SupabaseURL+"/rest/v1", cfg.SupabaseAnonKey)
-	authHandler := auth.NewHandler(authService)
-
-	// Sports
-	sportsService := sports.NewService(postgrestClient, cfg.SupabaseURL+"/rest/v1", cfg.SupabaseAnonKey)
-	sportsHandler := sports.NewHandler(sportsService)
-
-	// Venues
-	venuesService := venues.NewService(postgrestClient, cfg.SupabaseURL+"/rest/v1", cfg.SupabaseAnonKey)
-	venuesHandler := venues.NewHandler(venuesService)
-
-	// Organizations
-	organizationsService := organizations.NewService(postgrestClient, postgrestServiceClient, cfg.SupabaseURL+"/rest/v1", cfg.SupabaseAnonKey)
-	organizationsHandler := organizations.NewHandler(organizationsService, authService)
-
-	// Notifications
-	notificationsService := notifications.NewService(postgrestClient, postgrestServiceClient)
-	notificationsHandler := notifications.NewHandler(notificationsService)
-
-	// Leagues
-	leaguesService := leagues.NewService(postgrestClient, cfg.SupabaseURL+"/rest/v1", cfg.SupabaseAnonKey, organizationsService, authService, sportsService, venuesService, notificationsService)
-	leaguesHandler := leagues.NewHandler(leaguesService, authService)
+	authHandler := auth.NewHandler(svc.auth)
+	sportsHandler := sports.NewHandler(svc.sports)
+	venuesHandler := venues.NewHandler(svc.venues)
+	organizationsHandler := organizations.NewHandler(svc.organizations, svc.auth)
+	notificationsHandler := notifications.NewHandler(svc.notifications)
+	leaguesHandler := leagues.NewHandler(svc.leagues, svc.auth)
+	venuesHandler.SetOccupancyProvider(svc.leagues)
+
+	idempotencyMiddleware := idempotency.NewMiddleware(svc.idempotency, cfg.IdempotencyTTL)
+	leaguesHandler.SetIdempotency(idempotencyMiddleware)
+	organizationsHandler.SetIdempotency(idempotencyMiddleware)
+	sportsHandler.SetIdempotency(idempotencyMiddleware)
+	venuesHandler.SetIdempotency(idempotencyMiddleware)
 
 	r.Route("/v1", func(r chi.Router) {
 		authHandler.RegisterRoutes(r)
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/leaguefindr/backend/internal/auth"
+	"github.com/leaguefindr/backend/internal/idempotency"
+	"github.com/leaguefindr/backend/internal/leagues"
+	"github.com/leaguefindr/backend/internal/notifications"
+	"github.com/leaguefindr/backend/internal/organizations"
+	"github.com/leaguefindr/backend/internal/sports"
+	"github.com/leaguefindr/backend/internal/venues"
+	"github.com/supabase-community/postgrest-go"
+)
+
+// Data stores selectable with DATA_STORE
+const (
+	dataStoreSupabase = "supabase"
+	dataStorePostgres = "postgres"
+	dataStoreMemory   = "memory"
+)
+
+// services holds the domain services the router serves
+type services struct {
+	auth          *auth.Service
+	sports        *sports.Service
+	venues        *venues.Service
+	organizations *organizations.Service
+	notifications *notifications.Service
+	leagues       *leagues.Service
+	idempotency   idempotency.Store // Idempotency keys of the create endpoints
+}
+
+// newSupabaseServices creates services that store their data in Supabase through PostgREST
+func newSupabaseServices(postgrestClient *postgrest.Client, postgrestServiceClient *postgrest.Client) *services {
+	baseURL := cfg.SupabaseURL + "/rest/v1"
+
+	s := &services{
+		auth:          auth.NewServiceWithConfig(postgrestClient, postgrestServiceClient, baseURL, cfg.SupabaseAnonKey),
+		sports:        sports.NewService(postgrestClient, baseURL, cfg.SupabaseAnonKey),
+		venues:        venues.NewService(postgrestClient, baseURL, cfg.SupabaseAnonKey),
+		organizations: organizations.NewService(postgrestClient, postgrestServiceClient, baseURL, cfg.SupabaseAnonKey),
+		notifications: notifications.NewService(postgrestClient, postgrestServiceClient),
+		idempotency:   idempotency.NewPostgresStore(postgrestServiceClient),
+	}
+	s.leagues = leagues.NewService(postgrestClient, postgrestServiceClient, baseURL, cfg.SupabaseAnonKey, s.organizations, s.auth, s.sports, s.venues, s.notifications)
+	return s
+}
+
+// newPostgresServices creates services like newSupabaseServices, except that leagues are stored over a direct
+// Postgres connection pool, which lets approvals run in a database transaction
+func newPostgresServices(postgrestClient *postgrest.Client, postgrestServiceClient *postgrest.Client, pool *pgxpool.Pool) *services {
+	s := newSupabaseServices(postgrestClient, postgrestServiceClient)
+	s.leagues = leagues.NewServiceWithRepository(leagues.NewPgxRepository(pool), s.organizations, s.auth, s.sports, s.venues, s.notifications)
+	return s
+}
+
+// newMemoryServices creates services that keep their data in memory until the process exits
+func newMemoryServices() *services {
+	users := auth.NewMemoryRepository()
+	sportsRepo := sports.NewMemoryRepository()
+	venuesRepo := venues.NewMemoryRepository()
+
+	s := &services{
+		auth:          auth.NewServiceWithRepository(users),
+		sports:        sports.NewServiceWithRepository(sportsRepo),
+		venues:        venues.NewServiceWithRepository(venuesRepo),
+		organizations: organizations.NewServiceWithRepository(organizations.NewMemoryRepository()),
+		notifications: notifications.NewServiceWithRepository(notifications.NewMemoryRepository(users)),
+		idempotency:   idempotency.NewMemoryStore(),
+	}
+	s.leagues = leagues.NewServiceWithRepository(leagues.NewMemoryRepository(sportsRepo, venuesRepo), s.organizations, s.auth, s.sports, s.venues, s.notifications)
+	return s
+}
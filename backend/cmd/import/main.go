@@ -0,0 +1,145 @@
+// Command import uploads a CSV or XLSX spreadsheet of leagues to the API and prints the per-row report
+//
+//	LEAGUEFINDR_TOKEN=<session token> go run ./cmd/import -org <org id> [-dry-run] leagues.xlsx
+//
+// Exits 1 if any row failed and 2 if the import could not run at all
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/leagues"
+)
+
+func main() {
+	orgID := flag.String("org", "", "organization ID to import the leagues into (required)")
+	dryRun := flag.Bool("dry-run", false, "validate the file without creating any leagues")
+	apiURL := flag.String("api", envOr("LEAGUEFINDR_API_URL", "http://localhost:8080"), "API base URL")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: import -org <org id> [-dry-run] [-api url] <file.csv|file.xlsx>\n\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "The session token is read from LEAGUEFINDR_TOKEN.\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *orgID == "" || flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	token := os.Getenv("LEAGUEFINDR_TOKEN")
+	if token == "" {
+		fmt.Fprintln(os.Stderr, "LEAGUEFINDR_TOKEN is not set")
+		os.Exit(2)
+	}
+
+	report, err := upload(*apiURL, token, *orgID, flag.Arg(0), *dryRun)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	printReport(os.Stdout, report)
+	if report.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// upload posts the file to the import endpoint and decodes the report
+func upload(apiURL, token, orgID, path string, dryRun bool) (*leagues.ImportLeaguesResponse, error) {
+	format, ok := leagues.ParseImportFormat(filepath.Ext(path))
+	if !ok {
+		return nil, fmt.Errorf("%s: unsupported file type, use .csv or .xlsx", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("org_id", orgID)
+	query.Set("dry_run", fmt.Sprint(dryRun))
+	query.Set("format", string(format))
+	endpoint := strings.TrimRight(apiURL, "/") + "/v1/leagues/import?" + query.Encode()
+
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	client := &http.Client{Timeout: 5 * time.Minute}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("import request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read import response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("import failed (%s): %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var report leagues.ImportLeaguesResponse
+	if err := json.Unmarshal(body, &report); err != nil {
+		return nil, fmt.Errorf("failed to decode import report: %w", err)
+	}
+	return &report, nil
+}
+
+// printReport writes one line per row followed by its errors and warnings, then a summary
+func printReport(w io.Writer, report *leagues.ImportLeaguesResponse) {
+	for _, warning := range report.Warnings {
+		fmt.Fprintf(w, "warning: %s\n", warning)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ROW\tSTATUS\tLEAGUE\tLEAGUE ID")
+	for _, row := range report.Rows {
+		name := ""
+		if row.LeagueName != nil {
+			name = *row.LeagueName
+		}
+		id := ""
+		if row.LeagueID != nil {
+			id = *row.LeagueID
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", row.Row, row.Status, name, id)
+		for _, msg := range row.Errors {
+			fmt.Fprintf(tw, "\t  error:\t%s\t\n", msg)
+		}
+		for _, msg := range row.Warnings {
+			fmt.Fprintf(tw, "\t  warning:\t%s\t\n", msg)
+		}
+	}
+	tw.Flush()
+
+	mode := "imported"
+	count := report.Imported
+	if report.DryRun {
+		mode = "valid (dry run, nothing imported)"
+		count = report.Valid
+	}
+	fmt.Fprintf(w, "\n%d of %d rows %s, %d failed\n", count, report.Total, mode, report.Failed)
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/caarlos0/env/v10"
+	"github.com/joho/godotenv"
+	"github.com/leaguefindr/backend/internal/idempotency"
+	"github.com/leaguefindr/backend/internal/jobs"
+	"github.com/leaguefindr/backend/internal/leagues"
+	"github.com/leaguefindr/backend/internal/notifications"
+	"github.com/supabase-community/postgrest-go"
+)
+
+type config struct {
+	SupabaseURL       string        `env:"SUPABASE_URL,required"`
+	SupabaseSecretKey string        `env:"SUPABASE_SECRET_KEY,required"`
+	WorkerID          string        `env:"WORKER_ID"`                          // Defaults to hostname-pid
+	Tick              time.Duration `env:"WORKER_TICK" envDefault:"1m"`        // How often due jobs are checked
+	JobInterval       time.Duration `env:"JOB_INTERVAL" envDefault:"1h"`       // How often each job runs
+	DraftRetention    time.Duration `env:"DRAFT_RETENTION" envDefault:"2160h"` // 90 days
+	ReviewSLA         time.Duration `env:"REVIEW_SLA" envDefault:"48h"`        // Pending leagues older than this are escalated to admins; 0 disables
+}
+
+func main() {
+	once := flag.Bool("once", false, "run every job once and exit (for cron-style scheduling)")
+	flag.Parse()
+
+	// Set up logging
+	slog.SetDefault(slog.New(
+		slog.NewTextHandler(
+			os.Stdout, &slog.HandlerOptions{
+				Level:     slog.LevelDebug,
+				AddSource: true,
+			})))
+
+	// Load environment variables
+	if err := godotenv.Load(); err != nil {
+		slog.Warn("godotenv load error", "err", err)
+		// Continue anyway - env vars might be set in the shell
+	}
+
+	var cfg config
+	if err := env.Parse(&cfg); err != nil {
+		slog.Error("config", "err", err)
+		os.Exit(1)
+	}
+	if cfg.WorkerID == "" {
+		hostname, _ := os.Hostname()
+		cfg.WorkerID = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+
+	// Jobs act on every organization's data, so they use the service client (bypasses RLS)
+	postgrestServiceClient := postgrest.NewClient(
+		cfg.SupabaseURL+"/rest/v1",
+		"public",
+		map[string]string{
+			"apikey": cfg.SupabaseSecretKey,
+		},
+	)
+
+	store := jobs.NewPostgresStore(postgrestServiceClient)
+	runner := jobs.NewRunner(jobs.SystemClock{}, store, store, cfg.WorkerID)
+
+	notificationsService := notifications.NewService(postgrestServiceClient, postgrestServiceClient)
+
+	workerJobs := leagues.NewJobs(postgrestServiceClient, notificationsService, leagues.JobConfig{
+		Interval:       cfg.JobInterval,
+		DraftRetention: cfg.DraftRetention,
+		ReviewSLA:      cfg.ReviewSLA,
+	})
+	workerJobs = append(workerJobs, idempotency.NewPurgeJob(idempotency.NewPostgresStore(postgrestServiceClient), cfg.JobInterval))
+	for _, job := range workerJobs {
+		if err := runner.Register(job); err != nil {
+			slog.Error("register job", "job", job.Name, "err", err)
+			os.Exit(1)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *once {
+		runs := runner.RunAll(ctx)
+		failed := 0
+		for _, run := range runs {
+			if run.Status == jobs.RunFailed {
+				failed++
+			}
+		}
+		slog.Info("Worker finished", "worker", cfg.WorkerID, "runs", len(runs), "failed", failed)
+		if failed > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
+	slog.Info("Starting worker...", "worker", cfg.WorkerID, "jobs", runner.Jobs(), "tick", cfg.Tick, "interval", cfg.JobInterval)
+	runner.Start(ctx, cfg.Tick)
+	slog.Info("Worker stopped", "worker", cfg.WorkerID)
+}
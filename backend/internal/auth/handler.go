@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
+	"github.com/leaguefindr/backend/internal/shared"
 )
 
 type Handler struct {
@@ -17,7 +18,7 @@ type Handler struct {
 func NewHandler(service *Service) *Handler {
 	return &Handler{
 		service:   service,
-		validator: validator.New(),
+		validator: shared.NewValidator(),
 	}
 }
 
@@ -50,16 +51,15 @@ func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		slog.Error("register error", "err", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	// Validate request
-	err = h.validator.Struct(req)
+	err = shared.ValidateStruct(h.validator, req)
 	if err != nil {
-		validationErrors := err.(validator.ValidationErrors)
 		slog.Error("register error", "err", err)
-		http.Error(w, "Validation failed: "+validationErrors.Error(), http.StatusBadRequest)
+		shared.WriteError(w, r, err, "Validation failed")
 		return
 	}
 
@@ -67,7 +67,7 @@ func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
 	isAdmin, err := h.service.RegisterUser(r.Context(), req.ClerkID, req.Email)
 	if err != nil {
 		slog.Error("register error", "err", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to register")
 		return
 	}
 
@@ -87,7 +87,7 @@ func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
 	authenticatedUserID := r.Header.Get("X-Clerk-User-ID")
 
 	if userID == "" {
-		http.Error(w, "userID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "userID is required")
 		return
 	}
 
@@ -99,7 +99,7 @@ func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
 			slog.Warn("unauthorized user data access attempt",
 				"authenticatedUserID", authenticatedUserID,
 				"requestedUserID", userID)
-			http.Error(w, "Forbidden: cannot access other users' data", http.StatusForbidden)
+			shared.WriteProblem(w, r, http.StatusForbidden, "Forbidden: cannot access other users' data")
 			return
 		}
 	}
@@ -107,7 +107,7 @@ func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
 	user, err := h.service.GetUser(r.Context(), userID)
 	if err != nil {
 		slog.Error("get user error", "userID", userID, "err", err)
-		http.Error(w, "User not found", http.StatusNotFound)
+		shared.WriteError(w, r, err, "Failed to fetch user")
 		return
 	}
 
@@ -122,7 +122,7 @@ func (h *Handler) RecordLogin(w http.ResponseWriter, r *http.Request) {
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		slog.Error("record login error", "err", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
@@ -130,14 +130,14 @@ func (h *Handler) RecordLogin(w http.ResponseWriter, r *http.Request) {
 	userID, err := GetUserIDFromSessionID(req.SessionID)
 	if err != nil {
 		slog.Error("record login error", "err", err)
-		http.Error(w, "Failed to retrieve user from session", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Failed to retrieve user from session")
 		return
 	}
 
 	err = h.service.RecordLogin(r.Context(), userID)
 	if err != nil {
 		slog.Error("record login error", "err", err)
-		http.Error(w, "Failed to record login", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to record login")
 		return
 	}
 
@@ -151,7 +151,7 @@ func (h *Handler) UpdateUserRole(w http.ResponseWriter, r *http.Request) {
 	userID := chi.URLParam(r, "userID")
 
 	if userID == "" {
-		http.Error(w, "userID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "userID is required")
 		return
 	}
 
@@ -160,23 +160,22 @@ func (h *Handler) UpdateUserRole(w http.ResponseWriter, r *http.Request) {
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		slog.Error("update role error", "err", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	// Validate request
-	err = h.validator.Struct(req)
+	err = shared.ValidateStruct(h.validator, req)
 	if err != nil {
-		validationErrors := err.(validator.ValidationErrors)
 		slog.Error("update role error", "err", err)
-		http.Error(w, "Validation failed: "+validationErrors.Error(), http.StatusBadRequest)
+		shared.WriteError(w, r, err, "Validation failed")
 		return
 	}
 
 	err = h.service.UpdateUserRole(r.Context(), userID, req.Role)
 	if err != nil {
 		slog.Error("update role error", "err", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to update role")
 		return
 	}
 
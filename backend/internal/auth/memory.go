@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// MemoryRepository is a thread-safe in-memory RepositoryInterface used in tests and local development
+type MemoryRepository struct {
+	mu    sync.RWMutex
+	users map[string]User
+}
+
+// NewMemoryRepository creates an in-memory repository holding the given users
+func NewMemoryRepository(seed ...User) *MemoryRepository {
+	m := &MemoryRepository{users: make(map[string]User)}
+	for _, user := range seed {
+		m.users[user.ID] = user
+	}
+	return m
+}
+
+// CreateUser stores a new active user, failing when the ID is already taken
+func (m *MemoryRepository) CreateUser(ctx context.Context, userID, email string, role Role) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.users[userID]; exists {
+		return shared.Conflict("user already exists")
+	}
+	now := shared.Timestamp{Time: time.Now().UTC()}
+	m.users[userID] = User{
+		ID:        userID,
+		Email:     email,
+		Role:      role,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	return nil
+}
+
+// GetUserByID retrieves a user by ID
+func (m *MemoryRepository) GetUserByID(ctx context.Context, userID string) (*User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	user, exists := m.users[userID]
+	if !exists {
+		return nil, shared.NotFound("user not found")
+	}
+	return &user, nil
+}
+
+// UserExists checks if a user exists by ID
+func (m *MemoryRepository) UserExists(ctx context.Context, userID string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	_, exists := m.users[userID]
+	return exists, nil
+}
+
+// AdminExists checks if any admin users exist
+func (m *MemoryRepository) AdminExists(ctx context.Context) (bool, error) {
+	ids, err := m.GetAdminIDs(ctx)
+	return len(ids) > 0, err
+}
+
+// GetAdminIDs returns the IDs of every admin user in ID order
+func (m *MemoryRepository) GetAdminIDs(ctx context.Context) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := []string{}
+	for _, user := range m.users {
+		if user.Role == RoleAdmin {
+			ids = append(ids, user.ID)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// UpdateLastLogin updates the user's last login time and increments login count
+func (m *MemoryRepository) UpdateLastLogin(ctx context.Context, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	user, exists := m.users[userID]
+	if !exists {
+		return shared.NotFound("user not found")
+	}
+	now := shared.Timestamp{Time: time.Now().UTC()}
+	user.LastLogin = &now
+	user.LoginCount++
+	user.UpdatedAt = now
+	m.users[userID] = user
+	return nil
+}
+
+// UpdateUserRole updates a user's role
+func (m *MemoryRepository) UpdateUserRole(ctx context.Context, userID string, role Role) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	user, exists := m.users[userID]
+	if !exists {
+		return shared.NotFound("user not found")
+	}
+	user.Role = role
+	user.UpdatedAt = shared.Timestamp{Time: time.Now().UTC()}
+	m.users[userID] = user
+	return nil
+}
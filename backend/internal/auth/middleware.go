@@ -11,6 +11,7 @@ import (
 	clerk "github.com/clerk/clerk-sdk-go/v2"
 	"github.com/clerk/clerk-sdk-go/v2/jwt"
 	"github.com/clerk/clerk-sdk-go/v2/session"
+	"github.com/leaguefindr/backend/internal/shared"
 )
 
 // JWTMiddleware validates Clerk JWT tokens from the Authorization header
@@ -20,7 +21,7 @@ func JWTMiddleware(next http.Handler) http.Handler {
 		authHeader := r.Header.Get("Authorization")
 		if authHeader == "" {
 			slog.Debug("JWTMiddleware: missing authorization header")
-			http.Error(w, "Missing authorization header", http.StatusUnauthorized)
+			shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing authorization header")
 			return
 		}
 
@@ -28,7 +29,7 @@ func JWTMiddleware(next http.Handler) http.Handler {
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
 			slog.Debug("JWTMiddleware: invalid authorization header format")
-			http.Error(w, "Invalid authorization header format", http.StatusUnauthorized)
+			shared.WriteProblem(w, r, http.StatusUnauthorized, "Invalid authorization header format")
 			return
 		}
 
@@ -41,7 +42,7 @@ func JWTMiddleware(next http.Handler) http.Handler {
 				"error", err.Error(),
 				"tokenLength", len(token),
 			)
-			http.Error(w, "Invalid or expired token", http.StatusUnauthorized)
+			shared.WriteProblem(w, r, http.StatusUnauthorized, "Invalid or expired token")
 			return
 		}
 
@@ -132,7 +133,7 @@ func RequireAdmin(authService *Service) func(http.Handler) http.Handler {
 			userID := r.Header.Get("X-Clerk-User-ID")
 			if userID == "" {
 				slog.Warn("RequireAdmin: missing X-Clerk-User-ID header")
-				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
 				return
 			}
 
@@ -140,7 +141,7 @@ func RequireAdmin(authService *Service) func(http.Handler) http.Handler {
 			isAdmin, err := authService.IsUserAdmin(r.Context(), userID)
 			if err != nil || !isAdmin {
 				slog.Warn("RequireAdmin: admin check failed", "userID", userID, "err", err)
-				http.Error(w, "Forbidden: admin access required", http.StatusForbidden)
+				shared.WriteProblem(w, r, http.StatusForbidden, "Forbidden: admin access required")
 				return
 			}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/leaguefindr/backend/internal/shared"
 	"github.com/supabase-community/postgrest-go"
 )
 
@@ -13,6 +14,7 @@ type RepositoryInterface interface {
 	GetUserByID(ctx context.Context, userID string) (*User, error)
 	UserExists(ctx context.Context, userID string) (bool, error)
 	AdminExists(ctx context.Context) (bool, error)
+	GetAdminIDs(ctx context.Context) ([]string, error)
 	UpdateLastLogin(ctx context.Context, userID string) error
 	UpdateUserRole(ctx context.Context, userID string, role Role) error
 }
@@ -56,7 +58,7 @@ func (r *Repository) GetUserByID(ctx context.Context, userID string) (*User, err
 		ExecuteToWithContext(ctx, &users)
 
 	if err != nil || len(users) == 0 {
-		return nil, fmt.Errorf("user not found")
+		return nil, shared.NotFound("user not found").Wrap(err)
 	}
 
 	return &users[0], nil
@@ -94,6 +96,27 @@ func (r *Repository) AdminExists(ctx context.Context) (bool, error) {
 	return len(users) > 0, nil
 }
 
+// GetAdminIDs returns the IDs of every admin user
+func (r *Repository) GetAdminIDs(ctx context.Context) ([]string, error) {
+	var users []User
+
+	_, err := r.client.From("users").
+		Select("id", "", false).
+		Eq("role", "admin").
+		ExecuteToWithContext(ctx, &users)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch admin users: %w", err)
+	}
+
+	ids := make([]string, len(users))
+	for i, user := range users {
+		ids[i] = user.ID
+	}
+
+	return ids, nil
+}
+
 // UpdateLastLogin updates the user's last login time and increments login count
 func (r *Repository) UpdateLastLogin(ctx context.Context, userID string) error {
 	// First, get the current login count
@@ -108,7 +131,7 @@ func (r *Repository) UpdateLastLogin(ctx context.Context, userID string) error {
 	}
 
 	if len(users) == 0 {
-		return fmt.Errorf("user not found")
+		return shared.NotFound("user not found")
 	}
 
 	// Get current login count and increment it
@@ -156,7 +179,7 @@ func (r *Repository) UpdateUserRole(ctx context.Context, userID string, role Rol
 	}
 
 	if len(result) == 0 {
-		return fmt.Errorf("user not found")
+		return shared.NotFound("user not found")
 	}
 
 	return nil
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/leaguefindr/backend/internal/shared"
 	"github.com/supabase-community/postgrest-go"
 )
 
@@ -17,12 +18,16 @@ type Service struct {
 	serviceClient *postgrest.Client
 	baseURL       string
 	anonKey       string
+	repo          RepositoryInterface                  // Users are read and written with the service client
+	syncMetadata  func(userID string, role Role) error // Pushes role changes to Clerk
 }
 
 func NewService(baseClient *postgrest.Client, serviceClient *postgrest.Client) *Service {
 	return &Service{
 		baseClient:    baseClient,
 		serviceClient: serviceClient,
+		repo:          NewRepository(serviceClient),
+		syncMetadata:  SyncUserMetadataToClerk,
 	}
 }
 
@@ -33,6 +38,16 @@ func NewServiceWithConfig(baseClient *postgrest.Client, serviceClient *postgrest
 		serviceClient: serviceClient,
 		baseURL:       baseURL,
 		anonKey:       anonKey,
+		repo:          NewRepository(serviceClient),
+		syncMetadata:  SyncUserMetadataToClerk,
+	}
+}
+
+// NewServiceWithRepository creates a service that keeps its users in repo instead of Supabase
+func NewServiceWithRepository(repo RepositoryInterface) *Service {
+	return &Service{
+		repo:         repo,
+		syncMetadata: SyncUserMetadataToClerk,
 	}
 }
 
@@ -72,23 +87,20 @@ func (s *Service) getClientWithAuth(ctx context.Context) *postgrest.Client {
 // User will create or join an organization during onboarding via frontend
 // Returns isAdmin boolean indicating if this user was made an admin
 func (s *Service) RegisterUser(ctx context.Context, clerkID, email string) (bool, error) {
-	// Use service client for all operations
-	repo := NewRepository(s.serviceClient)
-
 	// Check if user already exists
-	exists, err := repo.UserExists(ctx, clerkID)
+	exists, err := s.repo.UserExists(ctx, clerkID)
 	if err != nil {
 		return false, fmt.Errorf("failed to check user existence: %w", err)
 	}
 
 	// User already exists
 	if exists {
-		return false, fmt.Errorf("user already exists")
+		return false, shared.Conflict("user already exists")
 	}
 
 	// Determine role: first user is admin, others are organizers
 	role := RoleOrganizer
-	adminExists, err := repo.AdminExists(ctx)
+	adminExists, err := s.repo.AdminExists(ctx)
 	if err != nil {
 		slog.Error("failed to check admin existence", "clerkID", clerkID, "err", err)
 		return false, fmt.Errorf("failed to check admin existence: %w", err)
@@ -102,7 +114,7 @@ func (s *Service) RegisterUser(ctx context.Context, clerkID, email string) (bool
 	}
 
 	// Create new user
-	err = repo.CreateUser(ctx, clerkID, email, role)
+	err = s.repo.CreateUser(ctx, clerkID, email, role)
 	if err != nil {
 		return false, err
 	}
@@ -111,7 +123,7 @@ func (s *Service) RegisterUser(ctx context.Context, clerkID, email string) (bool
 	// Users verify via email code during signup flow
 
 	// Sync user metadata to Clerk (best effort - don't fail registration if sync fails)
-	syncErr := SyncUserMetadataToClerk(clerkID, role)
+	syncErr := s.syncMetadata(clerkID, role)
 	if syncErr != nil {
 		// Log the error but don't fail - DB is source of truth
 		// TODO: In future, publish to GCP Pub/Sub for async retry
@@ -123,9 +135,7 @@ func (s *Service) RegisterUser(ctx context.Context, clerkID, email string) (bool
 
 // GetUser retrieves user information by ID
 func (s *Service) GetUser(ctx context.Context, userID string) (*User, error) {
-	repo := NewRepository(s.serviceClient)
-
-	user, err := repo.GetUserByID(ctx, userID)
+	user, err := s.repo.GetUserByID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -135,15 +145,13 @@ func (s *Service) GetUser(ctx context.Context, userID string) (*User, error) {
 
 // ValidateUserRole checks if a user has a specific role
 func (s *Service) ValidateUserRole(ctx context.Context, userID string, requiredRole Role) (bool, error) {
-	repo := NewRepository(s.serviceClient)
-
-	user, err := repo.GetUserByID(ctx, userID)
+	user, err := s.repo.GetUserByID(ctx, userID)
 	if err != nil {
 		return false, err
 	}
 
 	if !user.IsActive {
-		return false, fmt.Errorf("user account is inactive")
+		return false, shared.Forbidden("user account is inactive")
 	}
 
 	return user.Role == requiredRole, nil
@@ -151,9 +159,7 @@ func (s *Service) ValidateUserRole(ctx context.Context, userID string, requiredR
 
 // RecordLogin updates user's last login time and increments login count
 func (s *Service) RecordLogin(ctx context.Context, userID string) error {
-	repo := NewRepository(s.serviceClient)
-
-	err := repo.UpdateLastLogin(ctx, userID)
+	err := s.repo.UpdateLastLogin(ctx, userID)
 	if err != nil {
 		return err
 	}
@@ -163,15 +169,13 @@ func (s *Service) RecordLogin(ctx context.Context, userID string) error {
 
 // UpdateUserRole updates a user's role (admin only)
 func (s *Service) UpdateUserRole(ctx context.Context, userID string, role Role) error {
-	repo := NewRepository(s.serviceClient)
-
-	err := repo.UpdateUserRole(ctx, userID, role)
+	err := s.repo.UpdateUserRole(ctx, userID, role)
 	if err != nil {
 		return err
 	}
 
 	// Sync user metadata to Clerk (best effort - don't fail update if sync fails)
-	syncErr := SyncUserMetadataToClerk(userID, role)
+	syncErr := s.syncMetadata(userID, role)
 	if syncErr != nil {
 		// Log the error but don't fail - DB is source of truth
 		// TODO: In future, publish to GCP Pub/Sub for async retry
@@ -184,9 +188,7 @@ func (s *Service) UpdateUserRole(ctx context.Context, userID string, role Role)
 // IsUserAdmin checks if a user has admin role
 // If user doesn't exist, returns false (not admin) instead of erroring
 func (s *Service) IsUserAdmin(ctx context.Context, userID string) (bool, error) {
-	repo := NewRepository(s.serviceClient)
-
-	user, err := repo.GetUserByID(ctx, userID)
+	user, err := s.repo.GetUserByID(ctx, userID)
 	if err != nil {
 		// User doesn't exist - return false, don't error
 		// The user will need to call /auth/register to create their account
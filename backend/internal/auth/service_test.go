@@ -1,384 +1,162 @@
 package auth
 
 import (
+	"context"
+	"errors"
 	"testing"
-)
-
-// MockRepository is a mock implementation of Repository for testing
-type MockRepository struct {
-	users map[string]*User
-}
-
-func NewMockRepository() *MockRepository {
-	return &MockRepository{
-		users: make(map[string]*User),
-	}
-}
-
-func (m *MockRepository) CreateUser(userID, email, organizationName string, role Role) error {
-	user := &User{
-		ID:       userID,
-		Email:    email,
-		Role:     role,
-		IsActive: true,
-	}
-	m.users[userID] = user
-	return nil
-}
 
-func (m *MockRepository) GetUserByID(userID string) (*User, error) {
-	user, exists := m.users[userID]
-	if !exists {
-		return nil, ErrUserNotFound
-	}
-	return user, nil
-}
-
-func (m *MockRepository) UserExists(userID string) (bool, error) {
-	_, exists := m.users[userID]
-	return exists, nil
-}
-
-func (m *MockRepository) AdminExists() (bool, error) {
-	for _, user := range m.users {
-		if user.Role == RoleAdmin {
-			return true, nil
-		}
-	}
-	return false, nil
-}
-
-func (m *MockRepository) UpdateLastLogin(userID string) error {
-	user, exists := m.users[userID]
-	if !exists {
-		return ErrUserNotFound
-	}
-	user.LoginCount++
-	return nil
-}
-
-func (m *MockRepository) UpdateUserRole(userID string, role Role) error {
-	user, exists := m.users[userID]
-	if !exists {
-		return ErrUserNotFound
-	}
-	user.Role = role
-	return nil
-}
-
-// Error definitions for testing
-var (
-	ErrUserNotFound = &ValidationError{msg: "user not found"}
+	"github.com/leaguefindr/backend/internal/shared"
 )
 
-type ValidationError struct {
-	msg string
-}
-
-func (e *ValidationError) Error() string {
-	return e.msg
-}
-
-// Tests
-
-func TestRegisterUser_Success(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
-
-	err := service.RegisterUser("clerk_123", "test@example.com", "Test Org")
-
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
-	}
-
-	// Verify user was created
-	user, err := repo.GetUserByID("clerk_123")
-	if err != nil {
-		t.Fatalf("user should exist: %v", err)
-	}
-
-	if user.ID != "clerk_123" {
-		t.Errorf("expected user ID clerk_123, got %s", user.ID)
-	}
-
-	if user.Email != "test@example.com" {
-		t.Errorf("expected email test@example.com, got %s", user.Email)
-	}
-
-	if user.OrganizationName != "Test Org" {
-		t.Errorf("expected organization name 'Test Org', got %s", user.OrganizationName)
-	}
-
-	// First user registered is admin
-	if user.Role != RoleAdmin {
-		t.Errorf("expected role admin for first user, got %s", user.Role)
-	}
-
-	if !user.IsActive {
-		t.Error("expected user to be active")
+// newTestService returns a service over an in-memory repository that records Clerk syncs instead of sending them
+func newTestService(seed ...User) (*Service, *MemoryRepository, map[string]Role) {
+	repo := NewMemoryRepository(seed...)
+	service := NewServiceWithRepository(repo)
+	synced := make(map[string]Role)
+	service.syncMetadata = func(userID string, role Role) error {
+		synced[userID] = role
+		return nil
 	}
+	return service, repo, synced
 }
 
-func TestRegisterUser_AlreadyExists(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
+func TestRegisterUser_FirstUserIsAdmin(t *testing.T) {
+	service, repo, synced := newTestService()
+	ctx := context.Background()
 
-	// Register first user
-	err := service.RegisterUser("clerk_123", "test@example.com", "Test Org")
+	isAdmin, err := service.RegisterUser(ctx, "clerk_first", "first@example.com")
 	if err != nil {
 		t.Fatalf("first registration failed: %v", err)
 	}
-
-	// Try to register same user again
-	err = service.RegisterUser("clerk_123", "test@example.com", "Test Org")
-	if err == nil {
-		t.Error("expected error when registering existing user")
-	}
-}
-
-func TestGetUser_Success(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
-
-	// Create user first
-	err := service.RegisterUser("clerk_123", "test@example.com", "Test Org")
-	if err != nil {
-		t.Fatalf("registration failed: %v", err)
+	if !isAdmin {
+		t.Error("expected the first user to be made an admin")
 	}
 
-	// Get user
-	user, err := service.GetUser("clerk_123")
+	user, err := repo.GetUserByID(ctx, "clerk_first")
 	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
-	}
-
-	if user.ID != "clerk_123" {
-		t.Errorf("expected user ID clerk_123, got %s", user.ID)
+		t.Fatalf("user should exist: %v", err)
 	}
-
-	if user.Email != "test@example.com" {
-		t.Errorf("expected email test@example.com, got %s", user.Email)
+	if user.Role != RoleAdmin || user.Email != "first@example.com" || !user.IsActive {
+		t.Errorf("unexpected user %+v", user)
 	}
-}
-
-func TestGetUser_NotFound(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
-
-	_, err := service.GetUser("nonexistent")
-	if err == nil {
-		t.Error("expected error for nonexistent user")
+	if synced["clerk_first"] != RoleAdmin {
+		t.Errorf("expected the admin role to be synced to Clerk, got %q", synced["clerk_first"])
 	}
 }
 
-func TestValidateUserRole_Success(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
+func TestRegisterUser_SecondUserIsOrganizer(t *testing.T) {
+	service, repo, _ := newTestService()
+	ctx := context.Background()
 
-	// Create first user (will be admin)
-	err := service.RegisterUser("clerk_first", "first@example.com", "Org 1")
-	if err != nil {
+	if _, err := service.RegisterUser(ctx, "clerk_first", "first@example.com"); err != nil {
 		t.Fatalf("first registration failed: %v", err)
 	}
-
-	// Create second user (will be regular user)
-	err = service.RegisterUser("clerk_second", "second@example.com", "Org 2")
+	isAdmin, err := service.RegisterUser(ctx, "clerk_second", "second@example.com")
 	if err != nil {
 		t.Fatalf("second registration failed: %v", err)
 	}
-
-	// Validate second user has role 'user'
-	isValid, err := service.ValidateUserRole("clerk_second", RoleUser)
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+	if isAdmin {
+		t.Error("expected the second user not to be an admin")
 	}
 
-	if !isValid {
-		t.Error("expected user to have role 'user'")
+	user, _ := repo.GetUserByID(ctx, "clerk_second")
+	if user.Role != RoleOrganizer {
+		t.Errorf("expected second user to be an organizer, got %s", user.Role)
 	}
 }
 
-func TestValidateUserRole_InvalidRole(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
+func TestRegisterUser_AlreadyExists(t *testing.T) {
+	service, _, _ := newTestService()
+	ctx := context.Background()
 
-	// Create first user (will be admin)
-	err := service.RegisterUser("clerk_first", "first@example.com", "Org 1")
-	if err != nil {
+	if _, err := service.RegisterUser(ctx, "clerk_123", "test@example.com"); err != nil {
 		t.Fatalf("first registration failed: %v", err)
 	}
-
-	// Create second user (will be regular user)
-	err = service.RegisterUser("clerk_second", "second@example.com", "Org 2")
-	if err != nil {
-		t.Fatalf("second registration failed: %v", err)
-	}
-
-	// Validate second user does not have role 'admin'
-	isValid, err := service.ValidateUserRole("clerk_second", RoleAdmin)
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
-	}
-
-	if isValid {
-		t.Error("expected user not to have role 'admin'")
+	_, err := service.RegisterUser(ctx, "clerk_123", "test@example.com")
+	if !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict when registering an existing user, got %v", err)
 	}
 }
 
-func TestValidateUserRole_InactiveUser(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
+func TestGetUser(t *testing.T) {
+	service, _, _ := newTestService(User{ID: "clerk_123", Email: "test@example.com", Role: RoleOrganizer, IsActive: true})
+	ctx := context.Background()
 
-	// Create and deactivate user
-	err := service.RegisterUser("clerk_123", "test@example.com", "Test Org")
+	user, err := service.GetUser(ctx, "clerk_123")
 	if err != nil {
-		t.Fatalf("registration failed: %v", err)
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if user.Email != "test@example.com" {
+		t.Errorf("expected email test@example.com, got %s", user.Email)
 	}
 
-	user, _ := repo.GetUserByID("clerk_123")
-	user.IsActive = false
-
-	// Try to validate role
-	_, err = service.ValidateUserRole("clerk_123", RoleUser)
-	if err == nil {
-		t.Error("expected error for inactive user")
+	if _, err := service.GetUser(ctx, "nonexistent"); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected not found for a nonexistent user, got %v", err)
 	}
 }
 
-func TestRecordLogin_Success(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
-
-	// Create user
-	err := service.RegisterUser("clerk_123", "test@example.com", "Test Org")
-	if err != nil {
-		t.Fatalf("registration failed: %v", err)
-	}
+func TestValidateUserRole(t *testing.T) {
+	service, _, _ := newTestService(
+		User{ID: "organizer", Role: RoleOrganizer, IsActive: true},
+		User{ID: "inactive", Role: RoleOrganizer, IsActive: false},
+	)
+	ctx := context.Background()
 
-	// Record login
-	err = service.RecordLogin("clerk_123")
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+	if ok, err := service.ValidateUserRole(ctx, "organizer", RoleOrganizer); err != nil || !ok {
+		t.Errorf("expected organizer role to validate, got %v, %v", ok, err)
 	}
-
-	// Verify login count incremented
-	user, _ := repo.GetUserByID("clerk_123")
-	if user.LoginCount != 1 {
-		t.Errorf("expected login count 1, got %d", user.LoginCount)
+	if ok, err := service.ValidateUserRole(ctx, "organizer", RoleAdmin); err != nil || ok {
+		t.Errorf("expected admin role not to validate, got %v, %v", ok, err)
 	}
-}
-
-func TestRecordLogin_NonexistentUser(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
-
-	err := service.RecordLogin("nonexistent")
-	if err == nil {
-		t.Error("expected error for nonexistent user")
+	if _, err := service.ValidateUserRole(ctx, "inactive", RoleOrganizer); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected forbidden for an inactive user, got %v", err)
 	}
 }
 
-func TestRecordLogin_Multiple(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
-
-	// Create user
-	err := service.RegisterUser("clerk_123", "test@example.com", "Test Org")
-	if err != nil {
-		t.Fatalf("registration failed: %v", err)
-	}
+func TestRecordLogin(t *testing.T) {
+	service, repo, _ := newTestService(User{ID: "clerk_123", Role: RoleOrganizer, IsActive: true})
+	ctx := context.Background()
 
-	// Record multiple logins
-	for i := 1; i <= 5; i++ {
-		err = service.RecordLogin("clerk_123")
-		if err != nil {
+	for i := 1; i <= 3; i++ {
+		if err := service.RecordLogin(ctx, "clerk_123"); err != nil {
 			t.Fatalf("record login failed: %v", err)
 		}
-
-		user, _ := repo.GetUserByID("clerk_123")
-		if user.LoginCount != i {
-			t.Errorf("expected login count %d, got %d", i, user.LoginCount)
+		user, _ := repo.GetUserByID(ctx, "clerk_123")
+		if user.LoginCount != i || user.LastLogin == nil {
+			t.Errorf("expected login count %d with a last login, got %d, %v", i, user.LoginCount, user.LastLogin)
 		}
 	}
-}
-
-func TestRegisterUser_FirstUserIsAdmin(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
 
-	// Register first user
-	err := service.RegisterUser("clerk_first", "first@example.com", "Org 1")
-	if err != nil {
-		t.Fatalf("first registration failed: %v", err)
-	}
-
-	// Verify first user is admin
-	user, err := repo.GetUserByID("clerk_first")
-	if err != nil {
-		t.Fatalf("user should exist: %v", err)
-	}
-
-	if user.Role != RoleAdmin {
-		t.Errorf("expected first user to be admin, got %s", user.Role)
+	if err := service.RecordLogin(ctx, "nonexistent"); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected not found for a nonexistent user, got %v", err)
 	}
 }
 
-func TestRegisterUser_SecondUserIsRegular(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
-
-	// Register first user (will be admin)
-	err := service.RegisterUser("clerk_first", "first@example.com", "Org 1")
-	if err != nil {
-		t.Fatalf("first registration failed: %v", err)
-	}
+func TestUpdateUserRole(t *testing.T) {
+	service, repo, synced := newTestService(User{ID: "clerk_123", Role: RoleOrganizer, IsActive: true})
+	ctx := context.Background()
 
-	// Register second user
-	err = service.RegisterUser("clerk_second", "second@example.com", "Org 2")
-	if err != nil {
-		t.Fatalf("second registration failed: %v", err)
-	}
-
-	// Verify second user is regular user
-	user, err := repo.GetUserByID("clerk_second")
-	if err != nil {
-		t.Fatalf("user should exist: %v", err)
-	}
-
-	if user.Role != RoleUser {
-		t.Errorf("expected second user to be user, got %s", user.Role)
+	if err := service.UpdateUserRole(ctx, "clerk_123", RoleAdmin); err != nil {
+		t.Fatalf("expected no error, got %v", err)
 	}
-}
-
-func TestUpdateUserRole_Success(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
-
-	// Create user
-	err := service.RegisterUser("clerk_123", "test@example.com", "Test Org")
-	if err != nil {
-		t.Fatalf("registration failed: %v", err)
+	user, _ := repo.GetUserByID(ctx, "clerk_123")
+	if user.Role != RoleAdmin || synced["clerk_123"] != RoleAdmin {
+		t.Errorf("expected admin role stored and synced, got %s and %s", user.Role, synced["clerk_123"])
 	}
-
-	// Update role
-	err = service.UpdateUserRole("clerk_123", RoleAdmin)
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+	if admin, _ := service.IsUserAdmin(ctx, "clerk_123"); !admin {
+		t.Error("expected IsUserAdmin to report the new role")
 	}
 
-	// Verify role was updated
-	user, _ := repo.GetUserByID("clerk_123")
-	if user.Role != RoleAdmin {
-		t.Errorf("expected admin role, got %s", user.Role)
+	if err := service.UpdateUserRole(ctx, "nonexistent", RoleAdmin); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected not found for a nonexistent user, got %v", err)
 	}
 }
 
-func TestUpdateUserRole_NonexistentUser(t *testing.T) {
-	repo := NewMockRepository()
-	service := NewService(repo)
+func TestIsUserAdmin_UnknownUser(t *testing.T) {
+	service, _, _ := newTestService()
 
-	err := service.UpdateUserRole("nonexistent", RoleAdmin)
-	if err == nil {
-		t.Error("expected error for nonexistent user")
+	admin, err := service.IsUserAdmin(context.Background(), "nonexistent")
+	if err != nil || admin {
+		t.Errorf("expected an unknown user not to be admin without error, got %v, %v", admin, err)
 	}
 }
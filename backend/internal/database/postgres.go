@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Querier runs queries on a connection pool or inside a transaction
+type Querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// NewPool connects to Postgres and checks the connection
+// maxConns overrides the pool size when positive; pool settings in the URL (e.g. pool_max_conns) apply otherwise
+func NewPool(ctx context.Context, databaseURL string, maxConns int32) (*pgxpool.Pool, error) {
+	config, err := pgxpool.ParseConfig(databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid database URL: %w", err)
+	}
+	if maxConns > 0 {
+		config.MaxConns = maxConns
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection pool: %w", err)
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return pool, nil
+}
+
+// Conditions collects the WHERE conditions of a query and their arguments
+type Conditions struct {
+	conditions []string
+	args       []any
+}
+
+// Arg adds a query argument and returns its placeholder
+func (c *Conditions) Arg(value any) string {
+	c.args = append(c.args, value)
+	return "$" + strconv.Itoa(len(c.args))
+}
+
+// Add adds a condition; its arguments must have been added with Arg
+func (c *Conditions) Add(condition string) {
+	c.conditions = append(c.conditions, condition)
+}
+
+// Where returns the WHERE clause of the conditions, or "" when there are none
+func (c *Conditions) Where() string {
+	if len(c.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(c.conditions, " AND ")
+}
+
+// Args returns the query arguments in placeholder order
+func (c *Conditions) Args() []any {
+	return c.args
+}
+
+// SelectJSON runs a query returning a single JSON column and decodes each row into a T
+// Selecting to_jsonb(row) decodes rows through the same JSON tags used with PostgREST
+func SelectJSON[T any](ctx context.Context, q Querier, sql string, args ...any) ([]T, error) {
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[T])
+}
+
+// Insert inserts a row built from data, keyed by column name, and decodes the stored row into result when it is not nil
+// Values are converted to the column types by jsonb_populate_record, as PostgREST does
+func Insert(ctx context.Context, q Querier, table string, data map[string]any, result any) error {
+	columns, payload, err := jsonColumns(data)
+	if err != nil {
+		return err
+	}
+	identifier := pgx.Identifier{table}.Sanitize()
+
+	sql := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM jsonb_populate_record(NULL::%s, $1) RETURNING to_jsonb(%s.*)",
+		identifier, columns, columns, identifier, identifier)
+	var row []byte
+	if err := q.QueryRow(ctx, sql, payload).Scan(&row); err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(row, result)
+}
+
+// Update sets the columns in data on the rows matching where and returns how many rows changed
+// where uses $1, $2... for args; the column values are passed after them
+func Update(ctx context.Context, q Querier, table string, data map[string]any, where string, args ...any) (int64, error) {
+	columns, payload, err := jsonColumns(data)
+	if err != nil {
+		return 0, err
+	}
+	identifier := pgx.Identifier{table}.Sanitize()
+
+	sql := fmt.Sprintf("UPDATE %s SET (%s) = (SELECT %s FROM jsonb_populate_record(NULL::%s, $%d)) WHERE %s",
+		identifier, columns, columns, identifier, len(args)+1, where)
+	tag, err := q.Exec(ctx, sql, append(args, payload)...)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// jsonColumns returns the quoted column list of data, in a stable order, and data encoded as JSON
+func jsonColumns(data map[string]any) (string, []byte, error) {
+	if len(data) == 0 {
+		return "", nil, fmt.Errorf("no columns to write")
+	}
+
+	names := make([]string, 0, len(data))
+	for name := range data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = pgx.Identifier{name}.Sanitize()
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode row: %w", err)
+	}
+	return strings.Join(quoted, ", "), payload, nil
+}
@@ -0,0 +1,41 @@
+package database
+
+import (
+	"testing"
+)
+
+func TestConditions(t *testing.T) {
+	conditions := &Conditions{}
+	if conditions.Where() != "" {
+		t.Errorf("expected no WHERE clause without conditions, got %q", conditions.Where())
+	}
+
+	conditions.Add("status = 'approved'")
+	conditions.Add("org_id = " + conditions.Arg("org-1"))
+	conditions.Add("sport_id = " + conditions.Arg(int64(3)))
+
+	if want := " WHERE status = 'approved' AND org_id = $1 AND sport_id = $2"; conditions.Where() != want {
+		t.Errorf("got %q, want %q", conditions.Where(), want)
+	}
+	if args := conditions.Args(); len(args) != 2 || args[0] != "org-1" || args[1] != int64(3) {
+		t.Errorf("unexpected args %v", args)
+	}
+}
+
+func TestJSONColumns(t *testing.T) {
+	columns, payload, err := jsonColumns(map[string]any{"status": "approved", "rejection_reason": nil, "order": 1})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// Columns are sorted and quoted, so reserved words work as column names
+	if columns != `"order", "rejection_reason", "status"` {
+		t.Errorf("unexpected columns %s", columns)
+	}
+	if string(payload) != `{"order":1,"rejection_reason":null,"status":"approved"}` {
+		t.Errorf("unexpected payload %s", payload)
+	}
+
+	if _, _, err := jsonColumns(nil); err == nil {
+		t.Error("expected an error without columns")
+	}
+}
@@ -0,0 +1,17 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// RPCError turns a PostgREST RPC body that could not be decoded into an error, preferring PostgREST's message
+func RPCError(prefix string, body string, decodeErr error) error {
+	var rpcErr struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal([]byte(body), &rpcErr) == nil && rpcErr.Message != "" {
+		return fmt.Errorf("%s: %s", prefix, rpcErr.Message)
+	}
+	return fmt.Errorf("%s: %w", prefix, decodeErr)
+}
@@ -0,0 +1,20 @@
+package database
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRPCError(t *testing.T) {
+	decodeErr := errors.New("cannot unmarshal object into bool")
+
+	err := RPCError("failed to acquire lock", `{"code":"42883","message":"function try_acquire does not exist"}`, decodeErr)
+	if err.Error() != "failed to acquire lock: function try_acquire does not exist" {
+		t.Errorf("expected PostgREST's message, got %q", err)
+	}
+
+	err = RPCError("failed to acquire lock", "not json", decodeErr)
+	if !errors.Is(err, decodeErr) {
+		t.Errorf("expected the decode error to be wrapped, got %v", err)
+	}
+}
@@ -0,0 +1,220 @@
+// Package ical writes iCalendar (RFC 5545) feeds
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProductID identifies the generator in every calendar
+const ProductID = "-//LeagueFindr//Leagues//EN"
+
+// maxLineOctets is the longest a content line may be before it has to be folded
+const maxLineOctets = 75
+
+const (
+	dateFormat         = "20060102"
+	floatingTimeFormat = "20060102T150405"
+	utcTimeFormat      = "20060102T150405Z"
+)
+
+// Event statuses
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Calendar is a VCALENDAR holding a list of events
+type Calendar struct {
+	Name        string // Shown by calendar apps as the subscription name (X-WR-CALNAME)
+	Description string
+	Events      []Event
+}
+
+// Event is a VEVENT
+// Start and End are written as floating local times (no time zone), so a 19:00 game shows at 19:00 wherever the
+// subscriber is; AllDay events only use the dates
+type Event struct {
+	UID         string    // Stable across feed refreshes so calendar apps update rather than duplicate events
+	Stamp       time.Time // When the event data last changed
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Geo         *Geo
+	Status      string // StatusConfirmed or StatusCancelled, omitted when empty
+	Recurrence  *Recurrence
+	ExDates     []time.Time // Occurrences removed from the recurrence, as their original start times
+	// RecurrenceID marks the event as an override of the recurring event with the same UID: the occurrence
+	// originally starting at RecurrenceID is replaced by this event
+	RecurrenceID *time.Time
+}
+
+// Geo is the position of an event
+type Geo struct {
+	Lat float64
+	Lng float64
+}
+
+// Recurrence is a weekly RRULE ending on Until or after Count occurrences
+type Recurrence struct {
+	Until *time.Time
+	Count int
+}
+
+// Encode writes the calendar in iCalendar format
+func (c *Calendar) Encode(w io.Writer) error {
+	enc := &encoder{w: bufio.NewWriter(w)}
+
+	enc.line("BEGIN", "VCALENDAR")
+	enc.line("VERSION", "2.0")
+	enc.line("PRODID", ProductID)
+	enc.line("CALSCALE", "GREGORIAN")
+	enc.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		enc.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+	if c.Description != "" {
+		enc.line("X-WR-CALDESC", escapeText(c.Description))
+	}
+
+	for _, event := range c.Events {
+		enc.event(event)
+	}
+
+	enc.line("END", "VCALENDAR")
+	if enc.err != nil {
+		return enc.err
+	}
+	return enc.w.Flush()
+}
+
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (e *encoder) event(event Event) {
+	e.line("BEGIN", "VEVENT")
+	e.line("UID", escapeText(event.UID))
+	e.line("DTSTAMP", event.Stamp.UTC().Format(utcTimeFormat))
+	e.timeLine("DTSTART", event.Start, event.AllDay)
+	if !event.End.IsZero() {
+		e.timeLine("DTEND", event.End, event.AllDay)
+	}
+	if event.RecurrenceID != nil {
+		e.timeLine("RECURRENCE-ID", *event.RecurrenceID, event.AllDay)
+	}
+	if event.Recurrence != nil {
+		e.line("RRULE", recurrenceRule(*event.Recurrence, event.AllDay))
+	}
+	if len(event.ExDates) > 0 {
+		values := make([]string, len(event.ExDates))
+		for i, date := range event.ExDates {
+			values[i] = formatTime(date, event.AllDay)
+		}
+		name := "EXDATE"
+		if event.AllDay {
+			name += ";VALUE=DATE"
+		}
+		e.line(name, strings.Join(values, ","))
+	}
+	e.line("SUMMARY", escapeText(event.Summary))
+	if event.Description != "" {
+		e.line("DESCRIPTION", escapeText(event.Description))
+	}
+	if event.Location != "" {
+		e.line("LOCATION", escapeText(event.Location))
+	}
+	if event.Geo != nil {
+		e.line("GEO", strconv.FormatFloat(event.Geo.Lat, 'f', 6, 64)+";"+strconv.FormatFloat(event.Geo.Lng, 'f', 6, 64))
+	}
+	if event.URL != "" {
+		e.line("URL", event.URL)
+	}
+	if event.Status != "" {
+		e.line("STATUS", event.Status)
+	}
+	e.line("END", "VEVENT")
+}
+
+func (e *encoder) timeLine(name string, t time.Time, allDay bool) {
+	if allDay {
+		name += ";VALUE=DATE"
+	}
+	e.line(name, formatTime(t, allDay))
+}
+
+// line writes a content line, folding it so no physical line is longer than 75 octets
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+	for _, part := range fold(name + ":" + value) {
+		if _, err := e.w.WriteString(part + "\r\n"); err != nil {
+			e.err = err
+			return
+		}
+	}
+}
+
+// fold splits a content line into 75 octet pieces, continuation lines starting with a space
+// Splits never fall inside a multi-byte UTF-8 character
+func fold(line string) []string {
+	var parts []string
+	for len(line) > maxLineOctets {
+		cut := maxLineOctets
+		for cut > 1 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		parts = append(parts, line[:cut])
+		line = " " + line[cut:]
+	}
+	return append(parts, line)
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+// escapeText escapes a TEXT value: backslashes, semicolons, commas and new lines
+func escapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+func formatTime(t time.Time, allDay bool) string {
+	if allDay {
+		return t.Format(dateFormat)
+	}
+	return t.Format(floatingTimeFormat)
+}
+
+// recurrenceRule formats a weekly RRULE; UNTIL uses the same value type as DTSTART, as RFC 5545 requires
+func recurrenceRule(r Recurrence, allDay bool) string {
+	rule := "FREQ=WEEKLY"
+	if r.Until != nil {
+		until := *r.Until
+		if !allDay {
+			// Include every occurrence on the last day
+			until = time.Date(until.Year(), until.Month(), until.Day(), 23, 59, 59, 0, until.Location())
+		}
+		rule += ";UNTIL=" + formatTime(until, allDay)
+	} else if r.Count > 0 {
+		rule += fmt.Sprintf(";COUNT=%d", r.Count)
+	}
+	return rule
+}
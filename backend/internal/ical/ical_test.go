@@ -0,0 +1,166 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestEncode(t *testing.T) {
+	until := time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)
+	calendar := &Calendar{
+		Name: "Spring Kickball",
+		Events: []Event{
+			{
+				UID:        "league-1-tuesday-1800@leaguefindr.com",
+				Stamp:      time.Date(2025, 2, 1, 12, 30, 0, 0, time.FixedZone("PST", -8*60*60)),
+				Start:      time.Date(2025, 3, 18, 18, 0, 0, 0, time.UTC),
+				End:        time.Date(2025, 3, 18, 20, 0, 0, 0, time.UTC),
+				Summary:    "Spring Kickball",
+				Location:   "7201 E Green Lake Dr N, Seattle",
+				Geo:        &Geo{Lat: 47.68, Lng: -122.33},
+				Status:     StatusConfirmed,
+				Recurrence: &Recurrence{Until: &until},
+			},
+			{
+				UID:     "league-1-registration-deadline@leaguefindr.com",
+				Stamp:   time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+				Start:   time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+				End:     time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC),
+				AllDay:  true,
+				Summary: "Registration deadline",
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := calendar.Encode(&buf); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	output := buf.String()
+
+	if !strings.HasPrefix(output, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n") || !strings.HasSuffix(output, "END:VCALENDAR\r\n") {
+		t.Errorf("unexpected calendar framing:\n%s", output)
+	}
+	for _, want := range []string{
+		"X-WR-CALNAME:Spring Kickball\r\n",
+		"DTSTAMP:20250201T203000Z\r\n",
+		"DTSTART:20250318T180000\r\n",
+		"DTEND:20250318T200000\r\n",
+		"RRULE:FREQ=WEEKLY;UNTIL=20250531T235959\r\n",
+		"LOCATION:7201 E Green Lake Dr N\\, Seattle\r\n",
+		"GEO:47.680000;-122.330000\r\n",
+		"STATUS:CONFIRMED\r\n",
+		"DTSTART;VALUE=DATE:20250301\r\n",
+		"DTEND;VALUE=DATE:20250302\r\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in:\n%s", want, output)
+		}
+	}
+	if strings.Count(output, "BEGIN:VEVENT") != 2 || strings.Count(output, "RRULE") != 1 {
+		t.Errorf("expected two events and one recurrence:\n%s", output)
+	}
+}
+
+func TestRecurrenceRule(t *testing.T) {
+	until := time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		recurrence Recurrence
+		allDay     bool
+		want       string
+	}{
+		{Recurrence{Until: &until}, false, "FREQ=WEEKLY;UNTIL=20250531T235959"},
+		{Recurrence{Until: &until}, true, "FREQ=WEEKLY;UNTIL=20250531"},
+		{Recurrence{Count: 8}, false, "FREQ=WEEKLY;COUNT=8"},
+		{Recurrence{}, false, "FREQ=WEEKLY"},
+	}
+	for _, tt := range tests {
+		if got := recurrenceRule(tt.recurrence, tt.allDay); got != tt.want {
+			t.Errorf("recurrenceRule(%+v, %v) = %q, want %q", tt.recurrence, tt.allDay, got, tt.want)
+		}
+	}
+}
+
+func TestEscapeText(t *testing.T) {
+	got := escapeText("Fields 1, 2; bring\\cleats\r\nand water")
+	want := `Fields 1\, 2\; bring\\cleats\nand water`
+	if got != want {
+		t.Errorf("escapeText = %q, want %q", got, want)
+	}
+}
+
+func TestFold(t *testing.T) {
+	line := "DESCRIPTION:" + strings.Repeat("é", 100)
+	parts := fold(line)
+	if len(parts) < 2 {
+		t.Fatalf("expected the line to be folded, got %d parts", len(parts))
+	}
+
+	var joined strings.Builder
+	for i, part := range parts {
+		if len(part) > maxLineOctets {
+			t.Errorf("part %d is %d octets", i, len(part))
+		}
+		if i > 0 {
+			if !strings.HasPrefix(part, " ") {
+				t.Errorf("continuation line %d does not start with a space: %q", i, part)
+			}
+			part = part[1:]
+		}
+		joined.WriteString(part)
+	}
+	if joined.String() != line {
+		t.Errorf("unfolded line does not match the original")
+	}
+	for _, part := range parts {
+		if !utf8.ValidString(part) {
+			t.Errorf("a multi-byte character was split: %q", part)
+		}
+	}
+
+	if short := fold("SUMMARY:Kickball"); len(short) != 1 {
+		t.Errorf("expected a short line not to be folded, got %q", short)
+	}
+}
+
+func TestEncodeExceptions(t *testing.T) {
+	until := time.Date(2025, 4, 15, 0, 0, 0, 0, time.UTC)
+	moved := time.Date(2025, 4, 1, 18, 0, 0, 0, time.UTC)
+	calendar := &Calendar{
+		Events: []Event{
+			{
+				UID:        "league-1-tuesday-1800@leaguefindr.com",
+				Start:      time.Date(2025, 3, 18, 18, 0, 0, 0, time.UTC),
+				End:        time.Date(2025, 3, 18, 20, 0, 0, 0, time.UTC),
+				Summary:    "Spring Kickball",
+				Recurrence: &Recurrence{Until: &until},
+				ExDates:    []time.Time{time.Date(2025, 3, 25, 18, 0, 0, 0, time.UTC), time.Date(2025, 4, 8, 18, 0, 0, 0, time.UTC)},
+			},
+			{
+				UID:          "league-1-tuesday-1800@leaguefindr.com",
+				Start:        time.Date(2025, 4, 3, 19, 0, 0, 0, time.UTC),
+				End:          time.Date(2025, 4, 3, 21, 0, 0, 0, time.UTC),
+				Summary:      "Rescheduled: Spring Kickball",
+				RecurrenceID: &moved,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := calendar.Encode(&buf); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	output := buf.String()
+	for _, want := range []string{
+		"EXDATE:20250325T180000,20250408T180000\r\n",
+		"RECURRENCE-ID:20250401T180000\r\n",
+		"DTSTART:20250403T190000\r\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in:\n%s", want, output)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/jobs"
+)
+
+// JobPurgeKeys is the name of the job deleting expired idempotency keys
+const JobPurgeKeys = "purge_idempotency_keys"
+
+// Purger deletes expired idempotency keys
+type Purger interface {
+	PurgeExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// NewPurgeJob returns the job deleting the keys in store that have expired
+// Expired keys are already replaced on reuse; the job keeps unused ones from piling up
+func NewPurgeJob(store Purger, interval time.Duration) jobs.Job {
+	return jobs.Job{
+		Name:     JobPurgeKeys,
+		Interval: interval,
+		Run: func(ctx context.Context, now time.Time) (jobs.Result, error) {
+			deleted, err := store.PurgeExpired(ctx, now)
+			if err != nil {
+				return jobs.Result{}, err
+			}
+			return jobs.Result{Affected: deleted, Message: fmt.Sprintf("deleted %d expired idempotency keys", deleted)}, nil
+		},
+	}
+}
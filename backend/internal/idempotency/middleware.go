@@ -0,0 +1,164 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// Headers read and written by the middleware
+const (
+	HeaderKey      = "Idempotency-Key"
+	HeaderReplayed = "Idempotent-Replayed"
+)
+
+// maxKeyLength bounds the client key so it can't be used to fill the store
+const maxKeyLength = 255
+
+// Middleware makes a create endpoint safe to retry
+// A request carrying an Idempotency-Key header is handled once; repeats of it within the TTL get the
+// first response back, and a different request reusing the key is rejected
+type Middleware struct {
+	store Store
+	ttl   time.Duration
+	now   func() time.Time
+}
+
+// NewMiddleware creates a middleware that keeps keys in store for ttl
+func NewMiddleware(store Store, ttl time.Duration) *Middleware {
+	return &Middleware{store: store, ttl: ttl, now: time.Now}
+}
+
+// Handler wraps next; a nil middleware leaves requests untouched
+func (m *Middleware) Handler(next http.Handler) http.Handler {
+	if m == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientKey := r.Header.Get(HeaderKey)
+		if clientKey == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(clientKey) > maxKeyLength {
+			shared.WriteProblem(w, r, http.StatusBadRequest, "Idempotency-Key must be at most 255 characters")
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			shared.WriteProblem(w, r, http.StatusBadRequest, "Failed to read request body")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		// Keys are per user, so two users can't collide on (or probe) each other's keys
+		key := r.Header.Get("X-Clerk-User-ID") + ":" + clientKey
+		fingerprint := fingerprintOf(r, body)
+		now := m.now()
+
+		existing, err := m.store.Reserve(r.Context(), key, fingerprint, now, now.Add(m.ttl))
+		if err != nil {
+			slog.Error("reserve idempotency key error", "err", err)
+			shared.WriteProblem(w, r, http.StatusInternalServerError, "Failed to check Idempotency-Key")
+			return
+		}
+
+		if existing != nil {
+			switch {
+			case existing.Fingerprint != fingerprint:
+				shared.WriteError(w, r, shared.Conflict("Idempotency-Key was already used for a different request"), "")
+			case existing.Response == nil:
+				shared.WriteError(w, r, shared.Conflict("A request with this Idempotency-Key is still being processed"), "")
+			default:
+				replay(w, existing.Response)
+			}
+			return
+		}
+
+		// The request ran whatever the client does next, so the store is updated even if it hung up
+		ctx := context.WithoutCancel(r.Context())
+
+		// A handler that panics leaves no response to save, so the key is freed before the panic goes on
+		defer func() {
+			if p := recover(); p != nil {
+				m.release(ctx, key)
+				panic(p)
+			}
+		}()
+
+		recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		// Server errors may be transient, so the key is freed and a retry runs the request again
+		if recorder.status >= http.StatusInternalServerError {
+			m.release(ctx, key)
+			return
+		}
+
+		response := &Response{
+			Status:      recorder.status,
+			ContentType: recorder.Header().Get("Content-Type"),
+			Body:        recorder.body.String(),
+		}
+		if err := m.store.Complete(ctx, key, response); err != nil {
+			slog.Error("save idempotent response error", "err", err)
+		}
+	})
+}
+
+// release frees a reserved key so a retry runs the request again
+func (m *Middleware) release(ctx context.Context, key string) {
+	if err := m.store.Release(ctx, key); err != nil {
+		slog.Error("release idempotency key error", "err", err)
+	}
+}
+
+// fingerprintOf hashes what makes two requests the same: method, path, query and body
+// The query is canonicalised (parameters sorted by name) since endpoints take arguments such as org_id there
+func fingerprintOf(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.Path + "?" + r.URL.Query().Encode() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// replay writes a saved response
+func replay(w http.ResponseWriter, response *Response) {
+	if response.ContentType != "" {
+		w.Header().Set("Content-Type", response.ContentType)
+	}
+	w.Header().Set(HeaderReplayed, "true")
+	w.WriteHeader(response.Status)
+	w.Write([]byte(response.Body))
+}
+
+// responseRecorder passes a response through while keeping a copy of it
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,214 @@
+package idempotency
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// countingHandler creates a resource per call, answering with the given status
+type countingHandler struct {
+	calls  int
+	status int
+}
+
+func (h *countingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.calls++
+	body, _ := io.ReadAll(r.Body)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(h.status)
+	w.Write([]byte(`{"call":` + strconv.Itoa(h.calls) + `,"request":` + string(body) + `}`))
+}
+
+func newTestMiddleware(now *time.Time) (*Middleware, *MemoryStore) {
+	store := NewMemoryStore()
+	m := NewMiddleware(store, time.Hour)
+	m.now = func() time.Time { return *now }
+	return m, store
+}
+
+func send(handler http.Handler, user string, key string, body string) *httptest.ResponseRecorder {
+	return sendTo(handler, "/v1/leagues/", user, key, body)
+}
+
+func sendTo(handler http.Handler, target string, user string, key string, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	r.Header.Set("X-Clerk-User-ID", user)
+	if key != "" {
+		r.Header.Set(HeaderKey, key)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestMiddlewareReplaysResponse(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	m, _ := newTestMiddleware(&now)
+	next := &countingHandler{status: http.StatusCreated}
+	handler := m.Handler(next)
+
+	first := send(handler, "user-1", "abc", `{"name":"Kickball"}`)
+	second := send(handler, "user-1", "abc", `{"name":"Kickball"}`)
+
+	if next.calls != 1 {
+		t.Fatalf("expected the handler to run once, ran %d times", next.calls)
+	}
+	if second.Code != http.StatusCreated || second.Body.String() != first.Body.String() {
+		t.Errorf("expected the first response to be replayed, got %d %s", second.Code, second.Body.String())
+	}
+	if second.Header().Get(HeaderReplayed) != "true" || second.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("unexpected replay headers %v", second.Header())
+	}
+	if first.Header().Get(HeaderReplayed) != "" {
+		t.Errorf("expected the first response not to be marked as replayed")
+	}
+
+	// Keys are scoped by user
+	send(handler, "user-2", "abc", `{"name":"Kickball"}`)
+	if next.calls != 2 {
+		t.Errorf("expected another user's key not to replay, handler ran %d times", next.calls)
+	}
+}
+
+func TestMiddlewareRejectsDifferentRequest(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	m, _ := newTestMiddleware(&now)
+	next := &countingHandler{status: http.StatusCreated}
+	handler := m.Handler(next)
+
+	send(handler, "user-1", "abc", `{"name":"Kickball"}`)
+	w := send(handler, "user-1", "abc", `{"name":"Dodgeball"}`)
+
+	if w.Code != http.StatusConflict || next.calls != 1 {
+		t.Errorf("expected 409 without running the handler, got %d after %d calls", w.Code, next.calls)
+	}
+	if !strings.Contains(w.Body.String(), "different request") {
+		t.Errorf("expected a problem explaining the mismatch, got %s", w.Body.String())
+	}
+}
+
+func TestMiddlewareFingerprintsQuery(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	m, _ := newTestMiddleware(&now)
+	next := &countingHandler{status: http.StatusCreated}
+	handler := m.Handler(next)
+
+	sendTo(handler, "/v1/leagues/?org_id=org-1&dry_run=true", "user-1", "abc", `{}`)
+
+	// The same parameters in another order are the same request
+	if w := sendTo(handler, "/v1/leagues/?dry_run=true&org_id=org-1", "user-1", "abc", `{}`); w.Code != http.StatusCreated || next.calls != 1 {
+		t.Errorf("expected the reordered query to replay, got %d after %d calls", w.Code, next.calls)
+	}
+	if w := sendTo(handler, "/v1/leagues/?org_id=org-2&dry_run=true", "user-1", "abc", `{}`); w.Code != http.StatusConflict || next.calls != 1 {
+		t.Errorf("expected 409 for another organization, got %d after %d calls", w.Code, next.calls)
+	}
+}
+
+func TestMiddlewareRejectsKeyInProgress(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	m, store := newTestMiddleware(&now)
+	next := &countingHandler{status: http.StatusCreated}
+	handler := m.Handler(next)
+
+	// Another request holds the key but hasn't finished yet
+	r := httptest.NewRequest(http.MethodPost, "/v1/leagues/", strings.NewReader(`{}`))
+	store.Reserve(context.Background(), "user-1:abc", fingerprintOf(r, []byte(`{}`)), now, now.Add(time.Hour))
+
+	w := send(handler, "user-1", "abc", `{}`)
+	if w.Code != http.StatusConflict || next.calls != 0 {
+		t.Errorf("expected 409 while the first request runs, got %d after %d calls", w.Code, next.calls)
+	}
+}
+
+func TestMiddlewareKeysExpire(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	m, store := newTestMiddleware(&now)
+	next := &countingHandler{status: http.StatusCreated}
+	handler := m.Handler(next)
+
+	send(handler, "user-1", "abc", `{"name":"Kickball"}`)
+
+	now = now.Add(time.Hour)
+	w := send(handler, "user-1", "abc", `{"name":"Dodgeball"}`)
+	if w.Code != http.StatusCreated || next.calls != 2 || w.Header().Get(HeaderReplayed) != "" {
+		t.Errorf("expected an expired key to run the request again, got %d after %d calls", w.Code, next.calls)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if deleted, _ := store.PurgeExpired(context.Background(), now); deleted != 1 {
+		t.Errorf("expected the expired key to be purged, deleted %d", deleted)
+	}
+}
+
+func TestMiddlewareReleasesKeyOnServerError(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	m, _ := newTestMiddleware(&now)
+	next := &countingHandler{status: http.StatusInternalServerError}
+	handler := m.Handler(next)
+
+	send(handler, "user-1", "abc", `{}`)
+	next.status = http.StatusCreated
+	w := send(handler, "user-1", "abc", `{}`)
+
+	if w.Code != http.StatusCreated || next.calls != 2 {
+		t.Errorf("expected the retry after a server error to run, got %d after %d calls", w.Code, next.calls)
+	}
+
+	// Client errors are final, so they replay like successes
+	next.status = http.StatusBadRequest
+	send(handler, "user-1", "def", `{}`)
+	next.status = http.StatusCreated
+	if w := send(handler, "user-1", "def", `{}`); w.Code != http.StatusBadRequest || next.calls != 3 {
+		t.Errorf("expected the client error to be replayed, got %d after %d calls", w.Code, next.calls)
+	}
+}
+
+func TestMiddlewareReleasesKeyOnPanic(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	m, _ := newTestMiddleware(&now)
+	next := &countingHandler{status: http.StatusCreated}
+	panicking := m.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected the panic to reach the caller")
+			}
+		}()
+		send(panicking, "user-1", "abc", `{}`)
+	}()
+
+	if w := send(m.Handler(next), "user-1", "abc", `{}`); w.Code != http.StatusCreated || next.calls != 1 {
+		t.Errorf("expected the retry after a panic to run, got %d after %d calls", w.Code, next.calls)
+	}
+}
+
+func TestMiddlewareWithoutKey(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	m, _ := newTestMiddleware(&now)
+	next := &countingHandler{status: http.StatusCreated}
+
+	send(m.Handler(next), "user-1", "", `{}`)
+	send(m.Handler(next), "user-1", "", `{}`)
+	if next.calls != 2 {
+		t.Errorf("expected requests without a key to always run, ran %d times", next.calls)
+	}
+
+	var disabled *Middleware
+	send(disabled.Handler(next), "user-1", "abc", `{}`)
+	if next.calls != 3 {
+		t.Errorf("expected a nil middleware to pass requests through")
+	}
+
+	if w := send(m.Handler(next), "user-1", strings.Repeat("k", 256), `{}`); w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an oversized key, got %d", w.Code)
+	}
+}
@@ -0,0 +1,191 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/database"
+	"github.com/supabase-community/postgrest-go"
+)
+
+// Response is a response saved for replay
+type Response struct {
+	Status      int    `json:"response_status"`
+	ContentType string `json:"response_content_type"`
+	Body        string `json:"response_body"`
+}
+
+// Record is a reserved idempotency key
+type Record struct {
+	Key         string    `json:"key"`
+	Fingerprint string    `json:"fingerprint"` // Hash of the request that reserved the key
+	Response    *Response `json:"-"`           // Nil while the first request is still being handled
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// Store keeps idempotency keys and the responses saved for them
+type Store interface {
+	// Reserve claims key for a request with the given fingerprint until the given time
+	// It returns nil if the key was free or had expired, otherwise the record already holding it
+	Reserve(ctx context.Context, key string, fingerprint string, now time.Time, until time.Time) (*Record, error)
+	// Complete saves the response to replay for a reserved key
+	Complete(ctx context.Context, key string, response *Response) error
+	// Release frees a reserved key so the request can be retried
+	Release(ctx context.Context, key string) error
+}
+
+// PostgresStore keeps idempotency keys in the idempotency_keys table
+// It needs a service-role client because the table is not exposed to users
+type PostgresStore struct {
+	client *postgrest.Client
+}
+
+// NewPostgresStore creates a store backed by the given PostgREST client
+func NewPostgresStore(client *postgrest.Client) *PostgresStore {
+	return &PostgresStore{client: client}
+}
+
+// storedRecord is a row of idempotency_keys
+type storedRecord struct {
+	Record
+	ResponseStatus      *int    `json:"response_status"`
+	ResponseContentType *string `json:"response_content_type"`
+	ResponseBody        *string `json:"response_body"`
+}
+
+// Reserve calls reserve_idempotency_key, which claims the key atomically
+func (s *PostgresStore) Reserve(ctx context.Context, key string, fingerprint string, now time.Time, until time.Time) (*Record, error) {
+	body, err := s.client.RpcWithError("reserve_idempotency_key", "", map[string]interface{}{
+		"idem_key":         key,
+		"idem_fingerprint": fingerprint,
+		"idem_now":         now.UTC().Format(time.RFC3339Nano),
+		"idem_until":       until.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	var stored *storedRecord
+	if err := json.Unmarshal([]byte(body), &stored); err != nil {
+		return nil, database.RPCError("failed to reserve idempotency key", body, err)
+	}
+	if stored == nil {
+		return nil, nil
+	}
+	if stored.Key == "" {
+		return nil, database.RPCError("failed to reserve idempotency key", body, fmt.Errorf("unexpected response %s", body))
+	}
+
+	record := stored.Record
+	if stored.ResponseStatus != nil {
+		record.Response = &Response{Status: *stored.ResponseStatus}
+		if stored.ResponseContentType != nil {
+			record.Response.ContentType = *stored.ResponseContentType
+		}
+		if stored.ResponseBody != nil {
+			record.Response.Body = *stored.ResponseBody
+		}
+	}
+	return &record, nil
+}
+
+// Complete stores the response on the key's row
+func (s *PostgresStore) Complete(ctx context.Context, key string, response *Response) error {
+	var result []storedRecord
+	_, err := s.client.From("idempotency_keys").
+		Update(response, "representation", "").
+		Eq("key", key).
+		ExecuteToWithContext(ctx, &result)
+	if err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+	return nil
+}
+
+// Release deletes the key's row
+func (s *PostgresStore) Release(ctx context.Context, key string) error {
+	_, _, err := s.client.From("idempotency_keys").
+		Delete("minimal", "").
+		Eq("key", key).
+		ExecuteWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// PurgeExpired deletes the keys that expired before now and returns how many were deleted
+func (s *PostgresStore) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	var deleted []storedRecord
+	_, err := s.client.From("idempotency_keys").
+		Delete("representation", "").
+		Lte("expires_at", now.UTC().Format(time.RFC3339Nano)).
+		ExecuteToWithContext(ctx, &deleted)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge idempotency keys: %w", err)
+	}
+	return len(deleted), nil
+}
+
+// MemoryStore is an in-memory Store for tests and single-process development
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]Record)}
+}
+
+// Reserve claims the key if it is free or expired
+func (m *MemoryStore) Reserve(ctx context.Context, key string, fingerprint string, now time.Time, until time.Time) (*Record, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if record, ok := m.records[key]; ok && record.ExpiresAt.After(now) {
+		return &record, nil
+	}
+	m.records[key] = Record{Key: key, Fingerprint: fingerprint, ExpiresAt: until}
+	return nil, nil
+}
+
+// Complete saves the response if the key is still reserved
+func (m *MemoryStore) Complete(ctx context.Context, key string, response *Response) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if record, ok := m.records[key]; ok {
+		saved := *response
+		record.Response = &saved
+		m.records[key] = record
+	}
+	return nil
+}
+
+// Release frees the key
+func (m *MemoryStore) Release(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.records, key)
+	return nil
+}
+
+// PurgeExpired deletes the keys that expired before now and returns how many were deleted
+func (m *MemoryStore) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deleted := 0
+	for key, record := range m.records {
+		if !record.ExpiresAt.After(now) {
+			delete(m.records, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,263 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Clock tells the runner and its jobs what time it is, so tests can control it
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the wall clock
+type SystemClock struct{}
+
+// Now returns the current time
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// ClockFunc adapts a function to the Clock interface
+type ClockFunc func() time.Time
+
+// Now calls the function
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// Result summarizes what a job run did
+type Result struct {
+	Affected int    // Rows changed
+	Message  string // Optional human-readable summary
+}
+
+// Job is a unit of scheduled work
+// Run receives the runner's clock time so jobs never read the wall clock themselves
+type Job struct {
+	Name     string
+	Interval time.Duration // How often the job runs; also the length of its lease
+	Run      func(ctx context.Context, now time.Time) (Result, error)
+}
+
+// RunStatus is the outcome of a job run
+type RunStatus string
+
+const (
+	RunSucceeded RunStatus = "succeeded"
+	RunFailed    RunStatus = "failed"
+)
+
+// Run is a row in the job run history
+type Run struct {
+	ID         int64      `json:"id,omitempty"`
+	JobName    string     `json:"job_name"`
+	Owner      string     `json:"owner"`
+	Status     RunStatus  `json:"status"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+	Affected   int        `json:"affected"`
+	Message    *string    `json:"message"`
+	Error      *string    `json:"error"`
+}
+
+// Locker hands out per-job leases so that only one worker runs a job at a time
+type Locker interface {
+	// TryLock takes the lease on a job until the given time if it is free, expired or already held by owner
+	TryLock(ctx context.Context, name string, owner string, now time.Time, until time.Time) (bool, error)
+	// Unlock releases a lease held by owner
+	Unlock(ctx context.Context, name string, owner string) error
+}
+
+// RunStore records job run history
+type RunStore interface {
+	RecordRun(ctx context.Context, run *Run) error
+}
+
+// Runner runs registered jobs on their intervals
+// Several runners (one per worker process) can share a Locker; each job then runs on one worker per interval
+type Runner struct {
+	clock   Clock
+	locker  Locker
+	runs    RunStore
+	owner   string
+	mu      sync.Mutex
+	jobs    []Job
+	nextRun map[string]time.Time
+}
+
+// NewRunner creates a runner identified by owner (e.g. hostname and PID)
+func NewRunner(clock Clock, locker Locker, runs RunStore, owner string) *Runner {
+	return &Runner{
+		clock:   clock,
+		locker:  locker,
+		runs:    runs,
+		owner:   owner,
+		nextRun: make(map[string]time.Time),
+	}
+}
+
+// Register adds a job to the runner
+func (r *Runner) Register(job Job) error {
+	if job.Name == "" {
+		return fmt.Errorf("job name is required")
+	}
+	if job.Interval <= 0 {
+		return fmt.Errorf("job %s: interval must be positive", job.Name)
+	}
+	if job.Run == nil {
+		return fmt.Errorf("job %s: run function is required", job.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.jobs {
+		if existing.Name == job.Name {
+			return fmt.Errorf("job %s is already registered", job.Name)
+		}
+	}
+	r.jobs = append(r.jobs, job)
+	return nil
+}
+
+// Jobs returns the names of the registered jobs in registration order
+func (r *Runner) Jobs() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, len(r.jobs))
+	for i, job := range r.jobs {
+		names[i] = job.Name
+	}
+	return names
+}
+
+// RunDue runs every job whose interval has elapsed since this runner last ran it
+// Jobs whose lease is held by another worker are skipped and retried on the next call
+func (r *Runner) RunDue(ctx context.Context) []Run {
+	return r.run(ctx, false)
+}
+
+// RunAll runs every job once regardless of when it last ran on this runner (leases still apply)
+func (r *Runner) RunAll(ctx context.Context) []Run {
+	return r.run(ctx, true)
+}
+
+// Start calls RunDue immediately and then every tick until ctx is cancelled
+func (r *Runner) Start(ctx context.Context, tick time.Duration) {
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	for {
+		r.RunDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Runner) run(ctx context.Context, all bool) []Run {
+	r.mu.Lock()
+	jobs := make([]Job, len(r.jobs))
+	copy(jobs, r.jobs)
+	r.mu.Unlock()
+
+	var runs []Run
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			break
+		}
+
+		now := r.clock.Now()
+		if !all && now.Before(r.getNextRun(job.Name)) {
+			continue
+		}
+
+		run, ran := r.runJob(ctx, job, now)
+		if ran {
+			runs = append(runs, *run)
+		}
+	}
+	return runs
+}
+
+// runJob takes the job's lease, runs it and records the run
+// Returns false if another worker holds the lease
+func (r *Runner) runJob(ctx context.Context, job Job, now time.Time) (*Run, bool) {
+	acquired, err := r.locker.TryLock(ctx, job.Name, r.owner, now, now.Add(job.Interval))
+	if err != nil {
+		slog.Error("failed to acquire job lock", "job", job.Name, "owner", r.owner, "err", err)
+		return nil, false
+	}
+	if !acquired {
+		slog.Debug("job lock held by another worker", "job", job.Name, "owner", r.owner)
+		return nil, false
+	}
+
+	run := &Run{
+		JobName:   job.Name,
+		Owner:     r.owner,
+		StartedAt: now,
+	}
+
+	result, err := r.execute(ctx, job, now)
+
+	finished := r.clock.Now()
+	run.FinishedAt = &finished
+	run.Affected = result.Affected
+	if result.Message != "" {
+		message := result.Message
+		run.Message = &message
+	}
+
+	if err != nil {
+		run.Status = RunFailed
+		errMessage := err.Error()
+		run.Error = &errMessage
+		slog.Error("job failed", "job", job.Name, "owner", r.owner, "err", err)
+
+		// Give the lease back so the next tick (on any worker) retries
+		if unlockErr := r.locker.Unlock(ctx, job.Name, r.owner); unlockErr != nil {
+			slog.Error("failed to release job lock", "job", job.Name, "owner", r.owner, "err", unlockErr)
+		}
+	} else {
+		run.Status = RunSucceeded
+		slog.Info("job succeeded", "job", job.Name, "owner", r.owner, "affected", result.Affected)
+
+		// Keep the lease for the rest of the interval so other workers skip this job
+		r.setNextRun(job.Name, now.Add(job.Interval))
+	}
+
+	if recordErr := r.runs.RecordRun(ctx, run); recordErr != nil {
+		slog.Error("failed to record job run", "job", job.Name, "err", recordErr)
+	}
+
+	return run, true
+}
+
+// execute runs the job, turning a panic into an error so one bad job doesn't stop the worker
+func (r *Runner) execute(ctx context.Context, job Job, now time.Time) (result Result, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("job panicked: %v", recovered)
+		}
+	}()
+	return job.Run(ctx, now)
+}
+
+func (r *Runner) getNextRun(name string) time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.nextRun[name]
+}
+
+func (r *Runner) setNextRun(name string, next time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextRun[name] = next
+}
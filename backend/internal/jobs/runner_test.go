@@ -0,0 +1,160 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock the test moves by hand
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func countingJob(name string, interval time.Duration, calls *int, err error) Job {
+	return Job{
+		Name:     name,
+		Interval: interval,
+		Run: func(ctx context.Context, now time.Time) (Result, error) {
+			*calls++
+			return Result{Affected: 1}, err
+		},
+	}
+}
+
+func TestRunnerRunDueRespectsInterval(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	runner := NewRunner(clock, store, store, "worker-1")
+
+	calls := 0
+	if err := runner.Register(countingJob("close_registration", time.Hour, &calls, nil)); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	runner.RunDue(context.Background())
+	clock.Advance(30 * time.Minute)
+	runner.RunDue(context.Background())
+	if calls != 1 {
+		t.Fatalf("expected 1 run within the interval, got %d", calls)
+	}
+
+	clock.Advance(30 * time.Minute)
+	runner.RunDue(context.Background())
+	if calls != 2 {
+		t.Fatalf("expected a second run after the interval, got %d", calls)
+	}
+
+	runs := store.Runs()
+	if len(runs) != 2 || runs[0].Status != RunSucceeded || runs[0].Owner != "worker-1" || runs[0].Affected != 1 {
+		t.Errorf("unexpected run history: %+v", runs)
+	}
+	if !runs[1].StartedAt.Equal(clock.Now()) {
+		t.Errorf("expected run to start at the injected clock time, got %v", runs[1].StartedAt)
+	}
+}
+
+func TestRunnerLeaseSharedAcrossWorkers(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	first := NewRunner(clock, store, store, "worker-1")
+	second := NewRunner(clock, store, store, "worker-2")
+
+	calls := 0
+	first.Register(countingJob("complete_seasons", time.Hour, &calls, nil))
+	second.Register(countingJob("complete_seasons", time.Hour, &calls, nil))
+
+	first.RunDue(context.Background())
+	if runs := second.RunDue(context.Background()); len(runs) != 0 {
+		t.Fatalf("expected second worker to skip a leased job, got %+v", runs)
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 run across both workers, got %d", calls)
+	}
+
+	// Once the lease expires either worker may take it
+	clock.Advance(time.Hour)
+	if runs := second.RunDue(context.Background()); len(runs) != 1 || runs[0].Owner != "worker-2" {
+		t.Fatalf("expected second worker to run after the lease expired, got %+v", runs)
+	}
+}
+
+func TestRunnerFailureReleasesLease(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	first := NewRunner(clock, store, store, "worker-1")
+	second := NewRunner(clock, store, store, "worker-2")
+
+	failing := 0
+	first.Register(countingJob("purge_stale_drafts", time.Hour, &failing, errors.New("database unavailable")))
+	succeeding := 0
+	second.Register(countingJob("purge_stale_drafts", time.Hour, &succeeding, nil))
+
+	runs := first.RunDue(context.Background())
+	if len(runs) != 1 || runs[0].Status != RunFailed || runs[0].Error == nil || *runs[0].Error != "database unavailable" {
+		t.Fatalf("expected a failed run, got %+v", runs)
+	}
+
+	second.RunDue(context.Background())
+	if succeeding != 1 {
+		t.Fatalf("expected another worker to retry right away after a failure, got %d runs", succeeding)
+	}
+}
+
+func TestRunnerRecoversPanics(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	runner := NewRunner(clock, store, store, "worker-1")
+
+	runner.Register(Job{
+		Name:     "broken",
+		Interval: time.Hour,
+		Run: func(ctx context.Context, now time.Time) (Result, error) {
+			panic("nil map")
+		},
+	})
+	calls := 0
+	runner.Register(countingJob("after_broken", time.Hour, &calls, nil))
+
+	runs := runner.RunAll(context.Background())
+	if len(runs) != 2 || runs[0].Status != RunFailed || runs[1].Status != RunSucceeded {
+		t.Fatalf("expected the panic to fail only its own job, got %+v", runs)
+	}
+}
+
+func TestRunnerRegisterValidation(t *testing.T) {
+	runner := NewRunner(SystemClock{}, NewMemoryStore(), NewMemoryStore(), "worker-1")
+	noop := func(ctx context.Context, now time.Time) (Result, error) { return Result{}, nil }
+
+	tests := []struct {
+		name string
+		job  Job
+	}{
+		{"missing name", Job{Interval: time.Hour, Run: noop}},
+		{"zero interval", Job{Name: "a", Run: noop}},
+		{"missing run", Job{Name: "a", Interval: time.Hour}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := runner.Register(tt.job); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+
+	if err := runner.Register(Job{Name: "a", Interval: time.Hour, Run: noop}); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if err := runner.Register(Job{Name: "a", Interval: time.Hour, Run: noop}); err == nil {
+		t.Errorf("expected an error registering a duplicate job")
+	}
+}
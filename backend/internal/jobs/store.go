@@ -0,0 +1,152 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/database"
+	"github.com/supabase-community/postgrest-go"
+)
+
+// PostgresStore keeps job leases and run history in the job_locks and job_runs tables
+// It needs a service-role client because those tables are not exposed to users
+type PostgresStore struct {
+	client *postgrest.Client
+}
+
+// NewPostgresStore creates a store backed by the given PostgREST client
+func NewPostgresStore(client *postgrest.Client) *PostgresStore {
+	return &PostgresStore{client: client}
+}
+
+// TryLock calls try_acquire_job_lock, which takes the lease atomically
+func (s *PostgresStore) TryLock(ctx context.Context, name string, owner string, now time.Time, until time.Time) (bool, error) {
+	body, err := s.client.RpcWithError("try_acquire_job_lock", "", map[string]interface{}{
+		"lock_name":  name,
+		"lock_owner": owner,
+		"lock_now":   now.UTC().Format(time.RFC3339Nano),
+		"lock_until": until.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire job lock: %w", err)
+	}
+
+	var acquired bool
+	if err := json.Unmarshal([]byte(body), &acquired); err != nil {
+		return false, database.RPCError("failed to acquire job lock", body, err)
+	}
+	return acquired, nil
+}
+
+// Unlock calls release_job_lock
+func (s *PostgresStore) Unlock(ctx context.Context, name string, owner string) error {
+	body, err := s.client.RpcWithError("release_job_lock", "", map[string]interface{}{
+		"lock_name":  name,
+		"lock_owner": owner,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release job lock: %w", err)
+	}
+	// A void function returns an empty body on success
+	if body != "" && body != "null" {
+		var rpcErr struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal([]byte(body), &rpcErr) == nil && rpcErr.Message != "" {
+			return fmt.Errorf("failed to release job lock: %s", rpcErr.Message)
+		}
+	}
+	return nil
+}
+
+// RecordRun inserts a row into job_runs
+func (s *PostgresStore) RecordRun(ctx context.Context, run *Run) error {
+	insertData := map[string]interface{}{
+		"job_name":    run.JobName,
+		"owner":       run.Owner,
+		"status":      run.Status,
+		"started_at":  run.StartedAt,
+		"finished_at": run.FinishedAt,
+		"affected":    run.Affected,
+		"message":     run.Message,
+		"error":       run.Error,
+	}
+
+	var result []Run
+	_, err := s.client.From("job_runs").
+		Insert(insertData, false, "", "representation", "").
+		ExecuteToWithContext(ctx, &result)
+
+	if err != nil {
+		return fmt.Errorf("failed to record job run: %w", err)
+	}
+
+	if len(result) > 0 {
+		run.ID = result[0].ID
+	}
+
+	return nil
+}
+
+// MemoryStore is an in-memory Locker and RunStore for tests and single-process development
+type MemoryStore struct {
+	mu    sync.Mutex
+	locks map[string]memoryLock
+	runs  []Run
+}
+
+type memoryLock struct {
+	owner string
+	until time.Time
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{locks: make(map[string]memoryLock)}
+}
+
+// TryLock takes the lease if it is free, expired or already held by owner
+func (m *MemoryStore) TryLock(ctx context.Context, name string, owner string, now time.Time, until time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if lock, ok := m.locks[name]; ok && lock.owner != owner && lock.until.After(now) {
+		return false, nil
+	}
+	m.locks[name] = memoryLock{owner: owner, until: until}
+	return true, nil
+}
+
+// Unlock releases the lease if owner holds it
+func (m *MemoryStore) Unlock(ctx context.Context, name string, owner string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if lock, ok := m.locks[name]; ok && lock.owner == owner {
+		delete(m.locks, name)
+	}
+	return nil
+}
+
+// RecordRun appends a run to the history
+func (m *MemoryStore) RecordRun(ctx context.Context, run *Run) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	run.ID = int64(len(m.runs) + 1)
+	m.runs = append(m.runs, *run)
+	return nil
+}
+
+// Runs returns a copy of the recorded history, oldest first
+func (m *MemoryStore) Runs() []Run {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	runs := make([]Run, len(m.runs))
+	copy(runs, m.runs)
+	return runs
+}
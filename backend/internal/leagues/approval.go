@@ -0,0 +1,171 @@
+package leagues
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/leaguefindr/backend/internal/shared"
+	"github.com/leaguefindr/backend/internal/sports"
+	"github.com/leaguefindr/backend/internal/venues"
+)
+
+// newLeagueApproval describes approving league with the given column updates
+// A sport or venue the organizer submitted by name (form_data) is found or created by the repository
+func newLeagueApproval(league *League, fields map[string]interface{}) *LeagueApproval {
+	approval := &LeagueApproval{
+		Fields:          fields,
+		SportID:         league.SportID,
+		VenueID:         league.VenueID,
+		GameOccurrences: occurrencesOf(league),
+	}
+
+	if league.SportID == nil {
+		if sportName, ok := league.FormData["sport_name"].(string); ok && sportName != "" {
+			approval.Sport = &SupplementalSport{Name: sportName}
+		}
+	}
+
+	if league.VenueID == nil {
+		venueName, _ := league.FormData["venue_name"].(string)
+		venueAddress, _ := league.FormData["venue_address"].(string)
+		if venueName != "" || venueAddress != "" {
+			approval.Venue = &SupplementalVenue{Name: venueName, Address: venueAddress}
+			if lat, ok := league.FormData["venue_lat"].(float64); ok {
+				approval.Venue.Lat = &lat
+			}
+			if lng, ok := league.FormData["venue_lng"].(float64); ok {
+				approval.Venue.Lng = &lng
+			}
+		}
+	}
+
+	return approval
+}
+
+// approvalColumns returns the league columns an approval sets, including the resolved sport and venue
+func approvalColumns(approval *LeagueApproval) map[string]interface{} {
+	columns := make(map[string]interface{}, len(approval.Fields)+2)
+	for column, value := range approval.Fields {
+		columns[column] = value
+	}
+	if approval.SportID != nil {
+		columns["sport_id"] = *approval.SportID
+	}
+	if approval.VenueID != nil {
+		columns["venue_id"] = *approval.VenueID
+	}
+	return columns
+}
+
+// occurrencesByDay keeps the first game time of each day
+// The game_occurrences table holds one row per league and day
+func occurrencesByDay(occurrences GameOccurrences) GameOccurrences {
+	seen := make(map[string]bool, len(occurrences))
+	var unique GameOccurrences
+	for _, occurrence := range occurrences {
+		if !seen[occurrence.Day] {
+			seen[occurrence.Day] = true
+			unique = append(unique, occurrence)
+		}
+	}
+	return unique
+}
+
+// resolveApprovalEntities finds or creates the sport and venue of an approval through their repositories
+// Used by the stores that cannot do it in the same transaction as the rest of the approval
+func resolveApprovalEntities(ctx context.Context, approval *LeagueApproval, sportsRepo sports.RepositoryInterface, venuesRepo venues.RepositoryInterface) error {
+	if approval.SportID == nil && approval.Sport != nil {
+		sport, err := sports.NewServiceWithRepository(sportsRepo).CreateSport(ctx, &sports.CreateSportRequest{
+			Name: approval.Sport.Name,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create sport: %w", err)
+		}
+		approval.SportID = &sport.ID
+	}
+
+	if approval.VenueID == nil && approval.Venue != nil {
+		venueReq := &venues.CreateVenueRequest{
+			Name:    approval.Venue.Name,
+			Address: approval.Venue.Address,
+		}
+		if approval.Venue.Lat != nil {
+			venueReq.Lat = *approval.Venue.Lat
+		}
+		if approval.Venue.Lng != nil {
+			venueReq.Lng = *approval.Venue.Lng
+		}
+		venue, err := venues.NewServiceWithRepository(venuesRepo).CreateVenue(ctx, venueReq)
+		if err != nil {
+			return fmt.Errorf("failed to create venue: %w", err)
+		}
+		approval.VenueID = &venue.ID
+	}
+
+	return nil
+}
+
+// correctionFields returns the fields an admin can correct while approving: every submitted field except the
+// sport and venue, which are replaced through the sport_id and venue_id overrides so their existence is checked
+func correctionFields() []string {
+	fields := []string{}
+	for _, field := range commentFields() {
+		if field != "sport_id" && field != "venue_id" && field != "pricing_per_player" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// correctedLeagueRequest applies an admin's corrections to the submission stored in a league's form_data
+// The sport and venue overrides, if given, replace the submitted names so no supplemental sport or venue is created
+// Returns a shared Validation error if a correction is not a league field or leaves the submission invalid
+func correctedLeagueRequest(league *League, request *ApproveLeagueRequest, sport *sports.Sport, venue *venues.Venue) (*CreateLeagueRequest, error) {
+	formData, err := copyFormData(league.FormData)
+	if err != nil {
+		return nil, err
+	}
+
+	// Report unknown fields in a stable order
+	keys := make([]string, 0, len(request.Changes))
+	for key := range request.Changes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	allowed := correctionFields()
+	var fieldErrors []shared.FieldError
+	for _, key := range keys {
+		if !slices.Contains(allowed, key) {
+			fieldErrors = append(fieldErrors, shared.FieldError{Field: "changes." + key, Message: "cannot be corrected"})
+			continue
+		}
+		formData[key] = request.Changes[key]
+	}
+	if len(fieldErrors) > 0 {
+		return nil, shared.Validation(fieldErrors...)
+	}
+
+	if sport != nil {
+		formData["sport_id"] = sport.ID
+		formData["sport_name"] = sport.Name
+	}
+	if venue != nil {
+		formData["venue_id"] = venue.ID
+		formData["venue_name"] = venue.Name
+		formData["venue_address"] = venue.Address
+		formData["venue_lat"] = venue.Lat
+		formData["venue_lng"] = venue.Lng
+	}
+
+	corrected, err := draftLeagueRequest(formData)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateLeagueRequest(corrected); err != nil {
+		return nil, err
+	}
+	return corrected, nil
+}
@@ -0,0 +1,183 @@
+package leagues
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/shared"
+	"github.com/leaguefindr/backend/internal/sports"
+	"github.com/leaguefindr/backend/internal/venues"
+)
+
+func TestNewLeagueApproval(t *testing.T) {
+	league := &League{
+		FormData: FormData{
+			"sport_name":    "Kickball",
+			"venue_name":    "Magnuson Park",
+			"venue_address": "7400 Sand Point Way NE",
+			"venue_lat":     47.68,
+			"game_occurrences": []interface{}{
+				map[string]interface{}{"day": "Tuesday", "startTime": "18:00", "endTime": "20:00"},
+			},
+		},
+	}
+
+	approval := newLeagueApproval(league, approvedStatusColumns())
+	if approval.Sport == nil || approval.Sport.Name != "Kickball" {
+		t.Errorf("expected the submitted sport, got %+v", approval.Sport)
+	}
+	if approval.Venue == nil || approval.Venue.Address != "7400 Sand Point Way NE" || approval.Venue.Lat == nil || approval.Venue.Lng != nil {
+		t.Errorf("expected the submitted venue with only a latitude, got %+v", approval.Venue)
+	}
+	if len(approval.GameOccurrences) != 1 || approval.GameOccurrences[0].Day != "Tuesday" {
+		t.Errorf("expected the game times from form_data, got %+v", approval.GameOccurrences)
+	}
+
+	// Leagues that already reference a sport and venue don't create them
+	sportID, venueID := int64(3), int64(4)
+	league.SportID, league.VenueID = &sportID, &venueID
+	approval = newLeagueApproval(league, approvedStatusColumns())
+	if approval.Sport != nil || approval.Venue != nil {
+		t.Errorf("expected no sport or venue to create, got %+v %+v", approval.Sport, approval.Venue)
+	}
+	columns := approvalColumns(approval)
+	if columns["sport_id"] != sportID || columns["venue_id"] != venueID || columns["status"] != "approved" {
+		t.Errorf("unexpected columns %v", columns)
+	}
+}
+
+func TestOccurrencesByDay(t *testing.T) {
+	occurrences := occurrencesByDay(GameOccurrences{
+		{Day: "Tuesday", StartTime: "18:00", EndTime: "20:00"},
+		{Day: "Tuesday", StartTime: "20:00", EndTime: "22:00"},
+		{Day: "Thursday", StartTime: "19:00", EndTime: "21:00"},
+	})
+	if len(occurrences) != 2 || occurrences[0].StartTime != "18:00" || occurrences[1].Day != "Thursday" {
+		t.Errorf("expected the first game of each day, got %+v", occurrences)
+	}
+}
+
+func TestMemoryRepositoryApproveLeague(t *testing.T) {
+	ctx := context.Background()
+	sportsRepo := sports.NewMemoryRepository(sports.Sport{ID: 7, Name: "Kickball"})
+	venuesRepo := venues.NewMemoryRepository()
+	repo := NewMemoryRepository(sportsRepo, venuesRepo)
+
+	league := &League{Status: LeagueStatusPending, RejectionReason: stringPtr("Missing venue")}
+	if err := repo.Create(ctx, league); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	approval := &LeagueApproval{
+		Fields: approvedStatusColumns(),
+		Sport:  &SupplementalSport{Name: "kickball"},
+		Venue:  &SupplementalVenue{Name: "Magnuson Park", Address: "7400 Sand Point Way NE"},
+	}
+	if err := repo.ApproveLeague(ctx, *league.ID, approval); err != nil {
+		t.Fatalf("approve failed: %v", err)
+	}
+
+	// The existing sport is reused and the new venue is created
+	if approval.SportID == nil || *approval.SportID != 7 {
+		t.Errorf("expected the existing sport, got %v", approval.SportID)
+	}
+	created, _ := venuesRepo.GetAll(ctx)
+	if len(created) != 1 || approval.VenueID == nil || *approval.VenueID != created[0].ID {
+		t.Errorf("expected the venue to be created and linked, got %+v %v", created, approval.VenueID)
+	}
+
+	stored, _ := repo.GetByUUID(ctx, *league.ID)
+	if stored.Status != LeagueStatusApproved || stored.RejectionReason != nil || stored.SportID == nil || stored.VenueID == nil {
+		t.Errorf("expected an approved league with its sport and venue, got %+v", stored)
+	}
+
+	// A second decision made from a stale read finds the league already approved
+	if err := repo.ApproveLeague(ctx, *league.ID, &LeagueApproval{Fields: approvedStatusColumns()}); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict approving an approved league, got %v", err)
+	}
+
+	err := repo.ApproveLeague(ctx, "00000000-0000-0000-0000-000000000000", &LeagueApproval{
+		Fields: approvedStatusColumns(),
+		Sport:  &SupplementalSport{Name: "Pickleball"},
+	})
+	if !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected not found for an unknown league, got %v", err)
+	}
+	if all, _ := sportsRepo.GetAll(ctx); len(all) != 1 {
+		t.Errorf("expected no sport to be created for an unknown league, got %+v", all)
+	}
+}
+
+func TestApproveLeagueWithChanges(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	league := env.submitTestLeague(t)
+	venue, err := env.service.venuesService.CreateVenue(ctx, &venues.CreateVenueRequest{Name: "Magnuson Park", Address: "7400 Sand Point Way NE", Lat: 47.68, Lng: -122.26})
+	if err != nil {
+		t.Fatalf("create venue: %v", err)
+	}
+
+	request := &ApproveLeagueRequest{Changes: FormData{"division": "Intermediate"}, VenueID: &venue.ID}
+	changed, err := env.service.ApproveLeagueWithChanges(ctx, "admin", *league.ID, request)
+	if err != nil {
+		t.Fatalf("approve failed: %v", err)
+	}
+	if len(changed) != 6 || changed[0] != "division" || changed[1] != "venue_id" || changed[2] != "venue_name" {
+		t.Errorf("expected the division and venue fields to change, got %v", changed)
+	}
+
+	stored, _ := env.repo.GetByUUID(ctx, *league.ID)
+	if stored.Status != LeagueStatusApproved || *stored.Division != "Intermediate" || stored.VenueID == nil || *stored.VenueID != venue.ID {
+		t.Errorf("expected the corrected league to be approved, got %+v", stored)
+	}
+	if stored.FormData["venue_name"] != "Magnuson Park" {
+		t.Errorf("expected form_data to name the chosen venue, got %v", stored.FormData["venue_name"])
+	}
+	if all, _ := env.service.venuesService.GetAllVenues(ctx); len(all) != 1 {
+		t.Errorf("expected no venue to be created, got %+v", all)
+	}
+
+	history, _ := env.service.GetLeagueHistory(ctx, *league.ID)
+	last := history[len(history)-1]
+	if last.Action != RevisionApproved || len(last.ChangedFields) != len(changed) || last.Note == nil {
+		t.Errorf("expected the corrections on the approval revision, got %+v", last)
+	}
+	received, _, _, _ := env.notifications.GetNotifications(ctx, "organizer", pagination.Params{Limit: 10})
+	if len(received) != 1 || !strings.Contains(received[0].Message, "division") {
+		t.Errorf("expected the organizer to be told what changed, got %+v", received)
+	}
+}
+
+func TestApproveLeagueWithInvalidChanges(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	league := env.submitTestLeague(t)
+
+	missing := int64(99)
+	tests := []struct {
+		name    string
+		request *ApproveLeagueRequest
+		field   string
+	}{
+		{"unknown field", &ApproveLeagueRequest{Changes: FormData{"status": "approved"}}, "changes.status"},
+		{"sport through changes", &ApproveLeagueRequest{Changes: FormData{"sport_id": 1}}, "changes.sport_id"},
+		{"invalid value", &ApproveLeagueRequest{Changes: FormData{"season_end_date": "2025-03-01"}}, "season_end_date"},
+		{"wrong type", &ApproveLeagueRequest{Changes: FormData{"duration": "ten"}}, "duration"},
+		{"missing sport", &ApproveLeagueRequest{SportID: &missing}, "sport_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := env.service.ApproveLeagueWithChanges(ctx, "admin", *league.ID, tt.request)
+			if fields := validationFields(t, err); len(fields) != 1 || fields[0].Field != tt.field {
+				t.Errorf("expected a %s error, got %v", tt.field, fields)
+			}
+		})
+	}
+
+	if stored, _ := env.repo.GetByUUID(ctx, *league.ID); stored.Status != LeagueStatusPending {
+		t.Errorf("expected the league to stay pending, got %s", stored.Status)
+	}
+}
@@ -0,0 +1,103 @@
+package leagues
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/leaguefindr/backend/internal/notifications"
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// maxListedLeagues limits how many league names a grouped notification lists before summarizing the rest
+const maxListedLeagues = 5
+
+// bulkReviewResult reports the outcome of one league in a bulk review
+// Domain errors keep their code and message; anything else is reported without its internal detail
+func bulkReviewResult(id string, action BulkReviewAction, err error) BulkReviewResult {
+	if err == nil {
+		status := BulkReviewApproved
+		if action == BulkReviewReject {
+			status = BulkReviewRejected
+		}
+		return BulkReviewResult{ID: id, Status: status}
+	}
+
+	result := BulkReviewResult{ID: id, Status: BulkReviewFailed, Code: shared.CodeInternal, Error: fmt.Sprintf("failed to %s league", action)}
+	if domainErr, ok := shared.AsError(err); ok {
+		result.Code = domainErr.Code
+		if domainErr.Message != "" {
+			result.Error = domainErr.Message
+		}
+	}
+	return result
+}
+
+// groupReviewNotices merges the notices of a bulk review into one per organization, recipient and notification type,
+// in first-seen order, so approved edits aren't reported as new approvals
+// An organizer with a single league decided keeps that league's own notice
+func groupReviewNotices(notices []*reviewNotice, rejectionReason string) []*reviewNotice {
+	var keys []string
+	groups := make(map[string][]*reviewNotice)
+	for _, notice := range notices {
+		if notice == nil {
+			continue
+		}
+		orgID := ""
+		if notice.orgID != nil {
+			orgID = *notice.orgID
+		}
+		key := orgID + "/" + notice.recipient + "/" + notice.notificationType.String()
+		if _, seen := groups[key]; !seen {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], notice)
+	}
+
+	grouped := make([]*reviewNotice, 0, len(keys))
+	for _, key := range keys {
+		group := groups[key]
+		if len(group) == 1 {
+			grouped = append(grouped, group[0])
+			continue
+		}
+
+		names := make([]string, len(group))
+		for i, notice := range group {
+			names[i] = notice.leagueName
+		}
+		notice := &reviewNotice{
+			recipient:        group[0].recipient,
+			orgID:            group[0].orgID,
+			notificationType: group[0].notificationType,
+		}
+		switch notice.notificationType {
+		case notifications.NotificationLeagueUpdateApproved:
+			notice.title = "League Updates Approved"
+			notice.message = fmt.Sprintf("Your changes to %d leagues have been approved and are now live: %s", len(group), listLeagueNames(names))
+		case notifications.NotificationLeagueUpdateRejected:
+			notice.title = "League Updates Rejected"
+			notice.message = fmt.Sprintf("Your changes to %d leagues were rejected: %s. Reason: %s", len(group), listLeagueNames(names), rejectionReason)
+		case notifications.NotificationLeagueRejected:
+			notice.title = "Leagues Rejected"
+			notice.message = fmt.Sprintf("%d of your leagues were rejected: %s. Reason: %s", len(group), listLeagueNames(names), rejectionReason)
+		default:
+			notice.title = "Leagues Approved"
+			notice.message = fmt.Sprintf("%d of your leagues have been approved: %s", len(group), listLeagueNames(names))
+		}
+		grouped = append(grouped, notice)
+	}
+	return grouped
+}
+
+// listLeagueNames quotes league names for a notification, summarizing any past maxListedLeagues
+func listLeagueNames(names []string) string {
+	listed := make([]string, 0, maxListedLeagues+1)
+	for i, name := range names {
+		if i == maxListedLeagues {
+			listed = append(listed, fmt.Sprintf("and %d more", len(names)-maxListedLeagues))
+			break
+		}
+		listed = append(listed, fmt.Sprintf("'%s'", name))
+	}
+	return strings.Join(listed, ", ")
+}
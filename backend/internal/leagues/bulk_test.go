@@ -0,0 +1,208 @@
+package leagues
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/leaguefindr/backend/internal/notifications"
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// countNotifications counts the notifications of a type a user has received
+func (e *testEnv) countNotifications(t *testing.T, userID string, notificationType string) int {
+	t.Helper()
+	count := 0
+	for _, received := range e.notificationTypes(t, userID) {
+		if received == notificationType {
+			count++
+		}
+	}
+	return count
+}
+
+func TestBulkApproveLeagues(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	first := env.submitTestLeague(t)
+	second := env.submitTestLeague(t)
+	decided := env.submitTestLeague(t)
+	if err := env.service.ApproveLeagueByUUID(ctx, "admin", *decided.ID); err != nil {
+		t.Fatalf("approve league: %v", err)
+	}
+	missing := uuid.New().String()
+
+	if _, err := env.service.BulkReviewLeagues(ctx, "organizer", &BulkReviewRequest{IDs: []string{*first.ID}, Action: BulkReviewApprove}); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected forbidden for an organizer, got %v", err)
+	}
+
+	report, err := env.service.BulkReviewLeagues(ctx, "admin", &BulkReviewRequest{
+		IDs:    []string{*first.ID, *decided.ID, missing, *second.ID},
+		Action: BulkReviewApprove,
+	})
+	if err != nil {
+		t.Fatalf("bulk review: %v", err)
+	}
+	if report.Total != 4 || report.Succeeded != 2 || report.Failed != 2 {
+		t.Errorf("expected 2 of 4 approved, got %+v", report)
+	}
+	want := []struct {
+		status BulkReviewItemStatus
+		code   shared.ErrorCode
+	}{
+		{BulkReviewApproved, ""},
+		{BulkReviewFailed, shared.CodeConflict},
+		{BulkReviewFailed, shared.CodeNotFound},
+		{BulkReviewApproved, ""},
+	}
+	for i, result := range report.Results {
+		if result.Status != want[i].status || result.Code != want[i].code {
+			t.Errorf("result %d: expected %s/%s, got %+v", i, want[i].status, want[i].code, result)
+		}
+	}
+	for _, id := range []string{*first.ID, *second.ID} {
+		if stored, _ := env.repo.GetByUUID(ctx, id); stored.Status != LeagueStatusApproved {
+			t.Errorf("expected %s to be approved, got %s", id, stored.Status)
+		}
+	}
+
+	// One notification for the single approval and one for the whole bulk approval
+	if count := env.countNotifications(t, "organizer", "league_approved"); count != 2 {
+		t.Errorf("expected 2 approval notifications, got %d", count)
+	}
+}
+
+func TestBulkRejectLeagues(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	first := env.submitTestLeague(t)
+	second := env.submitTestLeague(t)
+	request := &BulkReviewRequest{IDs: []string{*first.ID, *second.ID}, Action: BulkReviewReject}
+
+	if _, err := env.service.BulkReviewLeagues(ctx, "admin", request); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("expected a validation error without a reason, got %v", err)
+	}
+
+	request.RejectionReason = "Not a recreational league"
+	report, err := env.service.BulkReviewLeagues(ctx, "admin", request)
+	if err != nil {
+		t.Fatalf("bulk review: %v", err)
+	}
+	if report.Succeeded != 2 || report.Results[0].Status != BulkReviewRejected {
+		t.Errorf("expected both leagues rejected, got %+v", report)
+	}
+	stored, _ := env.repo.GetByUUID(ctx, *second.ID)
+	if stored.Status != LeagueStatusRejected || stored.RejectionReason == nil || *stored.RejectionReason != request.RejectionReason {
+		t.Errorf("expected the reason to be stored, got %s/%v", stored.Status, stored.RejectionReason)
+	}
+	if count := env.countNotifications(t, "organizer", "league_rejected"); count != 1 {
+		t.Errorf("expected one grouped rejection notification, got %d", count)
+	}
+}
+
+func TestBulkReviewSkipsLeaguesNotAwaitingReview(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	rejected := env.submitTestLeague(t)
+	if err := env.service.RejectLeagueByUUID(ctx, "admin", *rejected.ID, "Spam"); err != nil {
+		t.Fatalf("reject league: %v", err)
+	}
+	sentBack := env.submitTestLeague(t)
+	if _, err := env.service.RequestLeagueChanges(ctx, "admin", *sentBack.ID, &RequestChangesRequest{Message: "Add a venue"}); err != nil {
+		t.Fatalf("request changes: %v", err)
+	}
+	live, err := env.service.CreateLeague(ctx, "admin", env.orgID, "admin", validTestRequest())
+	if err != nil {
+		t.Fatalf("create league: %v", err)
+	}
+
+	for _, action := range []BulkReviewAction{BulkReviewApprove, BulkReviewReject} {
+		report, err := env.service.BulkReviewLeagues(ctx, "admin", &BulkReviewRequest{
+			IDs:             []string{*rejected.ID, *sentBack.ID, *live.ID},
+			Action:          action,
+			RejectionReason: "Not a recreational league",
+		})
+		if err != nil {
+			t.Fatalf("bulk %s: %v", action, err)
+		}
+		if report.Failed != 3 {
+			t.Errorf("bulk %s: expected every league to fail, got %+v", action, report.Results)
+		}
+		for _, result := range report.Results {
+			if result.Code != shared.CodeConflict {
+				t.Errorf("bulk %s: expected a conflict for %s, got %+v", action, result.ID, result)
+			}
+		}
+	}
+
+	for id, want := range map[string]LeagueStatus{*rejected.ID: LeagueStatusRejected, *sentBack.ID: LeagueStatusChangesRequested, *live.ID: LeagueStatusApproved} {
+		if stored, _ := env.repo.GetByUUID(ctx, id); stored.Status != want {
+			t.Errorf("expected %s to stay %s, got %s", id, want, stored.Status)
+		}
+	}
+}
+
+func TestGroupReviewNotices(t *testing.T) {
+	orgA, orgB := "org-a", "org-b"
+	notice := func(orgID *string, recipient, name string, notificationType notifications.NotificationType) *reviewNotice {
+		return &reviewNotice{recipient: recipient, orgID: orgID, leagueName: name, notificationType: notificationType, message: "single"}
+	}
+
+	grouped := groupReviewNotices([]*reviewNotice{
+		notice(&orgA, "alice", "Monday Kickball", notifications.NotificationLeagueUpdateApproved),
+		notice(&orgB, "bob", "Tuesday Softball", notifications.NotificationLeagueApproved),
+		nil,
+		notice(&orgA, "alice", "Wednesday Volleyball", notifications.NotificationLeagueUpdateApproved),
+		notice(&orgA, "alice", "Thursday Bowling", notifications.NotificationLeagueApproved),
+		notice(&orgA, "alice", "Friday Dodgeball", notifications.NotificationLeagueApproved),
+	}, "")
+
+	if len(grouped) != 3 {
+		t.Fatalf("expected one notice per organization and kind of decision, got %d", len(grouped))
+	}
+	if grouped[0].recipient != "alice" || grouped[0].notificationType != notifications.NotificationLeagueUpdateApproved ||
+		!strings.Contains(grouped[0].message, "Your changes to 2 leagues") || !strings.Contains(grouped[0].message, "'Monday Kickball', 'Wednesday Volleyball'") {
+		t.Errorf("expected the approved edits grouped as updates, got %+v", grouped[0])
+	}
+	if grouped[1].recipient != "bob" || grouped[1].message != "single" {
+		t.Errorf("expected a lone league to keep its own notice, got %+v", grouped[1])
+	}
+	if grouped[2].notificationType != notifications.NotificationLeagueApproved ||
+		!strings.Contains(grouped[2].message, "2 of your leagues have been approved: 'Thursday Bowling', 'Friday Dodgeball'") {
+		t.Errorf("expected the new leagues grouped as approvals, got %+v", grouped[2])
+	}
+}
+
+func TestBulkReviewLeaguesHandler(t *testing.T) {
+	handler, env := newTestHandler(t)
+	league := env.submitTestLeague(t)
+
+	body := createLeagueRequestBody(BulkReviewRequest{IDs: []string{*league.ID}, Action: BulkReviewApprove})
+	req := httptest.NewRequest(http.MethodPost, "/leagues/admin/bulk", body)
+	req.Header.Set("X-Clerk-User-ID", "admin")
+	rr := httptest.NewRecorder()
+	handler.BulkReviewLeagues(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	var response BulkReviewResponse
+	json.NewDecoder(rr.Body).Decode(&response)
+	if response.Succeeded != 1 || len(response.Results) != 1 || response.Results[0].ID != *league.ID {
+		t.Errorf("unexpected response %+v", response)
+	}
+
+	body = createLeagueRequestBody(BulkReviewRequest{IDs: []string{"not-a-uuid"}, Action: "archive"})
+	req = httptest.NewRequest(http.MethodPost, "/leagues/admin/bulk", body)
+	req.Header.Set("X-Clerk-User-ID", "admin")
+	rr = httptest.NewRecorder()
+	handler.BulkReviewLeagues(rr, req)
+	if rr.Code != http.StatusBadRequest || decodeProblem(t, rr).Code != shared.CodeValidation {
+		t.Errorf("expected a validation problem, got %d", rr.Code)
+	}
+}
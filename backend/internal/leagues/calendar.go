@@ -0,0 +1,204 @@
+package leagues
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/ical"
+	"github.com/leaguefindr/backend/internal/schedule"
+)
+
+// calendarUIDDomain scopes event UIDs so they are globally unique
+const calendarUIDDomain = "leaguefindr.com"
+
+// calendarDetails holds the sport and venue names of a league, which are not stored on the league itself
+type calendarDetails struct {
+	SportName    string
+	VenueName    string
+	VenueAddress string
+	VenueLat     *float64
+	VenueLng     *float64
+}
+
+// calendarDetailsOf takes the resolved names from an export row
+func calendarDetailsOf(row ExportRow) calendarDetails {
+	return calendarDetails{
+		SportName:    row.SportName,
+		VenueName:    row.VenueName,
+		VenueAddress: row.VenueAddress,
+		VenueLat:     row.VenueLat,
+		VenueLng:     row.VenueLng,
+	}
+}
+
+// leagueCalendarEvents builds the calendar events of a league: an all-day event on the registration deadline and,
+// for each weekly game slot of the expanded schedule, a recurring event from its first to its last game
+// Dates skipped for blackouts and holidays are excluded from the recurrence; cancelled and rescheduled games
+// override the occurrence they replace. Without a schedule only the deadline is included
+func leagueCalendarEvents(league *League, details calendarDetails, expanded *schedule.Schedule) ([]ical.Event, error) {
+	if league.ID == nil {
+		return nil, fmt.Errorf("league has no ID")
+	}
+
+	name := leagueDisplayName(league)
+	if league.Division != nil && *league.Division != "" {
+		name = fmt.Sprintf("%s (%s)", name, *league.Division)
+	}
+	status := ical.StatusConfirmed
+	if league.LifecycleStatus == LifecycleCancelled {
+		status = ical.StatusCancelled
+	}
+	stamp := league.UpdatedAt.Time
+	if stamp.IsZero() {
+		stamp = league.CreatedAt.Time
+	}
+
+	base := ical.Event{
+		Stamp:    stamp,
+		Location: details.VenueAddress,
+		URL:      derefString(league.RegistrationURL),
+		Status:   status,
+	}
+	if details.VenueLat != nil && details.VenueLng != nil {
+		base.Geo = &ical.Geo{Lat: *details.VenueLat, Lng: *details.VenueLng}
+	}
+
+	var events []ical.Event
+
+	if league.RegistrationDeadline != nil {
+		deadline := calendarDay(league.RegistrationDeadline.Time)
+		event := base
+		event.UID = fmt.Sprintf("%s-registration-deadline@%s", *league.ID, calendarUIDDomain)
+		event.Summary = "Registration deadline: " + name
+		event.Start = deadline
+		event.End = deadline.AddDate(0, 0, 1)
+		event.AllDay = true
+		event.Description = "Last day to register"
+		if league.RegistrationURL != nil {
+			event.Description += " at " + *league.RegistrationURL
+		}
+		events = append(events, event)
+	}
+
+	if expanded == nil {
+		return events, nil
+	}
+
+	base.Summary = name
+	base.Description = gameDescription(league, details)
+	for _, games := range gamesBySlot(expanded.Games) {
+		events = append(events, slotEvents(base, *league.ID, games)...)
+	}
+
+	return events, nil
+}
+
+// gamesBySlot groups games by weekly slot, in slot order, each group in planned order
+func gamesBySlot(games []schedule.Game) [][]schedule.Game {
+	var slots [][]schedule.Game
+	for _, game := range games {
+		for len(slots) <= game.Slot {
+			slots = append(slots, nil)
+		}
+		slots[game.Slot] = append(slots[game.Slot], game)
+	}
+	for _, slot := range slots {
+		// Games are sorted by their actual time; the recurrence needs rescheduled games where they were planned
+		sort.SliceStable(slot, func(i, j int) bool {
+			return slot[i].Planned.Before(slot[j].Planned)
+		})
+	}
+	return slots
+}
+
+// slotEvents builds the recurring event of one weekly slot followed by its overrides
+func slotEvents(base ical.Event, leagueID string, games []schedule.Game) []ical.Event {
+	if len(games) == 0 {
+		return nil
+	}
+	first, last := games[0], games[len(games)-1]
+
+	series := base
+	series.UID = fmt.Sprintf("%s-%s-%s@%s", leagueID, strings.ToLower(first.Planned.Weekday().String()), first.Planned.Format("1504"), calendarUIDDomain)
+
+	if len(games) == 1 {
+		// A single game is a plain event, with any change applied directly
+		return []ical.Event{gameEvent(series, first)}
+	}
+
+	series.Start = first.Planned
+	series.End = first.Planned.Add(plannedLength(games))
+	until := calendarDay(last.Planned)
+	series.Recurrence = &ical.Recurrence{Until: &until}
+
+	planned := make(map[time.Time]bool, len(games))
+	for _, game := range games {
+		planned[game.Planned] = true
+	}
+	for date := first.Planned; !date.After(last.Planned); date = date.AddDate(0, 0, 7) {
+		if !planned[date] {
+			series.ExDates = append(series.ExDates, date)
+		}
+	}
+
+	events := []ical.Event{series}
+	for _, game := range games {
+		if game.Status == schedule.StatusScheduled {
+			continue
+		}
+		override := gameEvent(series, game)
+		override.Recurrence = nil
+		override.ExDates = nil
+		recurrenceID := game.Planned
+		override.RecurrenceID = &recurrenceID
+		events = append(events, override)
+	}
+	return events
+}
+
+// gameEvent returns the event moved to the game's time and marked with its status
+func gameEvent(event ical.Event, game schedule.Game) ical.Event {
+	event.Start = game.Start
+	event.End = game.End
+	switch game.Status {
+	case schedule.StatusCancelled:
+		event.Status = ical.StatusCancelled
+		event.Summary = "Cancelled: " + event.Summary
+	case schedule.StatusRescheduled:
+		event.Summary = "Rescheduled: " + event.Summary
+	}
+	if game.Reason != "" {
+		event.Description = strings.TrimSpace(game.Reason + "\n" + event.Description)
+	}
+	return event
+}
+
+// plannedLength returns how long the slot's games last, taken from a game that wasn't moved
+func plannedLength(games []schedule.Game) time.Duration {
+	for _, game := range games {
+		if game.Status != schedule.StatusRescheduled {
+			return game.End.Sub(game.Start)
+		}
+	}
+	return games[0].End.Sub(games[0].Start)
+}
+
+// gameDescription summarizes a league for its game events
+func gameDescription(league *League, details calendarDetails) string {
+	var lines []string
+	if details.SportName != "" {
+		lines = append(lines, "Sport: "+details.SportName)
+	}
+	if details.VenueName != "" {
+		lines = append(lines, "Venue: "+details.VenueName)
+	}
+	if league.SeasonDetails != nil && *league.SeasonDetails != "" {
+		lines = append(lines, *league.SeasonDetails)
+	}
+	if league.RegistrationURL != nil && *league.RegistrationURL != "" {
+		lines = append(lines, "Register: "+*league.RegistrationURL)
+	}
+	return strings.Join(lines, "\n")
+}
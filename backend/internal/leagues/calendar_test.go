@@ -0,0 +1,142 @@
+package leagues
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/ical"
+	"github.com/leaguefindr/backend/internal/organizations"
+)
+
+func calendarTestEvents(t *testing.T, league *League, exceptions []ScheduleException, holidays []organizations.Holiday) []ical.Event {
+	t.Helper()
+	expanded, err := expandLeagueSchedule(league, exceptions, holidays)
+	if err != nil {
+		t.Fatalf("expandLeagueSchedule: %v", err)
+	}
+	lat, lng := 47.68, -122.33
+	details := calendarDetails{SportName: "Kickball", VenueName: "Green Lake Park", VenueAddress: "7201 E Green Lake Dr N", VenueLat: &lat, VenueLng: &lng}
+	events, err := leagueCalendarEvents(league, details, expanded)
+	if err != nil {
+		t.Fatalf("leagueCalendarEvents: %v", err)
+	}
+	return events
+}
+
+func TestLeagueCalendarEvents(t *testing.T) {
+	league := cloneTestLeague()
+	league.GameOccurrences = GameOccurrences{
+		{Day: "Tuesday", StartTime: "18:00", EndTime: "20:00"},
+		{Day: "Saturday", StartTime: "23:00", EndTime: "01:00"},
+	}
+
+	events := calendarTestEvents(t, league, nil, nil)
+	if len(events) != 3 {
+		t.Fatalf("expected a deadline and two game events, got %d", len(events))
+	}
+
+	deadline := events[0]
+	if !deadline.AllDay || deadline.Start.Format("2006-01-02") != "2025-03-01" || deadline.End.Format("2006-01-02") != "2025-03-02" {
+		t.Errorf("unexpected deadline event: %+v", deadline)
+	}
+	if deadline.UID != "league-1-registration-deadline@leaguefindr.com" {
+		t.Errorf("unexpected deadline UID %q", deadline.UID)
+	}
+
+	// The season starts on Saturday 2025-03-15, so the first Tuesday game is on the 18th
+	tuesday := events[1]
+	if want := time.Date(2025, 3, 18, 18, 0, 0, 0, time.UTC); !tuesday.Start.Equal(want) {
+		t.Errorf("first Tuesday game starts %v, want %v", tuesday.Start, want)
+	}
+	if tuesday.Recurrence == nil || tuesday.Recurrence.Until == nil || tuesday.Recurrence.Until.Format("2006-01-02") != "2025-05-27" {
+		t.Errorf("expected the games to repeat until the last Tuesday of the season, got %+v", tuesday.Recurrence)
+	}
+	if tuesday.Location != "7201 E Green Lake Dr N" || tuesday.Geo == nil || tuesday.Status != ical.StatusConfirmed || len(tuesday.ExDates) != 0 {
+		t.Errorf("unexpected game event: %+v", tuesday)
+	}
+	if tuesday.UID != "league-1-tuesday-1800@leaguefindr.com" {
+		t.Errorf("unexpected game UID %q", tuesday.UID)
+	}
+
+	saturday := events[2]
+	if want := time.Date(2025, 3, 15, 23, 0, 0, 0, time.UTC); !saturday.Start.Equal(want) {
+		t.Errorf("first Saturday game starts %v, want %v", saturday.Start, want)
+	}
+	if want := time.Date(2025, 3, 16, 1, 0, 0, 0, time.UTC); !saturday.End.Equal(want) {
+		t.Errorf("late game should end the next day, got %v", saturday.End)
+	}
+}
+
+func TestLeagueCalendarEventsFollowDuration(t *testing.T) {
+	league := cloneTestLeague()
+	league.SeasonEndDate = nil
+	league.RegistrationDeadline = nil
+	duration := 8
+	league.Duration = &duration
+	league.LifecycleStatus = LifecycleCancelled
+
+	events := calendarTestEvents(t, league, nil, nil)
+	if len(events) != 1 {
+		t.Fatalf("expected one game event, got %d", len(events))
+	}
+	// Eight Tuesdays from 2025-03-18
+	if events[0].Recurrence == nil || events[0].Recurrence.Until.Format("2006-01-02") != "2025-05-06" {
+		t.Errorf("expected the games to repeat for the league duration, got %+v", events[0].Recurrence)
+	}
+	if events[0].Status != ical.StatusCancelled {
+		t.Errorf("expected a cancelled league's games to be cancelled, got %q", events[0].Status)
+	}
+}
+
+func TestLeagueCalendarEventsApplyScheduleChanges(t *testing.T) {
+	league := cloneTestLeague()
+	league.RegistrationDeadline = nil
+	duration := 4
+	league.Duration = &duration
+	league.SeasonEndDate = nil
+
+	holidays := []organizations.Holiday{{HolidayDate: Date{Time: time.Date(2025, 3, 25, 0, 0, 0, 0, time.UTC)}, Name: "Spring break"}}
+	exceptions := []ScheduleException{
+		{Kind: ScheduleExceptionCancelled, GameDate: Date{Time: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)}, Reason: stringPtr("Field flooded")},
+		{
+			Kind:         ScheduleExceptionRescheduled,
+			GameDate:     Date{Time: time.Date(2025, 4, 8, 0, 0, 0, 0, time.UTC)},
+			StartTime:    stringPtr("18:00"),
+			NewDate:      &Date{Time: time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)},
+			NewStartTime: stringPtr("19:00"),
+			NewEndTime:   stringPtr("21:00"),
+		},
+	}
+
+	events := calendarTestEvents(t, league, exceptions, holidays)
+	if len(events) != 3 {
+		t.Fatalf("expected the series and two overrides, got %d: %+v", len(events), events)
+	}
+
+	series := events[0]
+	if len(series.ExDates) != 1 || series.ExDates[0].Format("2006-01-02 15:04") != "2025-03-25 18:00" {
+		t.Errorf("expected the holiday to be excluded, got %v", series.ExDates)
+	}
+	// The holiday pushes the fourth game back a week
+	if series.Recurrence.Until.Format("2006-01-02") != "2025-04-15" {
+		t.Errorf("expected the season to end on 2025-04-15, got %v", series.Recurrence.Until)
+	}
+
+	cancelled, moved := events[1], events[2]
+	if cancelled.RecurrenceID == nil || cancelled.RecurrenceID.Format("2006-01-02") != "2025-04-01" || cancelled.Status != ical.StatusCancelled || cancelled.UID != series.UID {
+		t.Errorf("unexpected cancelled override %+v", cancelled)
+	}
+	if moved.RecurrenceID == nil || moved.RecurrenceID.Format("2006-01-02 15:04") != "2025-04-08 18:00" || moved.Start.Format("2006-01-02 15:04") != "2025-04-10 19:00" {
+		t.Errorf("unexpected rescheduled override %+v", moved)
+	}
+}
+
+func TestLeagueCalendarEventsWithoutSchedule(t *testing.T) {
+	events, err := leagueCalendarEvents(cloneTestLeague(), calendarDetails{}, nil)
+	if err != nil {
+		t.Fatalf("leagueCalendarEvents: %v", err)
+	}
+	if len(events) != 1 || !events[0].AllDay {
+		t.Errorf("expected only the registration deadline, got %+v", events)
+	}
+}
@@ -0,0 +1,121 @@
+package leagues
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// cloneDateFields are the league dates moved forward when a league is cloned into a new season
+var cloneDateFields = []string{"registration_deadline", "season_start_date", "season_end_date"}
+
+// cloneOffsetDays works out how many days a clone's dates move forward
+// Either the offset is given directly or it is the distance from the source's start date to the requested one
+func cloneOffsetDays(source *League, request *CloneLeagueRequest) (int, error) {
+	if request == nil {
+		return 0, shared.BadRequest("clone league request cannot be nil")
+	}
+	if (request.OffsetDays == nil) == (request.SeasonStartDate == nil) {
+		return 0, shared.BadRequest("exactly one of offset_days and season_start_date is required")
+	}
+
+	if request.OffsetDays != nil {
+		if *request.OffsetDays <= 0 {
+			return 0, shared.Validation(shared.FieldError{Field: "offset_days", Message: "must be positive"})
+		}
+		return *request.OffsetDays, nil
+	}
+
+	if source.SeasonStartDate == nil {
+		return 0, shared.Conflict("league has no season start date to offset from")
+	}
+	start, err := time.Parse("2006-01-02", *request.SeasonStartDate)
+	if err != nil {
+		return 0, fmt.Errorf("invalid season start date format: %w", err)
+	}
+	offset := int(calendarDay(start).Sub(calendarDay(source.SeasonStartDate.Time)).Hours() / 24)
+	if offset <= 0 {
+		return 0, shared.Validation(shared.FieldError{Field: "season_start_date", Message: "must be after " + source.SeasonStartDate.Format("2006-01-02")})
+	}
+	return offset, nil
+}
+
+// cloneLeague copies a league into a new pending season with its dates moved forward by offsetDays
+// Schedule, pricing, sport and venue are kept; moderation and lifecycle state start over
+func cloneLeague(source *League, offsetDays int, userID string) (*League, error) {
+	formData, err := copyFormData(source.FormData)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range cloneDateFields {
+		value, ok := formData[field].(string)
+		if !ok || value == "" {
+			continue
+		}
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s in form data: %w", field, err)
+		}
+		formData[field] = parsed.AddDate(0, 0, offsetDays).Format("2006-01-02")
+	}
+
+	seriesID := source.SeriesID
+	if seriesID == nil {
+		seriesID = source.ID
+	}
+	createdBy := userID
+
+	clone := &League{
+		OrgID:                source.OrgID,
+		SportID:              source.SportID,
+		LeagueName:           source.LeagueName,
+		Division:             source.Division,
+		RegistrationDeadline: shiftDate(source.RegistrationDeadline, offsetDays),
+		SeasonStartDate:      shiftDate(source.SeasonStartDate, offsetDays),
+		SeasonEndDate:        shiftDate(source.SeasonEndDate, offsetDays),
+		GameOccurrences:      append(GameOccurrences(nil), source.GameOccurrences...),
+		PricingStrategy:      source.PricingStrategy,
+		PricingAmount:        source.PricingAmount,
+		PricingPerPlayer:     source.PricingPerPlayer,
+		VenueID:              source.VenueID,
+		Gender:               source.Gender,
+		SeasonDetails:        source.SeasonDetails,
+		RegistrationURL:      source.RegistrationURL,
+		Duration:             source.Duration,
+		MinimumTeamPlayers:   source.MinimumTeamPlayers,
+		PerGameFee:           source.PerGameFee,
+		FormData:             formData,
+		Status:               LeagueStatusPending,
+		CreatedBy:            &createdBy,
+		LifecycleStatus:      LifecycleRegistrationOpen,
+		PreviousSeasonID:     source.ID,
+		SeriesID:             seriesID,
+	}
+	return clone, nil
+}
+
+// shiftDate returns a copy of the date moved forward by the given number of days
+func shiftDate(date *Date, days int) *Date {
+	if date == nil {
+		return nil
+	}
+	return &Date{Time: date.AddDate(0, 0, days)}
+}
+
+// copyFormData deep copies form data so the clone never shares nested maps or slices with the source
+func copyFormData(formData FormData) (FormData, error) {
+	if formData == nil {
+		return FormData{}, nil
+	}
+	raw, err := json.Marshal(formData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy form data: %w", err)
+	}
+	var copied FormData
+	if err := json.Unmarshal(raw, &copied); err != nil {
+		return nil, fmt.Errorf("failed to copy form data: %w", err)
+	}
+	return copied, nil
+}
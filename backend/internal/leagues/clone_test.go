@@ -0,0 +1,217 @@
+package leagues
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+func intPtr(v int) *int { return &v }
+
+func cloneTestLeague() *League {
+	date := func(value string) *Date {
+		parsed, _ := time.Parse("2006-01-02", value)
+		return &Date{Time: parsed}
+	}
+	amount := 120.0
+	venueID := int64(7)
+	return &League{
+		ID:                   stringPtr("league-1"),
+		OrgID:                stringPtr("org-1"),
+		LeagueName:           stringPtr("Spring Kickball"),
+		RegistrationDeadline: date("2025-03-01"),
+		SeasonStartDate:      date("2025-03-15"),
+		SeasonEndDate:        date("2025-05-31"),
+		GameOccurrences:      GameOccurrences{{Day: "Tuesday", StartTime: "18:00", EndTime: "20:00"}},
+		PricingStrategy:      PricingStrategyPerTeam,
+		PricingAmount:        &amount,
+		VenueID:              &venueID,
+		Status:               LeagueStatusApproved,
+		LifecycleStatus:      LifecycleCompleted,
+		RejectionReason:      stringPtr("old reason"),
+		FormData: FormData{
+			"league_name":           "Spring Kickball",
+			"registration_deadline": "2025-03-01",
+			"season_start_date":     "2025-03-15",
+			"season_end_date":       "2025-05-31",
+			"game_occurrences":      []interface{}{map[string]interface{}{"day": "Tuesday"}},
+		},
+	}
+}
+
+func TestCloneOffsetDays(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *CloneLeagueRequest
+		want    int
+		wantErr bool
+	}{
+		{"offset", &CloneLeagueRequest{OffsetDays: intPtr(364)}, 364, false},
+		{"start date", &CloneLeagueRequest{SeasonStartDate: stringPtr("2026-03-14")}, 364, false},
+		{"neither", &CloneLeagueRequest{}, 0, true},
+		{"both", &CloneLeagueRequest{OffsetDays: intPtr(7), SeasonStartDate: stringPtr("2026-03-14")}, 0, true},
+		{"zero offset", &CloneLeagueRequest{OffsetDays: intPtr(0)}, 0, true},
+		{"start date not after source", &CloneLeagueRequest{SeasonStartDate: stringPtr("2025-03-15")}, 0, true},
+		{"bad start date", &CloneLeagueRequest{SeasonStartDate: stringPtr("03/14/2026")}, 0, true},
+		{"nil request", nil, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cloneOffsetDays(cloneTestLeague(), tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("cloneOffsetDays() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("cloneOffsetDays() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCloneLeague(t *testing.T) {
+	source := cloneTestLeague()
+	clone, err := cloneLeague(source, 364, "user-2")
+	if err != nil {
+		t.Fatalf("cloneLeague: %v", err)
+	}
+
+	for field, got := range map[string]*Date{
+		"registration_deadline": clone.RegistrationDeadline,
+		"season_start_date":     clone.SeasonStartDate,
+		"season_end_date":       clone.SeasonEndDate,
+	} {
+		want, _ := time.Parse("2006-01-02", source.FormData[field].(string))
+		want = want.AddDate(0, 0, 364)
+		if got == nil || !got.Equal(want) {
+			t.Errorf("%s = %v, want %v", field, got, want)
+		}
+		if clone.FormData[field] != want.Format("2006-01-02") {
+			t.Errorf("form_data %s = %v, want %s", field, clone.FormData[field], want.Format("2006-01-02"))
+		}
+	}
+
+	if clone.ID != nil {
+		t.Errorf("expected the clone to get a new ID, got %s", *clone.ID)
+	}
+	if clone.Status != LeagueStatusPending || clone.LifecycleStatus != LifecycleRegistrationOpen {
+		t.Errorf("expected a pending, open clone, got %s/%s", clone.Status, clone.LifecycleStatus)
+	}
+	if clone.RejectionReason != nil {
+		t.Errorf("expected rejection reason to be cleared")
+	}
+	if clone.PreviousSeasonID == nil || *clone.PreviousSeasonID != "league-1" {
+		t.Errorf("expected previous season league-1, got %v", clone.PreviousSeasonID)
+	}
+	if clone.SeriesID == nil || *clone.SeriesID != "league-1" {
+		t.Errorf("expected the first season to start the series, got %v", clone.SeriesID)
+	}
+	if clone.CreatedBy == nil || *clone.CreatedBy != "user-2" {
+		t.Errorf("expected the cloning user as creator, got %v", clone.CreatedBy)
+	}
+	if len(clone.GameOccurrences) != 1 || clone.GameOccurrences[0] != source.GameOccurrences[0] {
+		t.Errorf("expected game occurrences to be kept, got %+v", clone.GameOccurrences)
+	}
+	if *clone.PricingAmount != 120 || *clone.VenueID != 7 || clone.PricingStrategy != PricingStrategyPerTeam {
+		t.Errorf("expected pricing and venue to be kept")
+	}
+
+	// The clone must not share form data with its source
+	clone.FormData["league_name"] = "Changed"
+	clone.FormData["game_occurrences"].([]interface{})[0].(map[string]interface{})["day"] = "Friday"
+	if source.FormData["league_name"] != "Spring Kickball" {
+		t.Errorf("source form data changed with the clone")
+	}
+	if source.FormData["game_occurrences"].([]interface{})[0].(map[string]interface{})["day"] != "Tuesday" {
+		t.Errorf("source nested form data changed with the clone")
+	}
+	if source.SeasonStartDate.Format("2006-01-02") != "2025-03-15" {
+		t.Errorf("source dates changed with the clone")
+	}
+}
+
+func TestCloneLeagueKeepsSeries(t *testing.T) {
+	source := cloneTestLeague()
+	source.ID = stringPtr("league-2")
+	source.SeriesID = stringPtr("league-1")
+
+	clone, err := cloneLeague(source, 7, "user-1")
+	if err != nil {
+		t.Fatalf("cloneLeague: %v", err)
+	}
+	if *clone.SeriesID != "league-1" || *clone.PreviousSeasonID != "league-2" {
+		t.Errorf("expected series league-1 and previous league-2, got %s and %s", *clone.SeriesID, *clone.PreviousSeasonID)
+	}
+}
+
+func TestCloneLeagueIsSubmitted(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	submission := validTestRequest()
+	submission.LeagueName = stringPtr("Tuesday Coed Kickball")
+	source, err := env.service.CreateLeague(ctx, "organizer", env.orgID, "", submission)
+	if err != nil {
+		t.Fatalf("create league: %v", err)
+	}
+	request := &CloneLeagueRequest{OffsetDays: intPtr(364)}
+
+	first, err := env.service.CloneLeague(ctx, "organizer", *source.ID, "", request)
+	if err != nil {
+		t.Fatalf("clone league: %v", err)
+	}
+	if first.Status != LeagueStatusPending || first.PolicyDecision == nil || first.PolicyDecision.Outcome != PolicyQueue {
+		t.Errorf("expected the clone queued by the policy, got %s/%+v", first.Status, first.PolicyDecision)
+	}
+
+	// Cloning the same season again duplicates the first clone
+	second, err := env.service.CloneLeague(ctx, "organizer", *source.ID, "", request)
+	if err != nil {
+		t.Fatalf("clone league again: %v", err)
+	}
+	if len(second.DuplicateCandidates) == 0 || second.DuplicateCandidates[0].LeagueID != *first.ID {
+		t.Errorf("expected the first clone as a duplicate candidate, got %+v", second.DuplicateCandidates)
+	}
+
+	// Once the policy approves the organization's submissions, it approves new seasons too
+	if _, err := env.service.CreatePolicyRule(ctx, "admin", &PolicyRuleRequest{
+		Name:       "verified organizations",
+		Outcome:    PolicyAutoApprove,
+		Conditions: PolicyConditions{OrgVerified: boolPtr(true)},
+	}); err != nil {
+		t.Fatalf("create policy rule: %v", err)
+	}
+	if err := env.service.orgService.SetOrganizationVerified(ctx, "admin", env.orgID, true); err != nil {
+		t.Fatalf("verify organization: %v", err)
+	}
+	approved, err := env.service.CloneLeague(ctx, "organizer", *source.ID, "", &CloneLeagueRequest{OffsetDays: intPtr(728)})
+	if err != nil {
+		t.Fatalf("clone league once verified: %v", err)
+	}
+	if stored, _ := env.repo.GetByUUID(ctx, *approved.ID); stored.Status != LeagueStatusApproved {
+		t.Errorf("expected the clone auto-approved, got %s", stored.Status)
+	}
+}
+
+func TestCloneLeagueRejectsInvalidSource(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	source := env.submitTestLeague(t)
+	request := &CloneLeagueRequest{OffsetDays: intPtr(364)}
+
+	env.repo.mu.Lock()
+	delete(env.repo.league(*source.ID).FormData, "division")
+	env.repo.mu.Unlock()
+	if _, err := env.service.CloneLeague(ctx, "organizer", *source.ID, "", request); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("expected a validation error cloning an incomplete submission, got %v", err)
+	}
+
+	env.repo.mu.Lock()
+	env.repo.league(*source.ID).OrgID = nil
+	env.repo.mu.Unlock()
+	if _, err := env.service.CloneLeague(ctx, "admin", *source.ID, "admin", request); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict cloning a league without an organization, got %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package leagues
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// commentFields returns the league fields a review comment can point at: the editable columns and form_data keys
+func commentFields() []string {
+	return append(slices.Clone(editableColumns), formDataOnlyFields...)
+}
+
+// normalizeCommentFields trims and de-duplicates the fields a comment points at and checks that each is a league field
+func normalizeCommentFields(fields []string) ([]string, error) {
+	known := commentFields()
+	normalized := []string{}
+	var fieldErrors []shared.FieldError
+	for i, field := range fields {
+		field = strings.ToLower(strings.TrimSpace(field))
+		if !slices.Contains(known, field) {
+			fieldErrors = append(fieldErrors, shared.FieldError{Field: fmt.Sprintf("fields[%d]", i), Message: "is not a league field"})
+			continue
+		}
+		if !slices.Contains(normalized, field) {
+			normalized = append(normalized, field)
+		}
+	}
+	if len(fieldErrors) > 0 {
+		return nil, shared.Validation(fieldErrors...)
+	}
+	return normalized, nil
+}
+
+// commentAuthorRole returns the side of the review thread a caller writes from
+func commentAuthorRole(appRole string) CommentAuthorRole {
+	if appRole == "admin" {
+		return CommentAuthorAdmin
+	}
+	return CommentAuthorOrganizer
+}
+
+// awaitingOrganizer reports whether a league was sent back and waits for its organizer to resubmit it
+func awaitingOrganizer(league *League) bool {
+	return league.Status == LeagueStatusRejected || league.Status == LeagueStatusChangesRequested
+}
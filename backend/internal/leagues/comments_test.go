@@ -0,0 +1,139 @@
+package leagues
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+func TestNormalizeCommentFields(t *testing.T) {
+	fields, err := normalizeCommentFields([]string{" Division ", "venue_address", "division"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(fields) != 2 || fields[0] != "division" || fields[1] != "venue_address" {
+		t.Errorf("expected trimmed, de-duplicated fields, got %v", fields)
+	}
+
+	_, err = normalizeCommentFields([]string{"division", "colour"})
+	domainErr, ok := shared.AsError(err)
+	if !ok || domainErr.Code != shared.CodeValidation || len(domainErr.Fields) != 1 || domainErr.Fields[0].Field != "fields[1]" {
+		t.Errorf("expected a fields[1] validation error, got %v", err)
+	}
+}
+
+func TestRequestLeagueChanges(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	league := env.submitTestLeague(t)
+	request := &RequestChangesRequest{Message: "Which field is the venue?", Fields: []string{"venue_name"}}
+
+	if _, err := env.service.RequestLeagueChanges(ctx, "organizer", *league.ID, request); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected forbidden for an organizer, got %v", err)
+	}
+
+	comment, err := env.service.RequestLeagueChanges(ctx, "admin", *league.ID, request)
+	if err != nil {
+		t.Fatalf("request changes: %v", err)
+	}
+	if comment.AuthorRole != CommentAuthorAdmin || comment.Status != LeagueStatusChangesRequested || len(comment.Fields) != 1 {
+		t.Errorf("unexpected comment %+v", comment)
+	}
+	stored, _ := env.repo.GetByUUID(ctx, *league.ID)
+	if stored.Status != LeagueStatusChangesRequested {
+		t.Errorf("expected the league to be sent back, got %s", stored.Status)
+	}
+	if pending, _ := env.service.GetPendingLeagues(ctx); len(pending) != 0 {
+		t.Errorf("expected the league to leave the review queue, got %d pending", len(pending))
+	}
+	if types := env.notificationTypes(t, "organizer"); !slices.Contains(types, "league_changes_requested") {
+		t.Errorf("expected the organizer to be notified, got %v", types)
+	}
+	history, _ := env.service.GetLeagueHistory(ctx, *league.ID)
+	if last := history[len(history)-1]; last.Action != RevisionChangesRequested || last.Note == nil || *last.Note != request.Message {
+		t.Errorf("expected a changes_requested revision with the message, got %+v", last)
+	}
+
+	if _, err := env.service.RequestLeagueChanges(ctx, "admin", *league.ID, request); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict for a league that is no longer pending, got %v", err)
+	}
+}
+
+func TestLeagueCommentThread(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	league := env.submitTestLeague(t)
+
+	if _, err := env.service.RequestLeagueChanges(ctx, "admin", *league.ID, &RequestChangesRequest{Message: "Please add the division", Fields: []string{"division"}}); err != nil {
+		t.Fatalf("request changes: %v", err)
+	}
+
+	if _, err := env.service.AddLeagueComment(ctx, "stranger", *league.ID, "", &CreateLeagueCommentRequest{Body: "Hi"}); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected forbidden for a non-member, got %v", err)
+	}
+	reply, err := env.service.AddLeagueComment(ctx, "organizer", *league.ID, "", &CreateLeagueCommentRequest{Body: "Fixed, it's Rec"})
+	if err != nil {
+		t.Fatalf("add comment: %v", err)
+	}
+	if reply.AuthorRole != CommentAuthorOrganizer {
+		t.Errorf("expected an organizer comment, got %s", reply.AuthorRole)
+	}
+	if types := env.notificationTypes(t, "admin"); !slices.Contains(types, "league_comment") {
+		t.Errorf("expected the admins to be notified of the reply, got %v", types)
+	}
+
+	if _, err := env.service.AddLeagueComment(ctx, "admin", *league.ID, "admin", &CreateLeagueCommentRequest{Body: "Thanks"}); err != nil {
+		t.Fatalf("add comment: %v", err)
+	}
+	if types := env.notificationTypes(t, "organizer"); !slices.Contains(types, "league_comment") {
+		t.Errorf("expected the organizer to be notified of the admin comment, got %v", types)
+	}
+
+	comments, err := env.service.GetLeagueComments(ctx, "organizer", *league.ID, "")
+	if err != nil {
+		t.Fatalf("get comments: %v", err)
+	}
+	if len(comments) != 3 || comments[0].AuthorRole != CommentAuthorAdmin || comments[1].Body != "Fixed, it's Rec" || comments[2].Body != "Thanks" {
+		t.Errorf("expected the three comments oldest first, got %+v", comments)
+	}
+	if _, err := env.service.GetLeagueComments(ctx, "stranger", *league.ID, ""); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected forbidden for a non-member, got %v", err)
+	}
+
+	// Resubmitting without further changes puts the league back in the queue
+	result, err := env.service.UpdateLeague(ctx, "organizer", *league.ID, "", validTestRequest())
+	if err != nil {
+		t.Fatalf("update league: %v", err)
+	}
+	if result.Outcome != EditOutcomeResubmitted || result.League.Status != LeagueStatusPending {
+		t.Errorf("expected the league to be resubmitted, got %s/%s", result.Outcome, result.League.Status)
+	}
+}
+
+func TestGetLeagueCommentsHandler(t *testing.T) {
+	handler, env := newTestHandler(t)
+	league := env.submitTestLeague(t)
+	if _, err := env.service.AddLeagueComment(context.Background(), "organizer", *league.ID, "", &CreateLeagueCommentRequest{Body: "Can you review this soon?"}); err != nil {
+		t.Fatalf("add comment: %v", err)
+	}
+
+	req := setChiParam(httptest.NewRequest(http.MethodGet, "/leagues/"+*league.ID+"/comments", nil), "id", *league.ID)
+	req.Header.Set("X-Clerk-User-ID", "organizer")
+	rr := httptest.NewRecorder()
+	handler.GetLeagueComments(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	var response GetLeagueCommentsResponse
+	json.NewDecoder(rr.Body).Decode(&response)
+	if response.LeagueID != *league.ID || len(response.Comments) != 1 || response.Comments[0].AuthorID != "organizer" {
+		t.Errorf("unexpected response %+v", response)
+	}
+}
@@ -0,0 +1,213 @@
+package leagues
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/schedule"
+	"github.com/leaguefindr/backend/internal/venues"
+)
+
+const minutesPerWeek = 7 * 24 * 60
+
+// venueBooking is the weekly slots a league holds at its venue and the dates of its first and last games
+type venueBooking struct {
+	league *League
+	first  time.Time
+	last   time.Time
+	slots  []schedule.Slot
+}
+
+// newVenueBooking works out when a league uses its venue; ok is false for leagues without any games
+// Blackouts and one-off changes are ignored: a venue is considered booked for the whole regular schedule
+func newVenueBooking(league *League) (*venueBooking, bool) {
+	season, err := leagueSeason(league)
+	if err != nil {
+		return nil, false
+	}
+	expanded, err := schedule.Expand(season, nil, nil)
+	if err != nil || len(expanded.Games) == 0 {
+		return nil, false
+	}
+	return &venueBooking{
+		league: league,
+		first:  calendarDay(expanded.Games[0].Planned),
+		last:   calendarDay(expanded.Games[len(expanded.Games)-1].Planned),
+		slots:  season.Slots,
+	}, true
+}
+
+// conflictWith returns the first date on which the two bookings both play in overlapping slots and the last
+// week that can happen, with the other booking's slot; ok is false if they never overlap
+func (b *venueBooking) conflictWith(other *venueBooking) (schedule.Slot, time.Time, time.Time, bool) {
+	from, to := b.first, b.last
+	if other.first.After(from) {
+		from = other.first
+	}
+	if other.last.Before(to) {
+		to = other.last
+	}
+	if from.After(to) {
+		return schedule.Slot{}, time.Time{}, time.Time{}, false
+	}
+
+	for _, slot := range b.slots {
+		for _, otherSlot := range other.slots {
+			if !slotsOverlap(slot, otherSlot) {
+				continue
+			}
+			firstGame := nextWeekday(from, slot.Day)
+			if firstGame.After(to) {
+				continue
+			}
+			lastGame := to.AddDate(0, 0, -((int(to.Weekday()) - int(slot.Day) + 7) % 7))
+			return otherSlot, firstGame, lastGame, true
+		}
+	}
+	return schedule.Slot{}, time.Time{}, time.Time{}, false
+}
+
+// slotsOverlap reports whether two weekly slots share any time, including slots running past midnight
+func slotsOverlap(a, b schedule.Slot) bool {
+	aStart, aEnd := weekMinutes(a)
+	bStart, bEnd := weekMinutes(b)
+	// Compare against b shifted a week either way so slots wrapping from Saturday into Sunday are caught
+	for _, shift := range []int{-minutesPerWeek, 0, minutesPerWeek} {
+		if aStart < bEnd+shift && bStart+shift < aEnd {
+			return true
+		}
+	}
+	return false
+}
+
+// weekMinutes returns a slot's start and end as minutes from the start of the week (Sunday 00:00)
+func weekMinutes(slot schedule.Slot) (int, int) {
+	start := int(slot.Day)*24*60 + slot.Start.Hour*60 + slot.Start.Minute
+	end := int(slot.Day)*24*60 + slot.End.Hour*60 + slot.End.Minute
+	if end <= start {
+		end += 24 * 60
+	}
+	return start, end
+}
+
+// nextWeekday returns the first date on or after day that falls on weekday
+func nextWeekday(day time.Time, weekday time.Weekday) time.Time {
+	offset := (int(weekday) - int(day.Weekday()) + 7) % 7
+	return day.AddDate(0, 0, offset)
+}
+
+// occupiesVenue reports whether a league still holds its venue; cancelled seasons free their slots
+func occupiesVenue(league *League) bool {
+	return league.Status == LeagueStatusApproved && league.LifecycleStatus != LifecycleCancelled
+}
+
+// findVenueConflicts compares a league's weekly slots and season with the other leagues booked at the same venue
+func findVenueConflicts(league *League, others []League) []VenueConflict {
+	booking, ok := newVenueBooking(league)
+	if !ok {
+		return nil
+	}
+
+	var conflicts []VenueConflict
+	for i := range others {
+		other := &others[i]
+		if other.ID != nil && league.ID != nil && *other.ID == *league.ID {
+			continue
+		}
+		if !occupiesVenue(other) {
+			continue
+		}
+		otherBooking, ok := newVenueBooking(other)
+		if !ok {
+			continue
+		}
+		slot, firstDate, lastDate, ok := booking.conflictWith(otherBooking)
+		if !ok {
+			continue
+		}
+
+		name := leagueDisplayName(other)
+		conflicts = append(conflicts, VenueConflict{
+			LeagueID:   derefString(other.ID),
+			LeagueName: name,
+			OrgID:      derefString(other.OrgID),
+			Day:        slot.Day.String(),
+			StartTime:  slot.Start.String(),
+			EndTime:    slot.End.String(),
+			FirstDate:  firstDate.Format("2006-01-02"),
+			LastDate:   lastDate.Format("2006-01-02"),
+			Message: fmt.Sprintf("'%s' plays at the same venue on %ss %s-%s between %s and %s",
+				name, slot.Day, slot.Start, slot.End, firstDate.Format("2006-01-02"), lastDate.Format("2006-01-02")),
+		})
+	}
+	return conflicts
+}
+
+// venueOccupancy lists the weekly slots booked by leagues still playing on or after from, each with the
+// IDs of the leagues it overlaps, ordered by weekday (Monday first) and start time
+func venueOccupancy(leagues []League, from time.Time) []venues.OccupiedSlot {
+	from = calendarDay(from)
+	var bookings []*venueBooking
+	for i := range leagues {
+		if !occupiesVenue(&leagues[i]) {
+			continue
+		}
+		booking, ok := newVenueBooking(&leagues[i])
+		if !ok || booking.last.Before(from) {
+			continue
+		}
+		bookings = append(bookings, booking)
+	}
+
+	type sortableSlot struct {
+		slot     schedule.Slot
+		occupied venues.OccupiedSlot
+	}
+	var slots []sortableSlot
+	for _, booking := range bookings {
+		for _, slot := range booking.slots {
+			occupied := venues.OccupiedSlot{
+				Day:             slot.Day.String(),
+				StartTime:       slot.Start.String(),
+				EndTime:         slot.End.String(),
+				LeagueID:        derefString(booking.league.ID),
+				LeagueName:      leagueDisplayName(booking.league),
+				OrgID:           derefString(booking.league.OrgID),
+				SeasonStartDate: booking.first.Format("2006-01-02"),
+				SeasonEndDate:   booking.last.Format("2006-01-02"),
+				Conflicts:       []string{},
+			}
+			single := &venueBooking{league: booking.league, first: booking.first, last: booking.last, slots: []schedule.Slot{slot}}
+			for _, other := range bookings {
+				if other == booking {
+					continue
+				}
+				if _, _, _, ok := single.conflictWith(other); ok {
+					occupied.Conflicts = append(occupied.Conflicts, derefString(other.league.ID))
+				}
+			}
+			slots = append(slots, sortableSlot{slot: slot, occupied: occupied})
+		}
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		// Weekdays are listed Monday first, like GameOccurrence days
+		dayI, dayJ := (int(slots[i].slot.Day)+6)%7, (int(slots[j].slot.Day)+6)%7
+		if dayI != dayJ {
+			return dayI < dayJ
+		}
+		startI, _ := weekMinutes(slots[i].slot)
+		startJ, _ := weekMinutes(slots[j].slot)
+		if startI != startJ {
+			return startI < startJ
+		}
+		return slots[i].occupied.SeasonStartDate < slots[j].occupied.SeasonStartDate
+	})
+
+	result := make([]venues.OccupiedSlot, len(slots))
+	for i, slot := range slots {
+		result[i] = slot.occupied
+	}
+	return result
+}
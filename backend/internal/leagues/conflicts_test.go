@@ -0,0 +1,117 @@
+package leagues
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/schedule"
+)
+
+func conflictTestLeague(id string, occurrences GameOccurrences) League {
+	league := cloneTestLeague()
+	league.ID = stringPtr(id)
+	league.LeagueName = stringPtr("League " + id)
+	league.GameOccurrences = occurrences
+	league.LifecycleStatus = LifecycleRegistrationOpen
+	return *league
+}
+
+func TestSlotsOverlap(t *testing.T) {
+	slot := func(day, start, end string) schedule.Slot {
+		parsed, err := schedule.ParseSlot(day, start, end)
+		if err != nil {
+			t.Fatalf("ParseSlot: %v", err)
+		}
+		return parsed
+	}
+
+	tests := []struct {
+		name string
+		a, b schedule.Slot
+		want bool
+	}{
+		{"same time", slot("Tuesday", "18:00", "20:00"), slot("Tuesday", "19:00", "21:00"), true},
+		{"back to back", slot("Tuesday", "18:00", "20:00"), slot("Tuesday", "20:00", "22:00"), false},
+		{"different day", slot("Tuesday", "18:00", "20:00"), slot("Wednesday", "18:00", "20:00"), false},
+		{"past midnight", slot("Monday", "23:00", "01:00"), slot("Tuesday", "00:30", "02:00"), true},
+		{"past midnight into the next week", slot("Saturday", "23:00", "01:00"), slot("Sunday", "00:00", "00:30"), true},
+		{"next week reversed", slot("Sunday", "00:00", "00:30"), slot("Saturday", "23:00", "01:00"), true},
+	}
+	for _, tt := range tests {
+		if got := slotsOverlap(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: slotsOverlap = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFindVenueConflicts(t *testing.T) {
+	league := conflictTestLeague("new", GameOccurrences{{Day: "Tuesday", StartTime: "19:00", EndTime: "21:00"}})
+	league.Status = LeagueStatusPending
+
+	overlapping := conflictTestLeague("overlapping", GameOccurrences{{Day: "Tuesday", StartTime: "18:00", EndTime: "20:00"}})
+	overlapping.SeasonStartDate = &Date{Time: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)}
+	overlapping.SeasonEndDate = &Date{Time: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)}
+
+	cancelled := conflictTestLeague("cancelled", GameOccurrences{{Day: "Tuesday", StartTime: "18:00", EndTime: "20:00"}})
+	cancelled.LifecycleStatus = LifecycleCancelled
+
+	pending := conflictTestLeague("pending", GameOccurrences{{Day: "Tuesday", StartTime: "18:00", EndTime: "20:00"}})
+	pending.Status = LeagueStatusPending
+
+	laterSeason := conflictTestLeague("later", GameOccurrences{{Day: "Tuesday", StartTime: "18:00", EndTime: "20:00"}})
+	laterSeason.SeasonStartDate = &Date{Time: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)}
+	laterSeason.SeasonEndDate = &Date{Time: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)}
+
+	otherNight := conflictTestLeague("other-night", GameOccurrences{{Day: "Thursday", StartTime: "19:00", EndTime: "21:00"}})
+
+	// The league's own approved copy is never a conflict with itself
+	self := league
+	self.Status = LeagueStatusApproved
+
+	conflicts := findVenueConflicts(&league, []League{self, overlapping, cancelled, pending, laterSeason, otherNight})
+	if len(conflicts) != 1 {
+		t.Fatalf("expected a single conflict, got %+v", conflicts)
+	}
+	conflict := conflicts[0]
+	if conflict.LeagueID != "overlapping" || conflict.Day != "Tuesday" || conflict.StartTime != "18:00" || conflict.EndTime != "20:00" {
+		t.Errorf("unexpected conflict %+v", conflict)
+	}
+	// The seasons share April 1st to May 31st; the last Tuesday in that range is May 27th
+	if conflict.FirstDate != "2025-04-01" || conflict.LastDate != "2025-05-27" {
+		t.Errorf("expected the conflict to run from 2025-04-01 to 2025-05-27, got %s to %s", conflict.FirstDate, conflict.LastDate)
+	}
+	if conflict.Message == "" {
+		t.Error("expected a message")
+	}
+}
+
+func TestVenueOccupancy(t *testing.T) {
+	first := conflictTestLeague("first", GameOccurrences{
+		{Day: "Sunday", StartTime: "10:00", EndTime: "12:00"},
+		{Day: "Tuesday", StartTime: "18:00", EndTime: "20:00"},
+	})
+	second := conflictTestLeague("second", GameOccurrences{{Day: "Tuesday", StartTime: "19:00", EndTime: "21:00"}})
+	finished := conflictTestLeague("finished", GameOccurrences{{Day: "Monday", StartTime: "18:00", EndTime: "20:00"}})
+	finished.SeasonStartDate = &Date{Time: time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)}
+	finished.SeasonEndDate = &Date{Time: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)}
+
+	slots := venueOccupancy([]League{first, second, finished}, time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC))
+	if len(slots) != 3 {
+		t.Fatalf("expected three slots from the leagues still playing, got %+v", slots)
+	}
+	order := []string{"Tuesday 18:00", "Tuesday 19:00", "Sunday 10:00"}
+	for i, want := range order {
+		if got := slots[i].Day + " " + slots[i].StartTime; got != want {
+			t.Errorf("slot %d is %s, want %s", i, got, want)
+		}
+	}
+	if len(slots[0].Conflicts) != 1 || slots[0].Conflicts[0] != "second" {
+		t.Errorf("expected the first Tuesday slot to overlap the second league, got %v", slots[0].Conflicts)
+	}
+	if len(slots[1].Conflicts) != 1 || slots[1].Conflicts[0] != "first" {
+		t.Errorf("expected the second Tuesday slot to overlap the first league, got %v", slots[1].Conflicts)
+	}
+	if len(slots[2].Conflicts) != 0 {
+		t.Errorf("expected the Sunday slot to be free of conflicts, got %v", slots[2].Conflicts)
+	}
+}
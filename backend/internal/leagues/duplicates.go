@@ -0,0 +1,166 @@
+package leagues
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// Weights of the signals making up a duplicate score; they add up to 1
+const (
+	duplicateNameWeight     = 0.4
+	duplicateOrgWeight      = 0.15
+	duplicateSportWeight    = 0.15
+	duplicateDivisionWeight = 0.1
+	duplicateSeasonWeight   = 0.1
+	duplicateVenueWeight    = 0.1
+)
+
+const (
+	duplicateThreshold     = 0.7 // Minimum score for a league to be reported as a likely duplicate
+	maxDuplicateCandidates = 5
+)
+
+// DuplicateRejectionReason is the rejection reason of a league marked as a duplicate
+const DuplicateRejectionReason = "This league duplicates an existing listing. Please edit the existing league instead of submitting it again."
+
+// scoreDuplicate scores how likely other is the same league as league, between 0 and 1, with the reasons
+func scoreDuplicate(league, other *League) (float64, []string) {
+	score := 0.0
+	reasons := []string{}
+
+	if nameScore := nameSimilarity(leagueDisplayName(league), leagueDisplayName(other)); nameScore > 0 {
+		score += duplicateNameWeight * nameScore
+		if nameScore >= 0.5 {
+			reasons = append(reasons, fmt.Sprintf("similar name (%.0f%%)", nameScore*100))
+		}
+	}
+	if league.OrgID != nil && other.OrgID != nil && *league.OrgID == *other.OrgID {
+		score += duplicateOrgWeight
+		reasons = append(reasons, "same organization")
+	}
+	if sameSport(league, other) {
+		score += duplicateSportWeight
+		reasons = append(reasons, "same sport")
+	}
+	if division := shared.NormalizeText(derefString(league.Division)); division != "" && division == shared.NormalizeText(derefString(other.Division)) {
+		score += duplicateDivisionWeight
+		reasons = append(reasons, "same division")
+	}
+	if seasonsOverlap(league, other) {
+		score += duplicateSeasonWeight
+		reasons = append(reasons, "overlapping season dates")
+	}
+	if sameVenue(league, other) {
+		score += duplicateVenueWeight
+		reasons = append(reasons, "same venue")
+	}
+
+	return math.Round(score*100) / 100, reasons
+}
+
+// findDuplicateCandidates returns the best-scoring leagues among others that league likely duplicates
+func findDuplicateCandidates(league *League, others []League) []DuplicateCandidate {
+	var candidates []DuplicateCandidate
+	for i := range others {
+		other := &others[i]
+		if other.ID == nil || (league.ID != nil && *other.ID == *league.ID) {
+			continue
+		}
+		score, reasons := scoreDuplicate(league, other)
+		if score < duplicateThreshold {
+			continue
+		}
+		candidates = append(candidates, DuplicateCandidate{
+			LeagueID:   *other.ID,
+			LeagueName: leagueDisplayName(other),
+			OrgID:      derefString(other.OrgID),
+			Status:     other.Status,
+			Score:      score,
+			Reasons:    reasons,
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].LeagueID < candidates[j].LeagueID
+	})
+	if len(candidates) > maxDuplicateCandidates {
+		candidates = candidates[:maxDuplicateCandidates]
+	}
+	return candidates
+}
+
+// nameSimilarity compares two league names word by word, tolerating typos and abbreviations, between 0 and 1
+// Words missing from either name lower the score, so "Monday Coed" and "Monday Coed Kickball" don't score 1
+func nameSimilarity(a, b string) float64 {
+	aWords, bWords := shared.Tokenize(a), shared.Tokenize(b)
+	if len(aWords) == 0 || len(bWords) == 0 {
+		return 0
+	}
+	// Names that only differ in spacing and punctuation ("Co-ed" and "Coed") are the same name
+	if strings.Join(aWords, "") == strings.Join(bWords, "") {
+		return 1
+	}
+	return (wordCoverage(aWords, bWords) + wordCoverage(bWords, aWords)) / 2
+}
+
+// wordCoverage averages how well each of words matches the best of the other words
+func wordCoverage(words, others []string) float64 {
+	total := 0.0
+	for _, word := range words {
+		total += shared.TokenMatchScore(word, others)
+	}
+	return total / float64(len(words))
+}
+
+// sameSport compares sport IDs, or the submitted sport names when either league's sport doesn't exist yet
+func sameSport(a, b *League) bool {
+	if a.SportID != nil && b.SportID != nil {
+		return *a.SportID == *b.SportID
+	}
+	aName := shared.NormalizeText(formDataString(a.FormData, "sport_name"))
+	return aName != "" && aName == shared.NormalizeText(formDataString(b.FormData, "sport_name"))
+}
+
+// sameVenue compares venue IDs, or the submitted venue addresses when either league's venue doesn't exist yet
+func sameVenue(a, b *League) bool {
+	if a.VenueID != nil && b.VenueID != nil {
+		return *a.VenueID == *b.VenueID
+	}
+	aAddress := shared.NormalizeText(formDataString(a.FormData, "venue_address"))
+	return aAddress != "" && aAddress == shared.NormalizeText(formDataString(b.FormData, "venue_address"))
+}
+
+// seasonsOverlap reports whether the two leagues' seasons share any day
+// A league without an end date is taken to play on its start date only
+func seasonsOverlap(a, b *League) bool {
+	aStart, aEnd, ok := seasonRange(a)
+	if !ok {
+		return false
+	}
+	bStart, bEnd, ok := seasonRange(b)
+	if !ok {
+		return false
+	}
+	return !aStart.After(bEnd) && !bStart.After(aEnd)
+}
+
+// seasonRange returns the first and last day of a league's season; ok is false without a start date
+func seasonRange(league *League) (start, end time.Time, ok bool) {
+	if league.SeasonStartDate == nil {
+		return time.Time{}, time.Time{}, false
+	}
+	start = league.SeasonStartDate.Time
+	end = start
+	if league.SeasonEndDate != nil && league.SeasonEndDate.After(start) {
+		end = league.SeasonEndDate.Time
+	}
+	return start, end, true
+}
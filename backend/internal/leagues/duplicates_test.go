@@ -0,0 +1,151 @@
+package leagues
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+func TestNameSimilarity(t *testing.T) {
+	if got := nameSimilarity("Tuesday Coed Kickball", "tuesday co-ed kickball!"); got < 0.8 {
+		t.Errorf("expected names differing in punctuation to be alike, got %.2f", got)
+	}
+	if got := nameSimilarity("Tuesday Coed Kickball", "Tuesday Coed Kickbal"); got < 0.8 {
+		t.Errorf("expected a typo to keep names alike, got %.2f", got)
+	}
+	if got := nameSimilarity("Tuesday Coed Kickball", "Sunday Mens Soccer"); got != 0 {
+		t.Errorf("expected unrelated names to score 0, got %.2f", got)
+	}
+	if got := nameSimilarity("Tuesday Coed Kickball", ""); got != 0 {
+		t.Errorf("expected a missing name to score 0, got %.2f", got)
+	}
+}
+
+func TestFindDuplicateCandidates(t *testing.T) {
+	orgID, otherOrgID := "org-1", "org-2"
+	sportID, venueID := int64(3), int64(4)
+	date := func(s string) *Date {
+		parsed, _ := time.Parse("2006-01-02", s)
+		return &Date{Time: parsed}
+	}
+	league := League{
+		OrgID:           &orgID,
+		SportID:         &sportID,
+		VenueID:         &venueID,
+		LeagueName:      stringPtr("Tuesday Coed Kickball"),
+		Division:        stringPtr("Rec"),
+		SeasonStartDate: date("2025-03-15"),
+		SeasonEndDate:   date("2025-05-31"),
+	}
+
+	resubmitted := league
+	resubmitted.ID = stringPtr("b")
+	resubmitted.Status = LeagueStatusRejected
+	resubmitted.Division = stringPtr("rec")
+
+	// Another account's copy: different organization, sport not created yet, same venue address
+	copied := league
+	copied.ID = stringPtr("a")
+	copied.OrgID = &otherOrgID
+	copied.SportID = nil
+	copied.VenueID = nil
+	copied.FormData = FormData{"sport_name": "kickball", "venue_address": "7400 Sand Point Way NE"}
+	league.FormData = FormData{"sport_name": "Kickball", "venue_address": "7400 Sand Point Way NE"}
+
+	// Same organization and sport but another night and season
+	different := league
+	different.ID = stringPtr("c")
+	different.LeagueName = stringPtr("Thursday Mens Softball")
+	different.SeasonStartDate = date("2025-09-01")
+	different.SeasonEndDate = date("2025-11-01")
+
+	candidates := findDuplicateCandidates(&league, []League{copied, resubmitted, different})
+	if len(candidates) != 2 {
+		t.Fatalf("expected the resubmission and the copy, got %+v", candidates)
+	}
+	if candidates[0].LeagueID != "b" || candidates[0].Score != 1 || candidates[0].Status != LeagueStatusRejected {
+		t.Errorf("expected the resubmission first with a full score, got %+v", candidates[0])
+	}
+	if candidates[1].LeagueID != "a" || candidates[1].Score != 0.85 || candidates[1].OrgID != otherOrgID {
+		t.Errorf("expected the other organization's copy second, got %+v", candidates[1])
+	}
+	if len(candidates[1].Reasons) != 5 || candidates[1].Reasons[0] != "similar name (100%)" {
+		t.Errorf("unexpected reasons %v", candidates[1].Reasons)
+	}
+
+	// A league is never its own duplicate
+	if candidates := findDuplicateCandidates(&resubmitted, []League{resubmitted}); len(candidates) != 0 {
+		t.Errorf("expected no candidates, got %+v", candidates)
+	}
+}
+
+func TestCreateLeagueFlagsDuplicates(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	request := validTestRequest()
+	request.LeagueName = stringPtr("Tuesday Coed Kickball")
+	first, err := env.service.CreateLeague(ctx, "organizer", env.orgID, "", request)
+	if err != nil {
+		t.Fatalf("create league: %v", err)
+	}
+	if len(first.DuplicateCandidates) != 0 {
+		t.Errorf("expected no duplicates for the first league, got %+v", first.DuplicateCandidates)
+	}
+
+	second, err := env.service.CreateLeague(ctx, "organizer", env.orgID, "", request)
+	if err != nil {
+		t.Fatalf("create league: %v", err)
+	}
+	stored, _ := env.repo.GetByUUID(ctx, *second.ID)
+	if len(stored.DuplicateCandidates) != 1 || stored.DuplicateCandidates[0].LeagueID != *first.ID {
+		t.Fatalf("expected the first league to be stored as a likely duplicate, got %+v", stored.DuplicateCandidates)
+	}
+
+	duplicates, err := env.service.GetLeagueDuplicates(ctx, *first.ID)
+	if err != nil || len(duplicates) != 1 || duplicates[0].LeagueID != *second.ID {
+		t.Errorf("expected the recheck to find the later submission, got %+v %v", duplicates, err)
+	}
+}
+
+func TestMarkLeagueDuplicate(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	original := env.submitTestLeague(t)
+	duplicate := env.submitTestLeague(t)
+
+	if err := env.service.MarkLeagueDuplicate(ctx, "organizer", *duplicate.ID, *original.ID); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected forbidden for an organizer, got %v", err)
+	}
+	if err := env.service.MarkLeagueDuplicate(ctx, "admin", *duplicate.ID, *duplicate.ID); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("expected a validation error for the league itself, got %v", err)
+	}
+	if err := env.service.MarkLeagueDuplicate(ctx, "admin", *duplicate.ID, "00000000-0000-0000-0000-000000000000"); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("expected a validation error for an unknown league, got %v", err)
+	}
+
+	if err := env.service.MarkLeagueDuplicate(ctx, "admin", *duplicate.ID, *original.ID); err != nil {
+		t.Fatalf("mark duplicate: %v", err)
+	}
+	stored, _ := env.repo.GetByUUID(ctx, *duplicate.ID)
+	if stored.Status != LeagueStatusRejected || stored.RejectionReason == nil || *stored.RejectionReason != DuplicateRejectionReason {
+		t.Errorf("expected a rejection with the standard reason, got %+v", stored)
+	}
+	if stored.DuplicateOfID == nil || *stored.DuplicateOfID != *original.ID {
+		t.Errorf("expected duplicate_of_id to be the original, got %v", stored.DuplicateOfID)
+	}
+	if types := env.notificationTypes(t, "organizer"); len(types) == 0 || types[0] != "league_rejected" {
+		t.Errorf("expected the organizer to be notified of the rejection, got %v", types)
+	}
+
+	if err := env.service.MarkLeagueDuplicate(ctx, "admin", *duplicate.ID, *original.ID); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict for a league that is no longer pending, got %v", err)
+	}
+	third := env.submitTestLeague(t)
+	if err := env.service.MarkLeagueDuplicate(ctx, "admin", *third.ID, *duplicate.ID); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("expected a validation error when pointing at a duplicate, got %v", err)
+	}
+}
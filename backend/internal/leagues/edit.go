@@ -0,0 +1,154 @@
+package leagues
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// editableColumns lists the league columns an edit can change, in the order changes are reported
+var editableColumns = []string{
+	"league_name",
+	"division",
+	"sport_id",
+	"venue_id",
+	"registration_deadline",
+	"season_start_date",
+	"season_end_date",
+	"pricing_strategy",
+	"pricing_amount",
+	"pricing_per_player",
+	"per_game_fee",
+	"gender",
+	"duration",
+	"minimum_team_players",
+	"season_details",
+	"registration_url",
+}
+
+// formDataOnlyFields are form_data keys with no column of their own that still matter for review
+var formDataOnlyFields = []string{
+	"sport_name",
+	"organization_name",
+	"venue_name",
+	"venue_address",
+	"venue_lat",
+	"venue_lng",
+	"game_occurrences",
+}
+
+// immediateEditFields can change on an approved league without another review
+var immediateEditFields = map[string]bool{
+	"registration_url": true,
+	"season_details":   true,
+}
+
+// leagueColumns returns the editable column values of a league keyed by column name, including form_data
+func leagueColumns(league *League) map[string]interface{} {
+	return map[string]interface{}{
+		"league_name":           league.LeagueName,
+		"division":              league.Division,
+		"sport_id":              league.SportID,
+		"venue_id":              league.VenueID,
+		"registration_deadline": league.RegistrationDeadline,
+		"season_start_date":     league.SeasonStartDate,
+		"season_end_date":       league.SeasonEndDate,
+		"pricing_strategy":      league.PricingStrategy,
+		"pricing_amount":        league.PricingAmount,
+		"pricing_per_player":    league.PricingPerPlayer,
+		"per_game_fee":          league.PerGameFee,
+		"gender":                league.Gender,
+		"duration":              league.Duration,
+		"minimum_team_players":  league.MinimumTeamPlayers,
+		"season_details":        league.SeasonDetails,
+		"registration_url":      league.RegistrationURL,
+		"form_data":             league.FormData,
+	}
+}
+
+// changedLeagueFields lists the fields that differ between the stored league and an edited version
+// Columns are compared first, then form_data keys that have no column (e.g. game_occurrences)
+func changedLeagueFields(current, updated *League) []string {
+	currentColumns := leagueColumns(current)
+	updatedColumns := leagueColumns(updated)
+
+	changed := []string{}
+	for _, column := range editableColumns {
+		if !jsonEqual(currentColumns[column], updatedColumns[column]) {
+			changed = append(changed, column)
+		}
+	}
+	for _, key := range formDataOnlyFields {
+		if !jsonEqual(current.FormData[key], updated.FormData[key]) {
+			changed = append(changed, key)
+		}
+	}
+	return changed
+}
+
+// requiresReview reports whether any of the changed fields needs admin approval on an approved league
+func requiresReview(changed []string) bool {
+	for _, field := range changed {
+		if !immediateEditFields[field] {
+			return true
+		}
+	}
+	return false
+}
+
+// immediateUpdateData builds the update for the whitelisted fields of an edit to an approved league
+// form_data keeps its stored values except for the whitelisted keys
+func immediateUpdateData(current, updated *League, changed []string) map[string]interface{} {
+	updatedColumns := leagueColumns(updated)
+
+	formData := FormData{}
+	for key, value := range current.FormData {
+		formData[key] = value
+	}
+
+	data := map[string]interface{}{}
+	for _, field := range changed {
+		if !immediateEditFields[field] {
+			continue
+		}
+		data[field] = updatedColumns[field]
+		formData[field] = updated.FormData[field]
+	}
+	if len(data) > 0 {
+		data["form_data"] = formData
+	}
+	return data
+}
+
+// jsonEqual compares two values by their JSON representation
+// Values read from the database and values built from a request differ in Go types (float64 vs *int64, etc.)
+func jsonEqual(a, b interface{}) bool {
+	return reflect.DeepEqual(normalizeJSON(a), normalizeJSON(b))
+}
+
+// normalizeJSON round-trips a value through JSON so equal documents compare equal
+func normalizeJSON(value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return value
+	}
+	return normalized
+}
+
+// stripPendingChanges removes unreviewed edits, review notes and review queue state from leagues served on public endpoints
+func stripPendingChanges(leagues []League) {
+	for i := range leagues {
+		leagues[i].PendingChanges = nil
+		leagues[i].PendingChangesAt = nil
+		leagues[i].PendingChangesBy = nil
+		leagues[i].DuplicateCandidates = nil
+		leagues[i].SubmittedAt = nil
+		leagues[i].ClaimedBy = nil
+		leagues[i].ClaimExpiresAt = nil
+		leagues[i].ReviewEscalatedAt = nil
+		leagues[i].PolicyDecision = nil
+	}
+}
@@ -0,0 +1,161 @@
+package leagues
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func editTestLeague() *League {
+	sportID := int64(3)
+	amount := 500.0
+	players := 10
+	deadline := &Date{Time: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)}
+	start := &Date{Time: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)}
+	return &League{
+		LeagueName:           stringPtr("Tuesday Kickball"),
+		Division:             stringPtr("Rec"),
+		SportID:              &sportID,
+		RegistrationDeadline: deadline,
+		SeasonStartDate:      start,
+		PricingStrategy:      PricingStrategyPerTeam,
+		PricingAmount:        &amount,
+		MinimumTeamPlayers:   &players,
+		RegistrationURL:      stringPtr("https://example.com/register"),
+		SeasonDetails:        stringPtr("Eight weeks"),
+		FormData: FormData{
+			"sport_id":         &sportID,
+			"sport_name":       "Kickball",
+			"registration_url": "https://example.com/register",
+			"season_details":   "Eight weeks",
+			"game_occurrences": GameOccurrences{{Day: "Tuesday", StartTime: "19:00", EndTime: "21:00"}},
+		},
+	}
+}
+
+// storedLeague round-trips a league through JSON the way it comes back from PostgREST
+func storedLeague(t *testing.T, league *League) *League {
+	t.Helper()
+	data, err := json.Marshal(league)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var stored League
+	if err := json.Unmarshal(data, &stored); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	return &stored
+}
+
+func TestChangedLeagueFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		edit   func(l *League)
+		want   []string
+		review bool
+	}{
+		{
+			name:   "no changes",
+			edit:   func(l *League) {},
+			want:   []string{},
+			review: false,
+		},
+		{
+			name: "registration url only",
+			edit: func(l *League) {
+				l.RegistrationURL = stringPtr("https://example.com/new")
+				l.FormData["registration_url"] = "https://example.com/new"
+			},
+			want:   []string{"registration_url"},
+			review: false,
+		},
+		{
+			name: "season details and pricing",
+			edit: func(l *League) {
+				amount := 600.0
+				l.PricingAmount = &amount
+				l.SeasonDetails = stringPtr("Ten weeks")
+				l.FormData["season_details"] = "Ten weeks"
+			},
+			want:   []string{"pricing_amount", "season_details"},
+			review: true,
+		},
+		{
+			name: "game occurrences",
+			edit: func(l *League) {
+				l.FormData["game_occurrences"] = GameOccurrences{{Day: "Wednesday", StartTime: "19:00", EndTime: "21:00"}}
+			},
+			want:   []string{"game_occurrences"},
+			review: true,
+		},
+		{
+			name: "new supplemental venue",
+			edit: func(l *League) {
+				l.FormData["venue_name"] = "Riverside Park"
+			},
+			want:   []string{"venue_name"},
+			review: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := storedLeague(t, editTestLeague())
+			updated := editTestLeague()
+			tt.edit(updated)
+
+			got := changedLeagueFields(current, updated)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("changedLeagueFields() = %v, want %v", got, tt.want)
+			}
+			if review := requiresReview(got); review != tt.review {
+				t.Errorf("requiresReview(%v) = %v, want %v", got, review, tt.review)
+			}
+		})
+	}
+}
+
+func TestImmediateUpdateData(t *testing.T) {
+	current := storedLeague(t, editTestLeague())
+	updated := editTestLeague()
+	updated.RegistrationURL = stringPtr("https://example.com/new")
+	updated.FormData["registration_url"] = "https://example.com/new"
+	updated.Division = stringPtr("Competitive")
+
+	data := immediateUpdateData(current, updated, changedLeagueFields(current, updated))
+
+	if _, ok := data["division"]; ok {
+		t.Errorf("division should wait for review, got %v", data)
+	}
+	if url, ok := data["registration_url"].(*string); !ok || *url != "https://example.com/new" {
+		t.Errorf("registration_url = %v, want the new URL", data["registration_url"])
+	}
+
+	formData, ok := data["form_data"].(FormData)
+	if !ok {
+		t.Fatalf("form_data missing from %v", data)
+	}
+	if formData["registration_url"] != "https://example.com/new" {
+		t.Errorf("form_data registration_url = %v, want the new URL", formData["registration_url"])
+	}
+	if formData["sport_name"] != "Kickball" {
+		t.Errorf("form_data sport_name = %v, want the stored value", formData["sport_name"])
+	}
+	if current.FormData["registration_url"] != "https://example.com/register" {
+		t.Errorf("stored form_data was modified")
+	}
+
+	if data := immediateUpdateData(current, updated, []string{"division"}); len(data) != 0 {
+		t.Errorf("immediateUpdateData() with no whitelisted changes = %v, want empty", data)
+	}
+}
+
+func TestStripPendingChanges(t *testing.T) {
+	leagues := []League{{PendingChanges: editTestLeague(), PendingChangesBy: stringPtr("user_1")}}
+	stripPendingChanges(leagues)
+
+	if leagues[0].PendingChanges != nil || leagues[0].PendingChangesBy != nil {
+		t.Errorf("pending changes were not stripped: %+v", leagues[0])
+	}
+}
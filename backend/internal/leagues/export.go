@@ -0,0 +1,299 @@
+package leagues
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/leaguefindr/backend/internal/sports"
+	"github.com/leaguefindr/backend/internal/venues"
+)
+
+// exportBatchSize is how many leagues are fetched per query while streaming an export
+const exportBatchSize = 500
+
+// ExportFormat is the file format of a league export
+type ExportFormat string
+
+const (
+	ExportFormatCSV    ExportFormat = "csv"
+	ExportFormatNDJSON ExportFormat = "ndjson"
+)
+
+// ParseExportFormat maps the format query parameter to an export format, defaulting to CSV
+func ParseExportFormat(value string) (ExportFormat, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "csv":
+		return ExportFormatCSV, true
+	case "ndjson", "jsonl":
+		return ExportFormatNDJSON, true
+	}
+	return "", false
+}
+
+// ContentType returns the MIME type of the export format
+func (f ExportFormat) ContentType() string {
+	if f == ExportFormatNDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// ExportRow is a league flattened for export, with sport, venue and organization names instead of IDs
+// Column names match the import columns so an exported CSV can be imported again
+type ExportRow struct {
+	ID                   string          `json:"id"`
+	LeagueName           string          `json:"league_name"`
+	Division             string          `json:"division"`
+	OrganizationName     string          `json:"organization_name"`
+	SportName            string          `json:"sport_name"`
+	Gender               string          `json:"gender"`
+	RegistrationDeadline string          `json:"registration_deadline"`
+	SeasonStartDate      string          `json:"season_start_date"`
+	SeasonEndDate        string          `json:"season_end_date"`
+	GameOccurrences      GameOccurrences `json:"game_occurrences"`
+	Duration             *int            `json:"duration"`
+	PricingStrategy      string          `json:"pricing_strategy"`
+	PricingAmount        *float64        `json:"pricing_amount"`
+	PricingPerPlayer     *float64        `json:"pricing_per_player"`
+	PerGameFee           *float64        `json:"per_game_fee"`
+	MinimumTeamPlayers   *int            `json:"minimum_team_players"`
+	VenueName            string          `json:"venue_name"`
+	VenueAddress         string          `json:"venue_address"`
+	VenueLat             *float64        `json:"venue_lat"`
+	VenueLng             *float64        `json:"venue_lng"`
+	RegistrationURL      string          `json:"registration_url"`
+	SeasonDetails        string          `json:"season_details"`
+	Status               string          `json:"status"`
+	LifecycleStatus      string          `json:"lifecycle_status"`
+	UpdatedAt            string          `json:"updated_at"`
+}
+
+// exportColumns is the CSV header, in the order of ExportRow.csvRecord
+var exportColumns = []string{
+	"id", "league_name", "division", "organization_name", "sport_name", "gender",
+	"registration_deadline", "season_start_date", "season_end_date", "game_occurrences", "duration",
+	"pricing_strategy", "pricing_amount", "pricing_per_player", "per_game_fee", "minimum_team_players",
+	"venue_name", "venue_address", "venue_lat", "venue_lng",
+	"registration_url", "season_details", "status", "lifecycle_status", "updated_at",
+}
+
+// csvRecord returns the row's CSV fields in exportColumns order
+func (row ExportRow) csvRecord() []string {
+	return []string{
+		row.ID, csvText(row.LeagueName), csvText(row.Division), csvText(row.OrganizationName), csvText(row.SportName), csvText(row.Gender),
+		row.RegistrationDeadline, row.SeasonStartDate, row.SeasonEndDate, formatGameOccurrences(row.GameOccurrences), formatInt(row.Duration),
+		row.PricingStrategy, formatFloat(row.PricingAmount), formatFloat(row.PricingPerPlayer), formatFloat(row.PerGameFee), formatInt(row.MinimumTeamPlayers),
+		csvText(row.VenueName), csvText(row.VenueAddress), formatFloat(row.VenueLat), formatFloat(row.VenueLng),
+		csvText(row.RegistrationURL), csvText(row.SeasonDetails), row.Status, row.LifecycleStatus, row.UpdatedAt,
+	}
+}
+
+// csvText stops spreadsheet programs from running user-entered text as a formula
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// formatGameOccurrences writes game times in the format the importer reads ("Monday 19:00-21:00; Wednesday 18:30-20:00")
+func formatGameOccurrences(occurrences GameOccurrences) string {
+	parts := make([]string, len(occurrences))
+	for i, occurrence := range occurrences {
+		parts[i] = fmt.Sprintf("%s %s-%s", occurrence.Day, occurrence.StartTime, occurrence.EndTime)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func formatFloat(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
+
+func formatInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
+
+// exportEncoder writes export rows in one format
+type exportEncoder interface {
+	Write(row ExportRow) error
+	Flush() error
+}
+
+// newExportEncoder returns an encoder for the format; CSV exports start with the header row
+func newExportEncoder(w io.Writer, format ExportFormat) (exportEncoder, error) {
+	if format == ExportFormatNDJSON {
+		buffered := bufio.NewWriter(w)
+		return &ndjsonEncoder{buffered: buffered, encoder: json.NewEncoder(buffered)}, nil
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportColumns); err != nil {
+		return nil, err
+	}
+	return &csvEncoder{writer: writer}, nil
+}
+
+type csvEncoder struct {
+	writer *csv.Writer
+}
+
+func (e *csvEncoder) Write(row ExportRow) error {
+	return e.writer.Write(row.csvRecord())
+}
+
+func (e *csvEncoder) Flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+type ndjsonEncoder struct {
+	buffered *bufio.Writer
+	encoder  *json.Encoder
+}
+
+func (e *ndjsonEncoder) Write(row ExportRow) error {
+	// json.Encoder ends every value with a newline, which is all NDJSON needs
+	return e.encoder.Encode(row)
+}
+
+func (e *ndjsonEncoder) Flush() error {
+	return e.buffered.Flush()
+}
+
+// exportNames resolves the sport, venue and organization names of exported leagues
+// Sports and venues are small reference tables loaded once; organizations are looked up as they appear
+type exportNames struct {
+	sports    map[int64]string
+	venues    map[int64]venues.Venue
+	orgs      map[string]string
+	lookupOrg func(ctx context.Context, orgID string) (string, error)
+}
+
+func newExportNames(sportList []sports.Sport, venueList []venues.Venue, lookupOrg func(ctx context.Context, orgID string) (string, error)) *exportNames {
+	names := &exportNames{
+		sports:    make(map[int64]string, len(sportList)),
+		venues:    make(map[int64]venues.Venue, len(venueList)),
+		orgs:      make(map[string]string),
+		lookupOrg: lookupOrg,
+	}
+	for _, sport := range sportList {
+		names.sports[sport.ID] = sport.Name
+	}
+	for _, venue := range venueList {
+		names.venues[venue.ID] = venue
+	}
+	return names
+}
+
+// orgName returns the organization's name, caching lookups (including failed ones)
+func (n *exportNames) orgName(ctx context.Context, orgID string) string {
+	if name, ok := n.orgs[orgID]; ok {
+		return name
+	}
+	name := ""
+	if n.lookupOrg != nil {
+		name, _ = n.lookupOrg(ctx, orgID)
+	}
+	n.orgs[orgID] = name
+	return name
+}
+
+// row flattens a league into an export row
+// Names fall back to the submitted form data for sports and venues that haven't been created yet
+func (n *exportNames) row(ctx context.Context, league *League) ExportRow {
+	row := ExportRow{
+		LeagueName:         derefString(league.LeagueName),
+		Division:           derefString(league.Division),
+		Gender:             derefString(league.Gender),
+		GameOccurrences:    league.GameOccurrences,
+		Duration:           league.Duration,
+		PricingStrategy:    league.PricingStrategy.String(),
+		PricingAmount:      league.PricingAmount,
+		PricingPerPlayer:   league.PricingPerPlayer,
+		PerGameFee:         league.PerGameFee,
+		MinimumTeamPlayers: league.MinimumTeamPlayers,
+		RegistrationURL:    derefString(league.RegistrationURL),
+		SeasonDetails:      derefString(league.SeasonDetails),
+		Status:             league.Status.String(),
+		LifecycleStatus:    league.LifecycleStatus.String(),
+		UpdatedAt:          league.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if league.ID != nil {
+		row.ID = *league.ID
+	}
+	// Game occurrences are only stored in form_data
+	if len(row.GameOccurrences) == 0 {
+		row.GameOccurrences = occurrencesOf(league)
+	}
+	row.RegistrationDeadline = formatDate(league.RegistrationDeadline)
+	row.SeasonStartDate = formatDate(league.SeasonStartDate)
+	row.SeasonEndDate = formatDate(league.SeasonEndDate)
+
+	if league.OrgID != nil {
+		row.OrganizationName = n.orgName(ctx, *league.OrgID)
+	}
+	if row.OrganizationName == "" {
+		row.OrganizationName = formDataString(league.FormData, "organization_name")
+	}
+
+	if league.SportID != nil {
+		row.SportName = n.sports[*league.SportID]
+	}
+	if row.SportName == "" {
+		row.SportName = formDataString(league.FormData, "sport_name")
+	}
+
+	venue, ok := venues.Venue{}, false
+	if league.VenueID != nil {
+		venue, ok = n.venues[*league.VenueID]
+	}
+	if ok {
+		row.VenueName = venue.Name
+		row.VenueAddress = venue.Address
+		lat, lng := venue.Lat, venue.Lng
+		row.VenueLat = &lat
+		row.VenueLng = &lng
+	} else {
+		row.VenueName = formDataString(league.FormData, "venue_name")
+		row.VenueAddress = formDataString(league.FormData, "venue_address")
+		if lat, ok := league.FormData["venue_lat"].(float64); ok {
+			row.VenueLat = &lat
+		}
+		if lng, ok := league.FormData["venue_lng"].(float64); ok {
+			row.VenueLng = &lng
+		}
+	}
+
+	return row
+}
+
+func formatDate(date *Date) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format("2006-01-02")
+}
+
+func formDataString(formData FormData, key string) string {
+	value, _ := formData[key].(string)
+	return value
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,189 @@
+package leagues
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/leaguefindr/backend/internal/shared"
+	"github.com/leaguefindr/backend/internal/sports"
+	"github.com/leaguefindr/backend/internal/venues"
+)
+
+func exportTestNames(lookups *int) *exportNames {
+	return newExportNames(
+		[]sports.Sport{{ID: 1, Name: "Kickball"}},
+		[]venues.Venue{{ID: 7, Name: "Green Lake Park", Address: "7201 E Green Lake Dr N", Lat: 47.68, Lng: -122.33}},
+		func(ctx context.Context, orgID string) (string, error) {
+			*lookups++
+			if orgID == "org-1" {
+				return "Seattle Parks", nil
+			}
+			return "", errors.New("not found")
+		},
+	)
+}
+
+func TestExportRowFlattensNames(t *testing.T) {
+	lookups := 0
+	names := exportTestNames(&lookups)
+
+	league := cloneTestLeague()
+	sportID := int64(1)
+	league.SportID = &sportID
+	league.GameOccurrences = nil
+
+	row := names.row(context.Background(), league)
+	if row.OrganizationName != "Seattle Parks" || row.SportName != "Kickball" || row.VenueName != "Green Lake Park" {
+		t.Errorf("names not flattened: %+v", row)
+	}
+	if row.VenueLat == nil || *row.VenueLat != 47.68 {
+		t.Errorf("expected venue coordinates, got %v", row.VenueLat)
+	}
+	if row.SeasonStartDate != "2025-03-15" || row.Status != "approved" {
+		t.Errorf("unexpected row: %+v", row)
+	}
+	if len(row.GameOccurrences) != 1 || row.GameOccurrences[0].Day != "Tuesday" {
+		t.Errorf("expected game occurrences from form_data, got %+v", row.GameOccurrences)
+	}
+
+	names.row(context.Background(), league)
+	if lookups != 1 {
+		t.Errorf("expected organization lookups to be cached, got %d", lookups)
+	}
+}
+
+func TestExportRowFallsBackToFormData(t *testing.T) {
+	lookups := 0
+	names := exportTestNames(&lookups)
+
+	league := cloneTestLeague()
+	league.OrgID = stringPtr("org-2")
+	league.VenueID = nil
+	league.FormData["organization_name"] = "Rec Dept"
+	league.FormData["sport_name"] = "Pickleball"
+	league.FormData["venue_name"] = "New Courts"
+	league.FormData["venue_address"] = "9 Elm St"
+	league.FormData["venue_lat"] = 47.1
+
+	row := names.row(context.Background(), league)
+	if row.OrganizationName != "Rec Dept" || row.SportName != "Pickleball" || row.VenueName != "New Courts" || row.VenueAddress != "9 Elm St" {
+		t.Errorf("expected form_data names, got %+v", row)
+	}
+	if row.VenueLat == nil || *row.VenueLat != 47.1 || row.VenueLng != nil {
+		t.Errorf("unexpected coordinates %v %v", row.VenueLat, row.VenueLng)
+	}
+}
+
+func TestCSVExportCanBeImported(t *testing.T) {
+	lookups := 0
+	row := exportTestNames(&lookups).row(context.Background(), cloneTestLeague())
+	row.Division = "Rec"
+	row.Gender = "Coed"
+
+	var buf bytes.Buffer
+	encoder, err := newExportEncoder(&buf, ExportFormatCSV)
+	if err != nil {
+		t.Fatalf("newExportEncoder: %v", err)
+	}
+	encoder.Write(row)
+	if err := encoder.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	records, err := readCSV(buf.Bytes())
+	if err != nil {
+		t.Fatalf("readCSV: %v", err)
+	}
+	if len(records) != 2 || len(records[0]) != len(records[1]) {
+		t.Fatalf("expected a header and one row of the same width, got %q", records)
+	}
+
+	rows, _, err := parseImportRows(records)
+	if err != nil {
+		t.Fatalf("parseImportRows: %v", err)
+	}
+	req := rows[0].Request
+	if len(rows[0].Errors) != 0 || *req.LeagueName != "Spring Kickball" || *req.SeasonStartDate != "2025-03-15" || *req.VenueAddress != "7201 E Green Lake Dr N" {
+		t.Errorf("exported row did not import cleanly: %q %+v", rows[0].Errors, req)
+	}
+	if len(req.GameOccurrences) != 1 || req.GameOccurrences[0] != (GameOccurrence{"Tuesday", "18:00", "20:00"}) {
+		t.Errorf("game occurrences did not round trip: %+v", req.GameOccurrences)
+	}
+}
+
+func TestCSVTextEscapesFormulas(t *testing.T) {
+	tests := map[string]string{
+		"=HYPERLINK(\"x\")": "'=HYPERLINK(\"x\")",
+		"@SUM(A1)":          "'@SUM(A1)",
+		"-1":                "'-1",
+		"Spring League":     "Spring League",
+		"":                  "",
+	}
+	for value, want := range tests {
+		if got := csvText(value); got != want {
+			t.Errorf("csvText(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestStreamExport(t *testing.T) {
+	row := ExportRow{ID: "league-1", LeagueName: "Spring Kickball", GameOccurrences: GameOccurrences{}}
+	req := httptest.NewRequest(http.MethodGet, "/v1/leagues/export", nil)
+
+	t.Run("ndjson rows", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		streamExport(rec, req, ExportFormatNDJSON, "leagues.ndjson", func(emit func(ExportRow) error) error {
+			emit(row)
+			return emit(row)
+		})
+		if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/x-ndjson" {
+			t.Fatalf("unexpected response %d %q", rec.Code, rec.Header().Get("Content-Type"))
+		}
+		if !strings.Contains(rec.Header().Get("Content-Disposition"), "leagues.ndjson") {
+			t.Errorf("expected an attachment filename, got %q", rec.Header().Get("Content-Disposition"))
+		}
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		if len(lines) != 2 {
+			t.Fatalf("expected 2 lines, got %q", rec.Body.String())
+		}
+		var decoded ExportRow
+		if err := json.Unmarshal([]byte(lines[0]), &decoded); err != nil || decoded.ID != "league-1" {
+			t.Errorf("line is not a JSON row: %q (%v)", lines[0], err)
+		}
+	})
+
+	t.Run("empty export still has a CSV header", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		streamExport(rec, req, ExportFormatCSV, "leagues.csv", func(emit func(ExportRow) error) error { return nil })
+		if rec.Code != http.StatusOK || strings.TrimSpace(rec.Body.String()) != strings.Join(exportColumns, ",") {
+			t.Errorf("unexpected response %d %q", rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("error before the first row", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		streamExport(rec, req, ExportFormatCSV, "leagues.csv", func(emit func(ExportRow) error) error {
+			return errors.New("access denied")
+		})
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("expected 500, got %d", rec.Code)
+		}
+	})
+
+	t.Run("typed error before the first row", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		streamExport(rec, req, ExportFormatCSV, "leagues.csv", func(emit func(ExportRow) error) error {
+			return fmt.Errorf("failed to export: %w", shared.Forbidden("user does not have access to this organization"))
+		})
+		if rec.Code != http.StatusForbidden || rec.Header().Get("Content-Type") != shared.ProblemContentType {
+			t.Errorf("expected a 403 problem, got %d %q", rec.Code, rec.Header().Get("Content-Type"))
+		}
+	})
+}
@@ -0,0 +1,311 @@
+package leagues
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/shared"
+	"github.com/leaguefindr/backend/internal/venues"
+)
+
+// maxSearchQueryLength limits the q parameter of the public listing
+const maxSearchQueryLength = 200
+
+// maxFilterVenues caps the venues a location filter may match; their IDs go into the PostgREST query string,
+// which has to stay within URL length limits. League IDs from text search are capped by maxSearchResults
+const maxFilterVenues = 200
+
+// parseLeagueFilter reads the public listing filters from the query string
+// Returns an error describing the first invalid parameter
+func parseLeagueFilter(query url.Values) (LeagueFilter, error) {
+	var filter LeagueFilter
+
+	if v := strings.TrimSpace(query.Get("q")); v != "" {
+		if len(v) > maxSearchQueryLength {
+			return filter, fmt.Errorf("invalid q: must be at most %d characters", maxSearchQueryLength)
+		}
+		// Queries with no letters or digits can't match anything, treat them as absent
+		if len(shared.Tokenize(v)) > 0 {
+			filter.Query = v
+		}
+	}
+
+	if v := strings.TrimSpace(query.Get("sport_id")); v != "" {
+		sportID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || sportID <= 0 {
+			return filter, fmt.Errorf("invalid sport_id: must be a positive integer")
+		}
+		filter.SportID = &sportID
+	}
+
+	if v := strings.TrimSpace(query.Get("gender")); v != "" {
+		if len(v) > 255 {
+			return filter, fmt.Errorf("invalid gender: must be at most 255 characters")
+		}
+		filter.Gender = &v
+	}
+
+	if v := strings.TrimSpace(query.Get("division")); v != "" {
+		if len(v) > 255 {
+			return filter, fmt.Errorf("invalid division: must be at most 255 characters")
+		}
+		filter.Division = &v
+	}
+
+	// day may be repeated or comma-separated (day=monday,wednesday)
+	for _, value := range query["day"] {
+		for _, part := range strings.Split(value, ",") {
+			if strings.TrimSpace(part) == "" {
+				continue
+			}
+			weekday, ok := NormalizeWeekday(part)
+			if !ok {
+				return filter, fmt.Errorf("invalid day: %q is not a day of the week", strings.TrimSpace(part))
+			}
+			if !slices.Contains(filter.Days, weekday) {
+				filter.Days = append(filter.Days, weekday)
+			}
+		}
+	}
+
+	// lifecycle may be repeated or comma-separated (lifecycle=registration_open,full)
+	for _, value := range query["lifecycle"] {
+		for _, part := range strings.Split(value, ",") {
+			state := LifecycleStatus(strings.ToLower(strings.TrimSpace(part)))
+			if state == "" {
+				continue
+			}
+			if !state.IsValid() {
+				return filter, fmt.Errorf("invalid lifecycle: %q is not a lifecycle status", strings.TrimSpace(part))
+			}
+			if !slices.Contains(filter.Lifecycle, state) {
+				filter.Lifecycle = append(filter.Lifecycle, state)
+			}
+		}
+	}
+
+	var err error
+	if filter.MinPrice, err = parsePriceParam(query, "min_price"); err != nil {
+		return filter, err
+	}
+	if filter.MaxPrice, err = parsePriceParam(query, "max_price"); err != nil {
+		return filter, err
+	}
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return filter, fmt.Errorf("invalid price range: min_price must not exceed max_price")
+	}
+
+	if filter.DeadlineAfter, err = parseDateParam(query, "deadline_after"); err != nil {
+		return filter, err
+	}
+	if filter.DeadlineBefore, err = parseDateParam(query, "deadline_before"); err != nil {
+		return filter, err
+	}
+	if filter.DeadlineAfter != nil && filter.DeadlineBefore != nil && filter.DeadlineAfter.After(*filter.DeadlineBefore) {
+		return filter, fmt.Errorf("invalid deadline range: deadline_after must not be later than deadline_before")
+	}
+
+	if filter.SeasonStartAfter, err = parseDateParam(query, "season_start_after"); err != nil {
+		return filter, err
+	}
+	if filter.SeasonStartBefore, err = parseDateParam(query, "season_start_before"); err != nil {
+		return filter, err
+	}
+	if filter.SeasonStartAfter != nil && filter.SeasonStartBefore != nil && filter.SeasonStartAfter.After(*filter.SeasonStartBefore) {
+		return filter, fmt.Errorf("invalid season start range: season_start_after must not be later than season_start_before")
+	}
+
+	if v := strings.TrimSpace(query.Get("near")); v != "" {
+		near, err := shared.ParseGeoPoint(v)
+		if err != nil {
+			return filter, fmt.Errorf("invalid near: %v", err)
+		}
+		filter.Near = &near
+		if filter.RadiusKm, err = venues.ParseRadiusKm(strings.TrimSpace(query.Get("radius_km"))); err != nil {
+			return filter, err
+		}
+	} else if query.Get("radius_km") != "" {
+		return filter, fmt.Errorf("invalid radius_km: near is required when radius_km is set")
+	}
+
+	if v := strings.TrimSpace(query.Get("bbox")); v != "" {
+		box, err := shared.ParseBoundingBox(v)
+		if err != nil {
+			return filter, fmt.Errorf("invalid bbox: %v", err)
+		}
+		filter.BBox = &box
+	}
+
+	return filter, nil
+}
+
+// parsePriceParam parses an optional non-negative price query parameter
+func parsePriceParam(query url.Values, name string) (*float64, error) {
+	v := strings.TrimSpace(query.Get(name))
+	if v == "" {
+		return nil, nil
+	}
+	price, err := strconv.ParseFloat(v, 64)
+	if err != nil || price < 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+		return nil, fmt.Errorf("invalid %s: must be a non-negative number", name)
+	}
+	return &price, nil
+}
+
+// parseDateParam parses an optional YYYY-MM-DD query parameter
+func parseDateParam(query url.Values, name string) (*time.Time, error) {
+	v := strings.TrimSpace(query.Get(name))
+	if v == "" {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: must be a date in YYYY-MM-DD format", name)
+	}
+	return &date, nil
+}
+
+// likeEscaper escapes the LIKE wildcards and the escape character itself
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike makes ILIKE compare a value literally, ignoring only case, so "100%" doesn't match everything
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+// buildFacets counts facet values across the given rows
+// Rows are expected to be filtered by everything except sport, gender and day;
+// each facet then applies the other two of those filters but not its own
+func buildFacets(rows []leagueFacetRow, filter LeagueFilter) *LeagueFacets {
+	sportCounts := map[int64]int64{}
+	sportLabels := map[int64]string{}
+	genderCounts := map[string]int64{}
+	genderLabels := map[string]string{}
+	dayCounts := map[string]int64{}
+
+	for _, row := range rows {
+		sportOK := matchesSport(row, filter)
+		genderOK := matchesGender(row, filter)
+		dayOK := matchesDays(row, filter)
+
+		if genderOK && dayOK && row.SportID != nil {
+			sportCounts[*row.SportID]++
+			if _, ok := sportLabels[*row.SportID]; !ok && row.SportName != nil {
+				sportLabels[*row.SportID] = *row.SportName
+			}
+		}
+
+		if sportOK && dayOK && row.Gender != nil && strings.TrimSpace(*row.Gender) != "" {
+			key := strings.ToLower(strings.TrimSpace(*row.Gender))
+			genderCounts[key]++
+			if _, ok := genderLabels[key]; !ok {
+				genderLabels[key] = strings.TrimSpace(*row.Gender)
+			}
+		}
+
+		if sportOK && genderOK {
+			for _, day := range uniqueDays(row.GameDays) {
+				dayCounts[day]++
+			}
+		}
+	}
+
+	facets := &LeagueFacets{
+		Sports:  []FacetCount{},
+		Genders: []FacetCount{},
+		Days:    []FacetCount{},
+	}
+
+	for id, count := range sportCounts {
+		facets.Sports = append(facets.Sports, FacetCount{
+			Value: strconv.FormatInt(id, 10),
+			Label: sportLabels[id],
+			Count: count,
+		})
+	}
+	sortFacetCounts(facets.Sports)
+
+	for key, count := range genderCounts {
+		facets.Genders = append(facets.Genders, FacetCount{
+			Value: genderLabels[key],
+			Count: count,
+		})
+	}
+	sortFacetCounts(facets.Genders)
+
+	// Days keep calendar order rather than count order
+	for _, weekday := range Weekdays {
+		if count := dayCounts[weekday]; count > 0 {
+			facets.Days = append(facets.Days, FacetCount{Value: weekday, Count: count})
+		}
+	}
+
+	return facets
+}
+
+// facetBaseFilter returns the filter with the faceted fields cleared
+func facetBaseFilter(filter LeagueFilter) LeagueFilter {
+	filter.SportID = nil
+	filter.Gender = nil
+	filter.Days = nil
+	return filter
+}
+
+func matchesSport(row leagueFacetRow, filter LeagueFilter) bool {
+	if filter.SportID == nil {
+		return true
+	}
+	return row.SportID != nil && *row.SportID == *filter.SportID
+}
+
+func matchesGender(row leagueFacetRow, filter LeagueFilter) bool {
+	if filter.Gender == nil {
+		return true
+	}
+	return row.Gender != nil && strings.EqualFold(strings.TrimSpace(*row.Gender), strings.TrimSpace(*filter.Gender))
+}
+
+func matchesDays(row leagueFacetRow, filter LeagueFilter) bool {
+	if len(filter.Days) == 0 {
+		return true
+	}
+	for _, day := range uniqueDays(row.GameDays) {
+		for _, wanted := range filter.Days {
+			if strings.EqualFold(day, wanted) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// uniqueDays normalizes the lowercase day names from league_game_days, dropping unknown values
+func uniqueDays(days []string) []string {
+	seen := map[string]bool{}
+	var result []string
+	for _, day := range days {
+		weekday, ok := NormalizeWeekday(day)
+		if !ok || seen[weekday] {
+			continue
+		}
+		seen[weekday] = true
+		result = append(result, weekday)
+	}
+	return result
+}
+
+// sortFacetCounts orders facets by count descending, then by value
+func sortFacetCounts(counts []FacetCount) {
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Value < counts[j].Value
+	})
+}
@@ -0,0 +1,298 @@
+package leagues
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/shared"
+	"github.com/leaguefindr/backend/internal/venues"
+)
+
+func TestParseLeagueFilter(t *testing.T) {
+	query, _ := url.ParseQuery("sport_id=3&gender=Coed&division=Rec&day=monday,Wednesday&day=monday&min_price=10&max_price=75.5&deadline_after=2025-01-01&deadline_before=2025-02-01&season_start_after=2025-03-01&season_start_before=2025-04-01")
+
+	filter, err := parseLeagueFilter(query)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if filter.SportID == nil || *filter.SportID != 3 {
+		t.Errorf("expected sport_id 3, got %v", filter.SportID)
+	}
+	if filter.Gender == nil || *filter.Gender != "Coed" {
+		t.Errorf("expected gender Coed, got %v", filter.Gender)
+	}
+	if filter.Division == nil || *filter.Division != "Rec" {
+		t.Errorf("expected division Rec, got %v", filter.Division)
+	}
+	if len(filter.Days) != 2 || filter.Days[0] != "Monday" || filter.Days[1] != "Wednesday" {
+		t.Errorf("expected days [Monday Wednesday], got %v", filter.Days)
+	}
+	if filter.MinPrice == nil || *filter.MinPrice != 10 {
+		t.Errorf("expected min_price 10, got %v", filter.MinPrice)
+	}
+	if filter.MaxPrice == nil || *filter.MaxPrice != 75.5 {
+		t.Errorf("expected max_price 75.5, got %v", filter.MaxPrice)
+	}
+	if filter.DeadlineAfter == nil || filter.DeadlineAfter.Format("2006-01-02") != "2025-01-01" {
+		t.Errorf("expected deadline_after 2025-01-01, got %v", filter.DeadlineAfter)
+	}
+	if filter.SeasonStartBefore == nil || filter.SeasonStartBefore.Format("2006-01-02") != "2025-04-01" {
+		t.Errorf("expected season_start_before 2025-04-01, got %v", filter.SeasonStartBefore)
+	}
+}
+
+func TestParseLeagueFilterEmpty(t *testing.T) {
+	filter, err := parseLeagueFilter(url.Values{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if filter.SportID != nil || filter.Gender != nil || len(filter.Days) != 0 || filter.MinPrice != nil || filter.DeadlineAfter != nil {
+		t.Errorf("expected empty filter, got %+v", filter)
+	}
+}
+
+func TestParseLeagueFilterInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"non-numeric sport_id", "sport_id=abc"},
+		{"zero sport_id", "sport_id=0"},
+		{"unknown day", "day=funday"},
+		{"unknown lifecycle", "lifecycle=archived"},
+		{"negative price", "min_price=-5"},
+		{"non-numeric price", "max_price=cheap"},
+		{"inverted price range", "min_price=50&max_price=20"},
+		{"bad date format", "deadline_after=01/02/2025"},
+		{"inverted deadline range", "deadline_after=2025-02-01&deadline_before=2025-01-01"},
+		{"inverted season range", "season_start_after=2025-05-01&season_start_before=2025-04-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, _ := url.ParseQuery(tt.query)
+			if _, err := parseLeagueFilter(query); err == nil {
+				t.Errorf("expected error for %q", tt.query)
+			}
+		})
+	}
+}
+
+func TestBuildFacets(t *testing.T) {
+	soccer := int64(1)
+	hockey := int64(2)
+	rows := []leagueFacetRow{
+		{SportID: &soccer, SportName: stringPtr("Soccer"), Gender: stringPtr("Coed"), GameDays: []string{"monday", "wednesday"}},
+		{SportID: &soccer, SportName: stringPtr("Soccer"), Gender: stringPtr("Men"), GameDays: []string{"monday"}},
+		{SportID: &hockey, SportName: stringPtr("Hockey"), Gender: stringPtr("coed"), GameDays: []string{"friday"}},
+	}
+
+	t.Run("no filter counts everything", func(t *testing.T) {
+		facets := buildFacets(rows, LeagueFilter{})
+
+		if len(facets.Sports) != 2 || facets.Sports[0].Value != "1" || facets.Sports[0].Label != "Soccer" || facets.Sports[0].Count != 2 {
+			t.Errorf("unexpected sport facets: %+v", facets.Sports)
+		}
+		if len(facets.Genders) != 2 || facets.Genders[0].Value != "Coed" || facets.Genders[0].Count != 2 {
+			t.Errorf("expected genders to be grouped case-insensitively, got %+v", facets.Genders)
+		}
+		if len(facets.Days) != 3 || facets.Days[0].Value != "Monday" || facets.Days[0].Count != 2 || facets.Days[2].Value != "Friday" {
+			t.Errorf("expected days in calendar order, got %+v", facets.Days)
+		}
+	})
+
+	t.Run("facet ignores its own filter", func(t *testing.T) {
+		facets := buildFacets(rows, LeagueFilter{SportID: &soccer})
+
+		// Sport counts are unaffected by the sport filter
+		if len(facets.Sports) != 2 {
+			t.Errorf("expected both sports to remain visible, got %+v", facets.Sports)
+		}
+		// Gender counts only include soccer leagues
+		for _, g := range facets.Genders {
+			if g.Count != 1 {
+				t.Errorf("expected one soccer league per gender, got %+v", facets.Genders)
+			}
+		}
+		for _, d := range facets.Days {
+			if d.Value == "Friday" {
+				t.Errorf("expected Friday to be excluded by the sport filter, got %+v", facets.Days)
+			}
+		}
+	})
+
+	t.Run("day filter narrows other facets", func(t *testing.T) {
+		facets := buildFacets(rows, LeagueFilter{Days: []string{"Friday"}})
+
+		if len(facets.Sports) != 1 || facets.Sports[0].Label != "Hockey" {
+			t.Errorf("expected only hockey, got %+v", facets.Sports)
+		}
+		if len(facets.Days) != 3 {
+			t.Errorf("expected all days to remain visible, got %+v", facets.Days)
+		}
+	})
+}
+
+func TestFacetBaseFilter(t *testing.T) {
+	sportID := int64(4)
+	min := 10.0
+	filter := LeagueFilter{SportID: &sportID, Gender: stringPtr("Women"), Days: []string{"Monday"}, MinPrice: &min}
+
+	base := facetBaseFilter(filter)
+	if base.SportID != nil || base.Gender != nil || base.Days != nil {
+		t.Errorf("expected faceted fields to be cleared, got %+v", base)
+	}
+	if base.MinPrice == nil || *base.MinPrice != 10 {
+		t.Errorf("expected non-faceted fields to be kept, got %+v", base)
+	}
+	if filter.SportID == nil {
+		t.Errorf("expected original filter to be unchanged")
+	}
+}
+
+func TestParseLeagueFilterLocation(t *testing.T) {
+	query, _ := url.ParseQuery("near=47.6062,-122.3321&radius_km=5&bbox=-122.5,47.5,-122.2,47.7")
+
+	filter, err := parseLeagueFilter(query)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if filter.Near == nil || filter.Near.Lat != 47.6062 || filter.Near.Lng != -122.3321 {
+		t.Errorf("unexpected near: %+v", filter.Near)
+	}
+	if filter.RadiusKm != 5 {
+		t.Errorf("expected radius 5, got %v", filter.RadiusKm)
+	}
+	if filter.BBox == nil || filter.BBox.MinLng != -122.5 || filter.BBox.MaxLat != 47.7 {
+		t.Errorf("unexpected bbox: %+v", filter.BBox)
+	}
+	if !filter.HasLocation() {
+		t.Errorf("expected HasLocation to be true")
+	}
+
+	query, _ = url.ParseQuery("near=47.6062,-122.3321")
+	filter, err = parseLeagueFilter(query)
+	if err != nil || filter.RadiusKm <= 0 {
+		t.Errorf("expected default radius, got %v (err %v)", filter.RadiusKm, err)
+	}
+
+	for _, raw := range []string{"radius_km=5", "near=abc", "near=47,-122&radius_km=0", "bbox=1,2,3"} {
+		query, _ := url.ParseQuery(raw)
+		if _, err := parseLeagueFilter(query); err == nil {
+			t.Errorf("expected error for %q", raw)
+		}
+	}
+}
+
+func TestSortByDistance(t *testing.T) {
+	venueA, venueB, venueC := int64(1), int64(2), int64(3)
+	leagues := []League{
+		{ID: stringPtr("far"), VenueID: &venueA},
+		{ID: stringPtr("no-venue")},
+		{ID: stringPtr("near"), VenueID: &venueB},
+		{ID: stringPtr("unknown-venue"), VenueID: &venueC},
+	}
+
+	sortByDistance(leagues, map[int64]float64{venueA: 8.2, venueB: 1.5})
+
+	want := []string{"near", "far", "no-venue", "unknown-venue"}
+	for i, id := range want {
+		if *leagues[i].ID != id {
+			t.Errorf("position %d: expected %s, got %s", i, id, *leagues[i].ID)
+		}
+	}
+	if leagues[0].DistanceKm == nil || *leagues[0].DistanceKm != 1.5 {
+		t.Errorf("expected distance_km 1.5, got %v", leagues[0].DistanceKm)
+	}
+	if leagues[2].DistanceKm != nil {
+		t.Errorf("expected no distance for league without venue")
+	}
+}
+
+func TestPaginateLeagues(t *testing.T) {
+	leagues := make([]League, 5)
+
+	if got := paginateLeagues(leagues, 2, 0); len(got) != 2 {
+		t.Errorf("expected first page of 2, got %d", len(got))
+	}
+	if got := paginateLeagues(leagues, 2, 4); len(got) != 1 {
+		t.Errorf("expected last page of 1, got %d", len(got))
+	}
+	if got := paginateLeagues(leagues, 2, 10); got == nil || len(got) != 0 {
+		t.Errorf("expected empty page past the end, got %v", got)
+	}
+}
+
+func TestParseLeagueFilterQuery(t *testing.T) {
+	filter, err := parseLeagueFilter(url.Values{"q": {"  monday soccer "}})
+	if err != nil || filter.Query != "monday soccer" {
+		t.Errorf("expected trimmed query, got %q (err %v)", filter.Query, err)
+	}
+
+	filter, err = parseLeagueFilter(url.Values{"q": {"!!!"}})
+	if err != nil || filter.Query != "" {
+		t.Errorf("expected query without words to be ignored, got %q (err %v)", filter.Query, err)
+	}
+
+	long := make([]byte, maxSearchQueryLength+1)
+	for i := range long {
+		long[i] = 'a'
+	}
+	if _, err := parseLeagueFilter(url.Values{"q": {string(long)}}); err == nil {
+		t.Errorf("expected error for overly long query")
+	}
+}
+
+func TestParseLeagueFilterLifecycle(t *testing.T) {
+	query, _ := url.ParseQuery("lifecycle=Full,registration_open&lifecycle=full&lifecycle=completed")
+
+	filter, err := parseLeagueFilter(query)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []LifecycleStatus{LifecycleFull, LifecycleRegistrationOpen, LifecycleCompleted}
+	if len(filter.Lifecycle) != len(want) {
+		t.Fatalf("expected lifecycle %v, got %v", want, filter.Lifecycle)
+	}
+	for i := range want {
+		if filter.Lifecycle[i] != want[i] {
+			t.Errorf("expected lifecycle %v, got %v", want, filter.Lifecycle)
+		}
+	}
+}
+
+func TestEscapeLike(t *testing.T) {
+	tests := map[string]string{
+		"Coed":      "Coed",
+		"100%":      `100\%`,
+		"open_play": `open\_play`,
+		`a\b`:       `a\\b`,
+	}
+	for value, want := range tests {
+		if got := escapeLike(value); got != want {
+			t.Errorf("escapeLike(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestLocationFilterMatchingTooManyVenues(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	for i := 0; i <= maxFilterVenues; i++ {
+		request := &venues.CreateVenueRequest{Name: fmt.Sprintf("Field %d", i), Address: fmt.Sprintf("%d Main St", i), Lat: 47.6, Lng: -122.3}
+		if _, err := env.service.venuesService.CreateVenue(ctx, request); err != nil {
+			t.Fatalf("create venue: %v", err)
+		}
+	}
+
+	filter := LeagueFilter{Near: &shared.GeoPoint{Lat: 47.6, Lng: -122.3}, RadiusKm: 5}
+	if _, _, _, err := env.service.GetApprovedLeaguesWithPagination(ctx, filter, pagination.Params{Limit: 10}); !errors.Is(err, shared.ErrBadRequest) {
+		t.Errorf("expected a bad request for too many venues, got %v", err)
+	}
+}
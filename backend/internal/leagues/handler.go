@@ -1,47 +1,80 @@
 package leagues
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	"github.com/leaguefindr/backend/internal/auth"
+	"github.com/leaguefindr/backend/internal/ical"
+	"github.com/leaguefindr/backend/internal/idempotency"
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/shared"
 )
 
 type Handler struct {
 	service     *Service
 	authService *auth.Service
 	validator   *validator.Validate
+	idempotency *idempotency.Middleware
 }
 
 func NewHandler(service *Service, authService *auth.Service) *Handler {
 	return &Handler{
 		service:     service,
 		authService: authService,
-		validator:   validator.New(),
+		validator:   shared.NewValidator(),
 	}
 }
 
+// SetIdempotency makes the create routes honor the Idempotency-Key header
+func (h *Handler) SetIdempotency(m *idempotency.Middleware) {
+	h.idempotency = m
+}
+
 // RegisterRoutes registers all league routes
 func (h *Handler) RegisterRoutes(r chi.Router) {
 	r.Route("/leagues", func(r chi.Router) {
 		// Public routes (no auth required)
 		r.Get("/", h.GetApprovedLeagues)
+		r.Get("/export", h.ExportApprovedLeagues)
 		r.Get("/approved/{id}", h.GetApprovedLeagueByID)
+		r.Get("/approved/{id}/seasons", h.GetLeagueSeasons)
+		r.Get("/{id}/calendar.ics", h.GetLeagueCalendar)
+		r.Get("/{id}/schedule", h.GetLeagueSchedule)
+		r.Get("/org/{orgId}/calendar.ics", h.GetOrgCalendar)
 
 		// Protected routes (JWT required)
 		r.Group(func(r chi.Router) {
 			r.Use(auth.JWTMiddleware)
-			r.Post("/", h.CreateLeague)
+			r.With(h.idempotency.Handler).Post("/", h.CreateLeague)
+			r.Post("/import", h.ImportLeagues)
+			r.Put("/{id}", h.UpdateLeague)
+			r.With(h.idempotency.Handler).Post("/{id}/clone", h.CloneLeague)
+			r.Put("/{id}/cancel", h.CancelLeague)
+			r.Put("/{id}/full", h.MarkLeagueFull)
+			r.Put("/{id}/reopen", h.ReopenLeague)
+			r.Post("/{id}/schedule/exceptions", h.AddScheduleException)
+			r.Delete("/{id}/schedule/exceptions/{exceptionId}", h.RemoveScheduleException)
+			r.Get("/{id}/comments", h.GetLeagueComments)
+			r.Post("/{id}/comments", h.AddLeagueComment)
 			r.Get("/org/{orgId}", h.GetLeaguesByOrgID)
+			r.Get("/org/{orgId}/export", h.ExportOrgLeagues)
 
 			// Draft routes
 			r.Get("/drafts/org/{orgId}", h.GetDraft)
 			r.Post("/drafts", h.SaveDraft)
 			r.Delete("/drafts/org/{orgId}", h.DeleteDraft)
+			r.With(h.idempotency.Handler).Post("/drafts/{draftId}/submit", h.SubmitDraft)
 			r.Get("/drafts/{orgId}", h.GetDraftsByOrgID)
 
 			// Template routes
@@ -55,342 +88,1387 @@ func (h *Handler) RegisterRoutes(r chi.Router) {
 				r.Use(auth.RequireAdmin(h.authService))
 				r.Get("/all", h.GetAllLeagues)
 				r.Get("/pending", h.GetPendingLeagues)
+				r.Get("/metrics", h.GetReviewMetrics)
 				r.Get("/drafts/all", h.GetAllDrafts)
 				r.Get("/templates/all", h.GetAllTemplates)
+				r.Post("/bulk", h.BulkReviewLeagues)
+				r.Get("/policy-rules", h.GetPolicyRules)
+				r.Post("/policy-rules", h.CreatePolicyRule)
+				r.Put("/policy-rules/{ruleId}", h.UpdatePolicyRule)
+				r.Delete("/policy-rules/{ruleId}", h.DeletePolicyRule)
 				r.Get("/{id}", h.GetLeagueByID)
+				r.Put("/{id}/claim", h.ClaimLeague)
+				r.Delete("/{id}/claim", h.ReleaseLeagueClaim)
 				r.Put("/{id}/approve", h.ApproveLeague)
 				r.Put("/{id}/reject", h.RejectLeague)
+				r.Put("/{id}/request-changes", h.RequestLeagueChanges)
+				r.Get("/{id}/history", h.GetLeagueHistory)
+				r.Get("/{id}/diff", h.GetLeagueRevisionDiff)
+				r.Get("/{id}/conflicts", h.GetLeagueVenueConflicts)
+				r.Get("/{id}/duplicates", h.GetLeagueDuplicates)
+				r.Put("/{id}/duplicate", h.MarkLeagueDuplicate)
 			})
 		})
 	})
 }
 
-// GetApprovedLeagues returns all approved leagues (public)
-func (h *Handler) GetApprovedLeagues(w http.ResponseWriter, r *http.Request) {
-	// Parse query parameters for pagination
-	limitStr := r.URL.Query().Get("limit")
-	offsetStr := r.URL.Query().Get("offset")
+// GetApprovedLeagues returns all approved leagues (public)
+func (h *Handler) GetApprovedLeagues(w http.ResponseWriter, r *http.Request) {
+	page, err := pagination.Parse(r.URL.Query(), approvedLeaguesPaging)
+	if err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter, err := parseLeagueFilter(r.URL.Query())
+	if err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	leagues, count, nextCursor, err := h.service.GetApprovedLeaguesWithPagination(r.Context(), filter, page)
+	if err != nil {
+		slog.Error("get approved leagues error", "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch leagues")
+		return
+	}
+
+	if leagues == nil {
+		leagues = []League{}
+	}
+	stripPendingChanges(leagues)
+
+	facets, err := h.service.GetApprovedLeagueFacets(r.Context(), filter)
+	if err != nil {
+		slog.Error("get approved league facets error", "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch leagues")
+		return
+	}
+
+	pagination.SetLinkHeader(w, r, nextCursor)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GetLeaguesResponse{Leagues: leagues, Count: count, Facets: facets, NextCursor: nextCursor})
+}
+
+// ExportApprovedLeagues streams every approved league matching the listing filters as CSV or NDJSON (public)
+// Takes the same filter parameters as GET /leagues plus ?format=csv|ndjson
+func (h *Handler) ExportApprovedLeagues(w http.ResponseWriter, r *http.Request) {
+	format, ok := ParseExportFormat(r.URL.Query().Get("format"))
+	if !ok {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "invalid format: must be csv or ndjson")
+		return
+	}
+
+	filter, err := parseLeagueFilter(r.URL.Query())
+	if err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filename := fmt.Sprintf("leagues-%s.%s", time.Now().Format("2006-01-02"), format)
+	streamExport(w, r, format, filename, func(emit func(ExportRow) error) error {
+		return h.service.ExportApprovedLeagues(r.Context(), filter, emit)
+	})
+}
+
+// ExportOrgLeagues streams an organization's leagues as CSV or NDJSON (organization members and admins)
+// Takes the listing filters, ?format=csv|ndjson and an optional ?status= moderation status
+func (h *Handler) ExportOrgLeagues(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	orgID := chi.URLParam(r, "orgId")
+	if orgID == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
+		return
+	}
+
+	format, ok := ParseExportFormat(r.URL.Query().Get("format"))
+	if !ok {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "invalid format: must be csv or ndjson")
+		return
+	}
+
+	filter, err := parseLeagueFilter(r.URL.Query())
+	if err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var status *LeagueStatus
+	if value := r.URL.Query().Get("status"); value != "" {
+		parsed := LeagueStatus(value)
+		if !parsed.IsValid() {
+			shared.WriteProblem(w, r, http.StatusBadRequest, "invalid status: must be pending, approved, rejected or changes_requested")
+			return
+		}
+		status = &parsed
+	}
+
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
+	filename := fmt.Sprintf("leagues-%s-%s.%s", orgID, time.Now().Format("2006-01-02"), format)
+	streamExport(w, r, format, filename, func(emit func(ExportRow) error) error {
+		return h.service.ExportOrgLeagues(r.Context(), userID, orgID, appRole, filter, status, emit)
+	})
+}
+
+// streamExport writes the rows produced by run as a downloadable file, flushing after every batch
+// The status line is only sent with the first row, so errors before it still get a proper error response;
+// errors after it can only cut the download short
+func streamExport(w http.ResponseWriter, r *http.Request, format ExportFormat, filename string, run func(emit func(ExportRow) error) error) {
+	controller := http.NewResponseController(w)
+	var encoder exportEncoder
+	start := func() error {
+		w.Header().Set("Content-Type", format.ContentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.WriteHeader(http.StatusOK)
+		var err error
+		encoder, err = newExportEncoder(w, format)
+		return err
+	}
+
+	rows := 0
+	err := run(func(row ExportRow) error {
+		if encoder == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		if err := encoder.Write(row); err != nil {
+			return err
+		}
+		rows++
+		if rows%exportBatchSize == 0 {
+			if err := encoder.Flush(); err != nil {
+				return err
+			}
+			controller.Flush()
+		}
+		return nil
+	})
+
+	if err != nil {
+		slog.Error("export leagues error", "rows", rows, "err", err)
+		if encoder == nil {
+			shared.WriteError(w, r, err, "Failed to export leagues")
+		}
+		return
+	}
+
+	if encoder == nil {
+		if err := start(); err != nil {
+			slog.Error("export leagues error", "err", err)
+			return
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		slog.Error("export leagues error", "rows", rows, "err", err)
+	}
+}
+
+// GetApprovedLeagueByID returns an approved league by ID (public)
+func (h *Handler) GetApprovedLeagueByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	league, err := h.service.GetLeagueByUUID(r.Context(), id)
+	if err != nil {
+		slog.Error("get approved league by id error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch league")
+		return
+	}
+
+	// Only return if league is approved
+	if league.Status != LeagueStatusApproved {
+		shared.WriteProblem(w, r, http.StatusNotFound, "League not found")
+		return
+	}
+	stripped := []League{*league}
+	stripPendingChanges(stripped)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(stripped[0])
+}
+
+// GetLeagueSeasons returns every approved season of the league's series (public)
+func (h *Handler) GetLeagueSeasons(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	seasons, err := h.service.GetLeagueSeasons(r.Context(), id)
+	if err != nil {
+		slog.Error("get league seasons error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch league")
+		return
+	}
+
+	if seasons == nil {
+		seasons = []League{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GetLeaguesResponse{Leagues: seasons})
+}
+
+// GetLeagueCalendar returns an approved league's games and registration deadline as an iCalendar feed (public)
+func (h *Handler) GetLeagueCalendar(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	calendar, err := h.service.GetLeagueCalendar(r.Context(), id)
+	if err != nil {
+		slog.Error("get league calendar error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch league")
+		return
+	}
+
+	writeCalendar(w, r, calendar, "league-"+id+".ics")
+}
+
+// GetOrgCalendar returns the games and registration deadlines of an organization's approved leagues as one iCalendar feed (public)
+func (h *Handler) GetOrgCalendar(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgId")
+	if orgID == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
+		return
+	}
+
+	calendar, err := h.service.GetOrgCalendar(r.Context(), orgID)
+	if err != nil {
+		slog.Error("get organization calendar error", "orgID", orgID, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch organization")
+		return
+	}
+
+	writeCalendar(w, r, calendar, "organization-"+orgID+".ics")
+}
+
+// writeCalendar encodes the calendar before writing anything, so an encoding failure can still return a 500
+func writeCalendar(w http.ResponseWriter, r *http.Request, calendar *ical.Calendar, filename string) {
+	var buf bytes.Buffer
+	if err := calendar.Encode(&buf); err != nil {
+		slog.Error("encode calendar error", "err", err)
+		shared.WriteProblem(w, r, http.StatusInternalServerError, "Failed to build calendar")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// GetLeagueByID returns a league by ID (admin only - any status)
+func (h *Handler) GetLeagueByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	league, err := h.service.GetLeagueByUUID(r.Context(), id)
+	if err != nil {
+		slog.Error("get league by id error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch league")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(league)
+}
+
+// CreateLeague creates a new league submission (authenticated users)
+func (h *Handler) CreateLeague(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Extract appRole from Authorization header (JWT token)
+	appRole := h.authService.GetAppRoleFromRequest(r)
+	slog.Info("CreateLeague request", "userID", userID, "appRole", appRole)
+
+	// Extract org_id from query parameter
+	orgID := r.URL.Query().Get("org_id")
+	if orgID == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
+		return
+	}
+
+	var req CreateLeagueRequest
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("create league error", "err", err)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validation runs in the service so drafts and imports get the same checks
+	league, err := h.service.CreateLeague(r.Context(), userID, orgID, appRole, &req)
+	if err != nil {
+		slog.Error("create league error", "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to create league")
+		return
+	}
+
+	// Venue conflicts are advisory; failing to check them doesn't fail the submission
+	warnings, err := h.service.FindVenueConflicts(r.Context(), league)
+	if err != nil {
+		slog.Warn("check venue conflicts error", "leagueID", league.ID, "err", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(CreateLeagueResponse{League: *league, Warnings: warnings})
+}
+
+// CloneLeague copies a league into a new pending season (organization members and admins)
+func (h *Handler) CloneLeague(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	var req CloneLeagueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("clone league error", "err", err)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		slog.Error("clone league error", "err", err)
+		shared.WriteError(w, r, err, "Validation failed")
+		return
+	}
+
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
+	league, err := h.service.CloneLeague(r.Context(), userID, id, appRole, &req)
+	if err != nil {
+		slog.Error("clone league error", "id", id, "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to clone league")
+		return
+	}
+
+	// Venue conflicts are advisory; failing to check them doesn't fail the submission
+	warnings, err := h.service.FindVenueConflicts(r.Context(), league)
+	if err != nil {
+		slog.Warn("check venue conflicts error", "leagueID", league.ID, "err", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(CreateLeagueResponse{League: *league, Warnings: warnings})
+}
+
+// GetLeagueSchedule returns the dated games of an approved league (public)
+func (h *Handler) GetLeagueSchedule(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	response, err := h.service.GetLeagueSchedule(r.Context(), id)
+	if err != nil {
+		slog.Error("get league schedule error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch league")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// AddScheduleException blacks out a date or cancels or reschedules a game (authenticated users)
+func (h *Handler) AddScheduleException(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	var req CreateScheduleExceptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("add schedule exception error", "err", err)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		slog.Error("add schedule exception error", "err", err)
+		shared.WriteError(w, r, err, "Validation failed")
+		return
+	}
+
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
+	response, err := h.service.AddScheduleException(r.Context(), userID, id, appRole, &req)
+	if err != nil {
+		slog.Error("add schedule exception error", "id", id, "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to add schedule exception")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// RemoveScheduleException undoes a blackout or game change (authenticated users)
+func (h *Handler) RemoveScheduleException(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	exceptionID, err := strconv.ParseInt(chi.URLParam(r, "exceptionId"), 10, 64)
+	if id == "" || err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid league or exception ID")
+		return
+	}
+
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
+	if err := h.service.RemoveScheduleException(r.Context(), userID, id, appRole, exceptionID); err != nil {
+		slog.Error("remove schedule exception error", "id", id, "exceptionID", exceptionID, "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to remove schedule exception")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"status": "deleted"})
+}
+
+// ImportLeagues creates leagues from a CSV or XLSX spreadsheet and returns a per-row report (authenticated users)
+// The file is either the request body (format from Content-Type) or the "file" field of a multipart form
+// (format from the file extension); ?format= overrides both. ?dry_run=true validates without inserting
+func (h *Handler) ImportLeagues(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	orgID := r.URL.Query().Get("org_id")
+	if orgID == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
+		return
+	}
+
+	dryRun := false
+	if value := r.URL.Query().Get("dry_run"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			shared.WriteProblem(w, r, http.StatusBadRequest, "dry_run must be true or false")
+			return
+		}
+		dryRun = parsed
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportBytes)
+	formatHint := r.Header.Get("Content-Type")
+	var data []byte
+	if strings.HasPrefix(formatHint, "multipart/form-data") {
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			shared.WriteProblem(w, r, http.StatusBadRequest, "file is required")
+			return
+		}
+		defer file.Close()
+		formatHint = filepath.Ext(header.Filename)
+		data, err = io.ReadAll(file)
+		if err != nil {
+			shared.WriteProblem(w, r, http.StatusBadRequest, "Failed to read file")
+			return
+		}
+	} else {
+		var err error
+		data, err = io.ReadAll(r.Body)
+		if err != nil {
+			shared.WriteProblem(w, r, http.StatusBadRequest, "Failed to read file (max 10MB)")
+			return
+		}
+	}
+	if value := r.URL.Query().Get("format"); value != "" {
+		formatHint = value
+	}
+	format, ok := ParseImportFormat(formatHint)
+	if !ok {
+		shared.WriteProblem(w, r, http.StatusUnsupportedMediaType, "Unsupported file format: use CSV or XLSX")
+		return
+	}
+
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
+	report, err := h.service.ImportLeagues(r.Context(), userID, orgID, appRole, data, format, dryRun)
+	if err != nil {
+		slog.Error("import leagues error", "orgID", orgID, "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to import leagues")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(report)
+}
+
+// UpdateLeague edits an existing league (organization members and admins)
+// Pending/rejected leagues go back to review; edits of approved leagues may need re-approval
+func (h *Handler) UpdateLeague(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
+	var req CreateLeagueRequest
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("update league error", "err", err)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate request
+	err = shared.ValidateStruct(h.validator, req)
+	if err != nil {
+		slog.Error("update league error", "err", err)
+		shared.WriteError(w, r, err, "Validation failed")
+		return
+	}
+
+	result, err := h.service.UpdateLeague(r.Context(), userID, id, appRole, &req)
+	if err != nil {
+		slog.Error("update league error", "id", id, "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to update league")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
+
+// CancelLeague marks a league as cancelled (organization members and admins)
+func (h *Handler) CancelLeague(w http.ResponseWriter, r *http.Request) {
+	var req CancelLeagueRequest
+
+	// The body is optional; an empty body cancels without a reason
+	if r.ContentLength != 0 {
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			slog.Error("cancel league error", "err", err)
+			shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	// Validate request
+	err := shared.ValidateStruct(h.validator, req)
+	if err != nil {
+		slog.Error("cancel league error", "err", err)
+		shared.WriteError(w, r, err, "Validation failed")
+		return
+	}
+
+	var reason *string
+	if req.Reason != "" {
+		reason = &req.Reason
+	}
+	h.setLeagueLifecycle(w, r, LifecycleCancelled, reason)
+}
+
+// MarkLeagueFull marks a league as full (organization members and admins)
+func (h *Handler) MarkLeagueFull(w http.ResponseWriter, r *http.Request) {
+	h.setLeagueLifecycle(w, r, LifecycleFull, nil)
+}
+
+// ReopenLeague reopens registration for a full or closed league (organization members and admins)
+func (h *Handler) ReopenLeague(w http.ResponseWriter, r *http.Request) {
+	h.setLeagueLifecycle(w, r, LifecycleRegistrationOpen, nil)
+}
+
+// setLeagueLifecycle applies a lifecycle transition for the league in the URL and writes the updated league
+func (h *Handler) setLeagueLifecycle(w http.ResponseWriter, r *http.Request, to LifecycleStatus, reason *string) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
+	league, err := h.service.SetLeagueLifecycle(r.Context(), userID, id, appRole, to, reason)
+	if err != nil {
+		slog.Error("set league lifecycle error", "id", id, "to", to, "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to set league lifecycle")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(league)
+}
+
+// GetLeaguesByOrgID returns leagues for a specific organization
+func (h *Handler) GetLeaguesByOrgID(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
+	orgID := chi.URLParam(r, "orgId")
+	if orgID == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
+		return
+	}
+
+	leagues, err := h.service.GetLeaguesByOrgID(r.Context(), userID, orgID, appRole)
+	if err != nil {
+		slog.Error("get leagues by org id error", "orgID", orgID, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch leagues")
+		return
+	}
+
+	if leagues == nil {
+		leagues = []League{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GetLeaguesResponse{Leagues: leagues})
+}
+
+// GetAllLeagues returns all leagues regardless of status (admin only)
+func (h *Handler) GetAllLeagues(w http.ResponseWriter, r *http.Request) {
+	page, err := pagination.Parse(r.URL.Query(), allLeaguesPaging)
+	if err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	leagues, total, nextCursor, err := h.service.GetAllLeaguesWithPagination(r.Context(), page)
+	if err != nil {
+		slog.Error("get all leagues error", "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch leagues")
+		return
+	}
+
+	if leagues == nil {
+		leagues = []League{}
+	}
+
+	pagination.SetLinkHeader(w, r, nextCursor)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"leagues":     leagues,
+		"total":       total,
+		"limit":       page.Limit,
+		"offset":      page.Offset,
+		"sort":        page.SortString(),
+		"next_cursor": nextCursor,
+	})
+}
+
+// GetPendingLeagues returns the league submissions awaiting review (admin only)
+// assignee (an admin's user ID, "me" or "none") and older_than (e.g. 48h) narrow the queue
+func (h *Handler) GetPendingLeagues(w http.ResponseWriter, r *http.Request) {
+	page, err := pagination.Parse(r.URL.Query(), pendingLeaguesPaging)
+	if err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter, err := parseReviewQueueFilter(r.URL.Query(), r.Header.Get("X-Clerk-User-ID"), time.Now())
+	if err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	leagues, total, nextCursor, err := h.service.GetPendingLeaguesWithPagination(r.Context(), filter, page)
+	if err != nil {
+		slog.Error("get pending leagues error", "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch pending leagues")
+		return
+	}
+
+	if leagues == nil {
+		leagues = []League{}
+	}
+
+	pagination.SetLinkHeader(w, r, nextCursor)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"leagues":     leagues,
+		"total":       total,
+		"limit":       page.Limit,
+		"offset":      page.Offset,
+		"sort":        page.SortString(),
+		"next_cursor": nextCursor,
+	})
+}
+
+// ClaimLeague locks a league awaiting review to the calling admin for a limited time (admin only)
+func (h *Handler) ClaimLeague(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	claim, err := h.service.ClaimLeague(r.Context(), userID, id)
+	if err != nil {
+		slog.Error("claim league error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to claim league")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(claim)
+}
+
+// ReleaseLeagueClaim gives up the calling admin's claim on a league (admin only)
+func (h *Handler) ReleaseLeagueClaim(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	if err := h.service.ReleaseLeagueClaim(r.Context(), userID, id); err != nil {
+		slog.Error("release league claim error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to release league")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"status": "released"})
+}
+
+// GetReviewMetrics reports review turnaround over the last days (default 30) and the backlog by age (admin only)
+func (h *Handler) GetReviewMetrics(w http.ResponseWriter, r *http.Request) {
+	days, err := parseMetricsDays(r.URL.Query())
+	if err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	metrics, err := h.service.GetReviewMetrics(r.Context(), days)
+	if err != nil {
+		slog.Error("get review metrics error", "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch review metrics")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(metrics)
+}
+
+// ApproveLeague approves a pending league submission (admin only)
+// The body is optional: a partial patch of league fields and sport_id/venue_id overrides applied before approval
+func (h *Handler) ApproveLeague(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	var req ApproveLeagueRequest
+	if r.ContentLength != 0 {
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			slog.Error("approve league error", "err", err)
+			shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	// Validate request
+	err := shared.ValidateStruct(h.validator, req)
+	if err != nil {
+		slog.Error("approve league error", "err", err)
+		shared.WriteError(w, r, err, "Validation failed")
+		return
+	}
+
+	changed, err := h.service.ApproveLeagueWithChanges(r.Context(), userID, id, &req)
+	if err != nil {
+		slog.Error("approve league error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to approve league")
+		return
+	}
+
+	warnings, err := h.service.GetLeagueVenueConflicts(r.Context(), id)
+	if err != nil {
+		slog.Warn("check venue conflicts error", "id", id, "err", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(ApproveLeagueResponse{Status: "approved", ChangedFields: changed, Warnings: warnings})
+}
+
+// GetLeagueVenueConflicts lists approved leagues booked at the same venue and time as a league (admin only)
+// Lets admins see overlaps before approving
+func (h *Handler) GetLeagueVenueConflicts(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	conflicts, err := h.service.GetLeagueVenueConflicts(r.Context(), id)
+	if err != nil {
+		slog.Error("get venue conflicts error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch league")
+		return
+	}
+	if conflicts == nil {
+		conflicts = []VenueConflict{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GetVenueConflictsResponse{Conflicts: conflicts})
+}
+
+// GetLeagueDuplicates checks a league against the current leagues for likely duplicates (admin only)
+func (h *Handler) GetLeagueDuplicates(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	duplicates, err := h.service.GetLeagueDuplicates(r.Context(), id)
+	if err != nil {
+		slog.Error("get league duplicates error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch league")
+		return
+	}
+	if duplicates == nil {
+		duplicates = []DuplicateCandidate{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GetDuplicatesResponse{Duplicates: duplicates})
+}
+
+// MarkLeagueDuplicate rejects a pending league as a duplicate of another league (admin only)
+func (h *Handler) MarkLeagueDuplicate(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	var req MarkDuplicateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("mark league duplicate error", "err", err)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		slog.Error("mark league duplicate error", "err", err)
+		shared.WriteError(w, r, err, "Validation failed")
+		return
+	}
+
+	if err := h.service.MarkLeagueDuplicate(r.Context(), userID, id, req.DuplicateOfID); err != nil {
+		slog.Error("mark league duplicate error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to mark league as duplicate")
+		return
+	}
 
-	limit := 10 // default limit
-	offset := 0 // default offset
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"status": "rejected", "duplicate_of_id": req.DuplicateOfID})
+}
 
-	if limitStr != "" {
-		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
-			limit = parsedLimit
-		}
+// RejectLeague rejects a pending league submission (admin only)
+func (h *Handler) RejectLeague(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
 	}
 
-	if offsetStr != "" {
-		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
-			offset = parsedOffset
-		}
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
 	}
 
-	// Always use paginated version to include count
-	var leagues []League
-	var count int64
-	var err error
+	var req RejectLeagueRequest
 
-	leagues, count, err = h.service.GetApprovedLeaguesWithPagination(r.Context(), limit, offset)
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("reject league error", "err", err)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
 
+	// Validate request
+	err = shared.ValidateStruct(h.validator, req)
 	if err != nil {
-		slog.Error("get approved leagues error", "err", err)
-		http.Error(w, "Failed to fetch leagues", http.StatusInternalServerError)
+		slog.Error("reject league error", "err", err)
+		shared.WriteError(w, r, err, "Validation failed")
 		return
 	}
 
-	if leagues == nil {
-		leagues = []League{}
+	err = h.service.RejectLeagueByUUID(r.Context(), userID, id, req.RejectionReason)
+	if err != nil {
+		slog.Error("reject league error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to reject league")
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(GetLeaguesResponse{Leagues: leagues, Count: count})
+	json.NewEncoder(w).Encode(map[string]string{"status": "rejected"})
 }
 
-// GetApprovedLeagueByID returns an approved league by ID (public)
-func (h *Handler) GetApprovedLeagueByID(w http.ResponseWriter, r *http.Request) {
-	id := chi.URLParam(r, "id")
-	if id == "" {
-		http.Error(w, "league ID is required", http.StatusBadRequest)
+// BulkReviewLeagues approves or rejects a list of leagues and returns a per-league report (admin only)
+// A league that can't be decided is reported as failed without stopping the others
+func (h *Handler) BulkReviewLeagues(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
 		return
 	}
 
-	league, err := h.service.GetLeagueByUUID(r.Context(), id)
-	if err != nil {
-		slog.Error("get approved league by id error", "id", id, "err", err)
-		http.Error(w, "League not found", http.StatusNotFound)
+	var req BulkReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("bulk review leagues error", "err", err)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
-	// Only return if league is approved
-	if league.Status != LeagueStatusApproved {
-		http.Error(w, "League not found", http.StatusNotFound)
+	// Validate request
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		slog.Error("bulk review leagues error", "err", err)
+		shared.WriteError(w, r, err, "Validation failed")
+		return
+	}
+
+	report, err := h.service.BulkReviewLeagues(r.Context(), userID, &req)
+	if err != nil {
+		slog.Error("bulk review leagues error", "action", req.Action, "err", err)
+		shared.WriteError(w, r, err, "Failed to review leagues")
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(league)
+	json.NewEncoder(w).Encode(report)
 }
 
-// GetLeagueByID returns a league by ID (admin only - any status)
-func (h *Handler) GetLeagueByID(w http.ResponseWriter, r *http.Request) {
-	id := chi.URLParam(r, "id")
-	if id == "" {
-		http.Error(w, "league ID is required", http.StatusBadRequest)
+// GetPolicyRules lists the auto-approval policy rules in the order they're tried (admin only)
+func (h *Handler) GetPolicyRules(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
 		return
 	}
 
-	league, err := h.service.GetLeagueByUUID(r.Context(), id)
+	rules, err := h.service.GetPolicyRules(r.Context(), userID)
 	if err != nil {
-		slog.Error("get league by id error", "id", id, "err", err)
-		http.Error(w, "League not found", http.StatusNotFound)
+		slog.Error("get policy rules error", "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch policy rules")
 		return
 	}
+	if rules == nil {
+		rules = []PolicyRule{}
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(league)
+	json.NewEncoder(w).Encode(rules)
 }
 
-// CreateLeague creates a new league submission (authenticated users)
-func (h *Handler) CreateLeague(w http.ResponseWriter, r *http.Request) {
+// CreatePolicyRule adds a rule to the auto-approval policy (admin only)
+func (h *Handler) CreatePolicyRule(w http.ResponseWriter, r *http.Request) {
 	userID := r.Header.Get("X-Clerk-User-ID")
 	if userID == "" {
-		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
 		return
 	}
 
-	// Extract appRole from Authorization header (JWT token)
-	appRole := h.authService.GetAppRoleFromRequest(r)
-	slog.Info("CreateLeague request", "userID", userID, "appRole", appRole)
-
-	// Extract org_id from query parameter
-	orgID := r.URL.Query().Get("org_id")
-	if orgID == "" {
-		http.Error(w, "organization ID is required", http.StatusBadRequest)
+	var req PolicyRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("create policy rule error", "err", err)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
-	var req CreateLeagueRequest
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		slog.Error("create policy rule error", "err", err)
+		shared.WriteError(w, r, err, "Validation failed")
+		return
+	}
 
-	err := json.NewDecoder(r.Body).Decode(&req)
+	rule, err := h.service.CreatePolicyRule(r.Context(), userID, &req)
 	if err != nil {
-		slog.Error("create league error", "err", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		slog.Error("create policy rule error", "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to create policy rule")
 		return
 	}
 
-	// Validate request
-	err = h.validator.Struct(req)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(rule)
+}
+
+// UpdatePolicyRule replaces the settings of an auto-approval policy rule (admin only)
+func (h *Handler) UpdatePolicyRule(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+
+	ruleID, err := strconv.ParseInt(chi.URLParam(r, "ruleId"), 10, 64)
 	if err != nil {
-		validationErrors := err.(validator.ValidationErrors)
-		slog.Error("create league error", "err", err)
-		http.Error(w, "Validation failed: "+validationErrors.Error(), http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid policy rule ID")
 		return
 	}
 
-	league, err := h.service.CreateLeague(r.Context(), userID, orgID, appRole, &req)
+	var req PolicyRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("update policy rule error", "err", err)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		slog.Error("update policy rule error", "err", err)
+		shared.WriteError(w, r, err, "Validation failed")
+		return
+	}
+
+	rule, err := h.service.UpdatePolicyRule(r.Context(), userID, ruleID, &req)
 	if err != nil {
-		slog.Error("create league error", "userID", userID, "err", err)
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusInternalServerError)
-		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		slog.Error("update policy rule error", "ruleID", ruleID, "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to update policy rule")
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(CreateLeagueResponse{League: *league})
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(rule)
 }
 
-// GetLeaguesByOrgID returns leagues for a specific organization
-func (h *Handler) GetLeaguesByOrgID(w http.ResponseWriter, r *http.Request) {
-	orgID := chi.URLParam(r, "orgId")
-	if orgID == "" {
-		http.Error(w, "organization ID is required", http.StatusBadRequest)
+// DeletePolicyRule removes a rule from the auto-approval policy (admin only)
+func (h *Handler) DeletePolicyRule(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
 		return
 	}
 
-	leagues, err := h.service.GetLeaguesByOrgID(r.Context(), orgID)
+	ruleID, err := strconv.ParseInt(chi.URLParam(r, "ruleId"), 10, 64)
 	if err != nil {
-		slog.Error("get leagues by org id error", "orgID", orgID, "err", err)
-		http.Error(w, "Failed to fetch leagues", http.StatusInternalServerError)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid policy rule ID")
 		return
 	}
 
-	if leagues == nil {
-		leagues = []League{}
+	if err := h.service.DeletePolicyRule(r.Context(), userID, ruleID); err != nil {
+		slog.Error("delete policy rule error", "ruleID", ruleID, "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to delete policy rule")
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(GetLeaguesResponse{Leagues: leagues})
+	json.NewEncoder(w).Encode(map[string]string{"status": "deleted"})
 }
 
-// GetAllLeagues returns all leagues regardless of status (admin only)
-func (h *Handler) GetAllLeagues(w http.ResponseWriter, r *http.Request) {
-	// Parse pagination params from query string
-	limit := 20 // default limit
-	offset := 0 // default offset
+// RequestLeagueChanges sends a pending league back to its organizer with a message (admin only)
+func (h *Handler) RequestLeagueChanges(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
 
-	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
-		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
-			limit = l
-		}
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
 	}
 
-	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
-		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
-			offset = o
-		}
+	var req RequestChangesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("request league changes error", "err", err)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+		return
 	}
 
-	leagues, total, err := h.service.GetAllLeaguesWithPagination(r.Context(), limit, offset)
-	if err != nil {
-		slog.Error("get all leagues error", "err", err)
-		http.Error(w, "Failed to fetch leagues", http.StatusInternalServerError)
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		slog.Error("request league changes error", "err", err)
+		shared.WriteError(w, r, err, "Validation failed")
 		return
 	}
 
-	if leagues == nil {
-		leagues = []League{}
+	comment, err := h.service.RequestLeagueChanges(r.Context(), userID, id, &req)
+	if err != nil {
+		slog.Error("request league changes error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to request changes")
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"leagues": leagues,
-		"total":   total,
-		"limit":   limit,
-		"offset":  offset,
-	})
+	json.NewEncoder(w).Encode(comment)
 }
 
-// GetPendingLeagues returns all pending league submissions (admin only)
-func (h *Handler) GetPendingLeagues(w http.ResponseWriter, r *http.Request) {
-	// Parse pagination params from query string
-	limit := 20 // default limit
-	offset := 0 // default offset
-
-	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
-		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
-			limit = l
-		}
+// GetLeagueComments returns a league's review thread, oldest first (organization members and admins)
+func (h *Handler) GetLeagueComments(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
 	}
 
-	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
-		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
-			offset = o
-		}
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
 	}
 
-	leagues, total, err := h.service.GetPendingLeaguesWithPagination(r.Context(), limit, offset)
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
+	comments, err := h.service.GetLeagueComments(r.Context(), userID, id, appRole)
 	if err != nil {
-		slog.Error("get pending leagues error", "err", err)
-		http.Error(w, "Failed to fetch pending leagues", http.StatusInternalServerError)
+		slog.Error("get league comments error", "id", id, "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch league comments")
 		return
 	}
-
-	if leagues == nil {
-		leagues = []League{}
+	if comments == nil {
+		comments = []LeagueComment{}
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"leagues": leagues,
-		"total":   total,
-		"limit":   limit,
-		"offset":  offset,
-	})
+	json.NewEncoder(w).Encode(GetLeagueCommentsResponse{LeagueID: id, Comments: comments})
 }
 
-// ApproveLeague approves a pending league submission (admin only)
-func (h *Handler) ApproveLeague(w http.ResponseWriter, r *http.Request) {
+// AddLeagueComment adds a message to a league's review thread (organization members and admins)
+func (h *Handler) AddLeagueComment(w http.ResponseWriter, r *http.Request) {
 	userID := r.Header.Get("X-Clerk-User-ID")
 	if userID == "" {
-		http.Error(w, "Missing user ID", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
 		return
 	}
 
 	id := chi.URLParam(r, "id")
 	if id == "" {
-		http.Error(w, "league ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
 		return
 	}
 
-	err := h.service.ApproveLeagueByUUID(r.Context(), userID, id)
+	var req CreateLeagueCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("add league comment error", "err", err)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		slog.Error("add league comment error", "err", err)
+		shared.WriteError(w, r, err, "Validation failed")
+		return
+	}
+
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
+	comment, err := h.service.AddLeagueComment(r.Context(), userID, id, appRole, &req)
 	if err != nil {
-		slog.Error("approve league error", "id", id, "err", err)
-		http.Error(w, "Failed to approve league", http.StatusInternalServerError)
+		slog.Error("add league comment error", "id", id, "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to add comment")
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{"status": "approved"})
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(comment)
 }
 
-// RejectLeague rejects a pending league submission (admin only)
-func (h *Handler) RejectLeague(w http.ResponseWriter, r *http.Request) {
-	userID := r.Header.Get("X-Clerk-User-ID")
-	if userID == "" {
-		http.Error(w, "Missing user ID", http.StatusUnauthorized)
+// GetLeagueHistory returns every revision of a league, oldest first (admin only)
+func (h *Handler) GetLeagueHistory(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
+		return
+	}
+
+	revisions, err := h.service.GetLeagueHistory(r.Context(), id)
+	if err != nil {
+		slog.Error("get league history error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch league history")
 		return
 	}
 
+	if revisions == nil {
+		revisions = []LeagueRevision{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GetLeagueHistoryResponse{LeagueID: id, Revisions: revisions})
+}
+
+// GetLeagueRevisionDiff compares two revisions of a league field by field (admin only)
+// ?from= and ?to= are revision numbers; by default the latest revision is compared with the one before it
+func (h *Handler) GetLeagueRevisionDiff(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 	if id == "" {
-		http.Error(w, "league ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "league ID is required")
 		return
 	}
 
-	var req RejectLeagueRequest
-
-	err := json.NewDecoder(r.Body).Decode(&req)
+	from, err := parseRevisionNumber(r.URL.Query().Get("from"))
 	if err != nil {
-		slog.Error("reject league error", "err", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	// Validate request
-	err = h.validator.Struct(req)
+	to, err := parseRevisionNumber(r.URL.Query().Get("to"))
 	if err != nil {
-		validationErrors := err.(validator.ValidationErrors)
-		slog.Error("reject league error", "err", err)
-		http.Error(w, "Validation failed: "+validationErrors.Error(), http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	err = h.service.RejectLeagueByUUID(r.Context(), userID, id, req.RejectionReason)
+	diff, err := h.service.DiffLeagueRevisions(r.Context(), id, from, to)
 	if err != nil {
-		slog.Error("reject league error", "id", id, "err", err)
-		http.Error(w, "Failed to reject league", http.StatusInternalServerError)
+		slog.Error("diff league revisions error", "id", id, "from", from, "to", to, "err", err)
+		shared.WriteError(w, r, err, "Failed to compare revisions")
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{"status": "rejected"})
+	json.NewEncoder(w).Encode(diff)
+}
+
+// parseRevisionNumber parses an optional revision number query parameter (0 if empty)
+func parseRevisionNumber(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return 0, fmt.Errorf("invalid revision number: %s", value)
+	}
+	return number, nil
 }
 
 // ============= DRAFT HANDLERS =============
 
 // GetDraft returns the draft for an organization
 func (h *Handler) GetDraft(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
 	orgID := chi.URLParam(r, "orgId")
 	if orgID == "" {
-		http.Error(w, "organization ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
 		return
 	}
 
-	draft, err := h.service.GetDraft(r.Context(), orgID)
+	draft, err := h.service.GetDraft(r.Context(), userID, orgID, appRole)
 	if err != nil {
 		slog.Error("get draft error", "orgID", orgID, "err", err)
-		http.Error(w, "Failed to fetch draft", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to fetch draft")
 		return
 	}
 
@@ -403,14 +1481,15 @@ func (h *Handler) GetDraft(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) SaveDraft(w http.ResponseWriter, r *http.Request) {
 	userID := r.Header.Get("X-Clerk-User-ID")
 	if userID == "" {
-		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
 		return
 	}
+	appRole := h.authService.GetAppRoleFromRequest(r)
 
 	// Get org_id from query parameter (UUID string)
 	orgID := r.URL.Query().Get("org_id")
 	if orgID == "" {
-		http.Error(w, "organization ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
 		return
 	}
 
@@ -418,16 +1497,15 @@ func (h *Handler) SaveDraft(w http.ResponseWriter, r *http.Request) {
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		slog.Error("save draft error", "err", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	// Validate request
-	err = h.validator.Struct(req)
+	err = shared.ValidateStruct(h.validator, req)
 	if err != nil {
-		validationErrors := err.(validator.ValidationErrors)
 		slog.Error("save draft error", "err", err)
-		http.Error(w, "Validation failed: "+validationErrors.Error(), http.StatusBadRequest)
+		shared.WriteError(w, r, err, "Validation failed")
 		return
 	}
 
@@ -436,15 +1514,15 @@ func (h *Handler) SaveDraft(w http.ResponseWriter, r *http.Request) {
 	// Check if updating existing draft or creating new one
 	if req.DraftID != nil && *req.DraftID > 0 {
 		// Update existing draft
-		draft, err = h.service.UpdateDraft(r.Context(), *req.DraftID, orgID, req.FormData)
+		draft, err = h.service.UpdateDraft(r.Context(), userID, *req.DraftID, orgID, appRole, req.FormData)
 	} else {
 		// Create new draft
-		draft, err = h.service.SaveDraft(r.Context(), orgID, userID, req.Name, req.FormData)
+		draft, err = h.service.SaveDraft(r.Context(), orgID, userID, appRole, req.Name, req.FormData)
 	}
 
 	if err != nil {
 		slog.Error("save draft error", "orgID", orgID, "userID", userID, "err", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to save draft")
 		return
 	}
 
@@ -455,9 +1533,16 @@ func (h *Handler) SaveDraft(w http.ResponseWriter, r *http.Request) {
 
 // DeleteDraft deletes a draft for an organization
 func (h *Handler) DeleteDraft(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
 	orgID := chi.URLParam(r, "orgId")
 	if orgID == "" {
-		http.Error(w, "organization ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
 		return
 	}
 
@@ -466,19 +1551,19 @@ func (h *Handler) DeleteDraft(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	if req.DraftID == 0 {
-		http.Error(w, "draft_id is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "draft_id is required")
 		return
 	}
 
-	err := h.service.DeleteDraftByID(r.Context(), req.DraftID, orgID)
+	err := h.service.DeleteDraftByID(r.Context(), userID, req.DraftID, orgID, appRole)
 	if err != nil {
 		slog.Error("delete draft error", "draftID", req.DraftID, "orgID", orgID, "err", err)
-		http.Error(w, "Failed to delete draft", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to delete draft")
 		return
 	}
 
@@ -487,12 +1572,44 @@ func (h *Handler) DeleteDraft(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(map[string]string{"status": "deleted"})
 }
 
+// SubmitDraft submits a saved draft as a league for review and deletes the draft
+func (h *Handler) SubmitDraft(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
+	draftID, err := strconv.Atoi(chi.URLParam(r, "draftId"))
+	if err != nil || draftID <= 0 {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid draft ID")
+		return
+	}
+
+	league, err := h.service.SubmitDraft(r.Context(), userID, draftID, appRole)
+	if err != nil {
+		slog.Error("submit draft error", "draftID", draftID, "userID", userID, "err", err)
+		shared.WriteError(w, r, err, "Failed to submit draft")
+		return
+	}
+
+	warnings, err := h.service.FindVenueConflicts(r.Context(), league)
+	if err != nil {
+		slog.Warn("check venue conflicts error", "leagueID", league.ID, "err", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(CreateLeagueResponse{League: *league, Warnings: warnings})
+}
+
 // GetAllDrafts returns all league drafts across all organizations (admin only)
 func (h *Handler) GetAllDrafts(w http.ResponseWriter, r *http.Request) {
 	drafts, err := h.service.GetAllDrafts(r.Context())
 	if err != nil {
 		slog.Error("get all drafts error", "err", err)
-		http.Error(w, "Failed to fetch drafts", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to fetch drafts")
 		return
 	}
 
@@ -509,13 +1626,14 @@ func (h *Handler) GetAllDrafts(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) SaveTemplate(w http.ResponseWriter, r *http.Request) {
 	userID := r.Header.Get("X-Clerk-User-ID")
 	if userID == "" {
-		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
 		return
 	}
+	appRole := h.authService.GetAppRoleFromRequest(r)
 
 	orgID := r.URL.Query().Get("org_id")
 	if orgID == "" {
-		http.Error(w, "organization ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
 		return
 	}
 
@@ -523,23 +1641,22 @@ func (h *Handler) SaveTemplate(w http.ResponseWriter, r *http.Request) {
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		slog.Error("save template error", "err", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	// Validate request
-	err = h.validator.Struct(req)
+	err = shared.ValidateStruct(h.validator, req)
 	if err != nil {
-		validationErrors := err.(validator.ValidationErrors)
 		slog.Error("save template error", "err", err)
-		http.Error(w, "Validation failed: "+validationErrors.Error(), http.StatusBadRequest)
+		shared.WriteError(w, r, err, "Validation failed")
 		return
 	}
 
-	template, err := h.service.SaveTemplate(r.Context(), orgID, userID, req.Name, req.FormData)
+	template, err := h.service.SaveTemplate(r.Context(), orgID, userID, appRole, req.Name, req.FormData)
 	if err != nil {
 		slog.Error("save template error", "orgID", orgID, "userID", userID, "err", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to save template")
 		return
 	}
 
@@ -550,16 +1667,23 @@ func (h *Handler) SaveTemplate(w http.ResponseWriter, r *http.Request) {
 
 // GetDraftsByOrgID returns all drafts for an organization
 func (h *Handler) GetDraftsByOrgID(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
 	orgID := chi.URLParam(r, "orgId")
 	if orgID == "" {
-		http.Error(w, "organization ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
 		return
 	}
 
-	drafts, err := h.service.GetDraftsByOrgID(r.Context(), orgID)
+	drafts, err := h.service.GetDraftsByOrgID(r.Context(), userID, orgID, appRole)
 	if err != nil {
 		slog.Error("get drafts by org id error", "orgID", orgID, "err", err)
-		http.Error(w, "Failed to fetch drafts", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to fetch drafts")
 		return
 	}
 
@@ -574,16 +1698,23 @@ func (h *Handler) GetDraftsByOrgID(w http.ResponseWriter, r *http.Request) {
 
 // GetTemplatesByOrgID returns all templates for an organization
 func (h *Handler) GetTemplatesByOrgID(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
 	orgID := chi.URLParam(r, "orgId")
 	if orgID == "" {
-		http.Error(w, "organization ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
 		return
 	}
 
-	templates, err := h.service.GetTemplatesByOrgID(r.Context(), orgID)
+	templates, err := h.service.GetTemplatesByOrgID(r.Context(), userID, orgID, appRole)
 	if err != nil {
 		slog.Error("get templates by org id error", "orgID", orgID, "err", err)
-		http.Error(w, "Failed to fetch templates", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to fetch templates")
 		return
 	}
 
@@ -601,7 +1732,7 @@ func (h *Handler) GetAllTemplates(w http.ResponseWriter, r *http.Request) {
 	templates, err := h.service.GetAllTemplates(r.Context())
 	if err != nil {
 		slog.Error("get all templates error", "err", err)
-		http.Error(w, "Failed to fetch templates", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to fetch templates")
 		return
 	}
 
@@ -616,21 +1747,28 @@ func (h *Handler) GetAllTemplates(w http.ResponseWriter, r *http.Request) {
 
 // UpdateTemplate updates an existing template
 func (h *Handler) UpdateTemplate(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
 	orgID := r.URL.Query().Get("org_id")
 	if orgID == "" {
-		http.Error(w, "organization ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
 		return
 	}
 
 	templateIDStr := chi.URLParam(r, "templateId")
 	if templateIDStr == "" {
-		http.Error(w, "template ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "template ID is required")
 		return
 	}
 
 	templateID, err := strconv.Atoi(templateIDStr)
 	if err != nil {
-		http.Error(w, "Invalid template ID", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid template ID")
 		return
 	}
 
@@ -638,27 +1776,22 @@ func (h *Handler) UpdateTemplate(w http.ResponseWriter, r *http.Request) {
 	err = json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		slog.Error("update template error", "err", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	// Validate request
-	err = h.validator.Struct(req)
+	err = shared.ValidateStruct(h.validator, req)
 	if err != nil {
-		validationErrors := err.(validator.ValidationErrors)
 		slog.Error("update template error", "err", err)
-		http.Error(w, "Validation failed: "+validationErrors.Error(), http.StatusBadRequest)
+		shared.WriteError(w, r, err, "Validation failed")
 		return
 	}
 
-	template, err := h.service.UpdateTemplate(r.Context(), templateID, orgID, req.Name, req.FormData)
+	template, err := h.service.UpdateTemplate(r.Context(), userID, templateID, orgID, appRole, req.Name, req.FormData)
 	if err != nil {
 		slog.Error("update template error", "templateID", templateID, "orgID", orgID, "err", err)
-		if err.Error() == "template not found or access denied" {
-			http.Error(w, err.Error(), http.StatusForbidden)
-			return
-		}
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to update template")
 		return
 	}
 
@@ -669,32 +1802,35 @@ func (h *Handler) UpdateTemplate(w http.ResponseWriter, r *http.Request) {
 
 // DeleteTemplate deletes a template
 func (h *Handler) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	appRole := h.authService.GetAppRoleFromRequest(r)
+
 	orgID := r.URL.Query().Get("org_id")
 	if orgID == "" {
-		http.Error(w, "organization ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "organization ID is required")
 		return
 	}
 
 	templateIDStr := chi.URLParam(r, "templateId")
 	if templateIDStr == "" {
-		http.Error(w, "template ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "template ID is required")
 		return
 	}
 
 	templateID, err := strconv.Atoi(templateIDStr)
 	if err != nil {
-		http.Error(w, "Invalid template ID", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid template ID")
 		return
 	}
 
-	err = h.service.DeleteTemplate(r.Context(), templateID, orgID)
+	err = h.service.DeleteTemplate(r.Context(), userID, templateID, orgID, appRole)
 	if err != nil {
 		slog.Error("delete template error", "templateID", templateID, "orgID", orgID, "err", err)
-		if err.Error() == "template not found or access denied" {
-			http.Error(w, err.Error(), http.StatusForbidden)
-			return
-		}
-		http.Error(w, "Failed to delete template", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to delete template")
 		return
 	}
 
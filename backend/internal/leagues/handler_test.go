@@ -9,519 +9,243 @@ import (
 	"testing"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/leaguefindr/backend/internal/auth"
+	"github.com/leaguefindr/backend/internal/shared"
 )
 
-// Helper function to create a test handler
-func createTestHandler() *Handler {
-	leaguesRepo := NewMockLeagueRepository()
-	service := NewService(leaguesRepo)
-	authRepo := auth.NewRepository(nil) // Will be mocked in actual tests
-	return NewHandler(service, auth.NewService(authRepo))
+// newTestHandler returns a handler over a newTestEnv
+// Protected routes sit behind Clerk JWT verification, so tests call those handlers directly
+// with the X-Clerk-User-ID header the middleware would have set
+func newTestHandler(t *testing.T) (*Handler, *testEnv) {
+	t.Helper()
+	env := newTestEnv(t)
+	return NewHandler(env.service, env.authService), env
 }
 
-// Helper function to create test request body
 func createLeagueRequestBody(v interface{}) *bytes.Buffer {
 	body := new(bytes.Buffer)
 	json.NewEncoder(body).Encode(v)
 	return body
 }
 
-// Helper function to set chi URL params
+// setChiParam sets a chi URL param as the router would
 func setChiParam(req *http.Request, key, value string) *http.Request {
 	rctx := chi.NewRouteContext()
 	rctx.URLParams.Add(key, value)
 	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
 }
 
-// ============= PUBLIC LEAGUE TESTS =============
-
-func TestGetApprovedLeagues_PublicHandler(t *testing.T) {
-	handler := createTestHandler()
-
-	// Add test data
-	orgID := 1
-	league := &League{
-		OrgID:      &orgID,
-		LeagueName: stringPtr("Basketball League"),
-		Status:     LeagueStatusApproved,
-	}
-	handler.service.repo.(*MockLeagueRepository).Create(league)
-
-	req, err := http.NewRequest("GET", "/leagues", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetApprovedLeagues).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("expected status %d, got %d", http.StatusOK, status)
-	}
-
-	expected := "application/json"
-	if ct := rr.Header().Get("Content-Type"); ct != expected {
-		t.Errorf("expected content-type %s, got %s", expected, ct)
-	}
-
-	var resp GetLeaguesResponse
-	err = json.NewDecoder(rr.Body).Decode(&resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
-	}
-
-	if len(resp.Leagues) != 1 {
-		t.Errorf("expected 1 league, got %d", len(resp.Leagues))
+func decodeProblem(t *testing.T, rr *httptest.ResponseRecorder) shared.Problem {
+	t.Helper()
+	var problem shared.Problem
+	if err := json.NewDecoder(rr.Body).Decode(&problem); err != nil {
+		t.Fatalf("failed to decode problem: %v", err)
 	}
+	return problem
 }
 
-func TestGetLeagueByIDHandler_Success(t *testing.T) {
-	handler := createTestHandler()
-
-	orgID := 1
-	league := &League{
-		OrgID:      &orgID,
-		LeagueName: stringPtr("Test League"),
-		Status:     LeagueStatusApproved,
+func TestGetApprovedLeagues_PublicRoute(t *testing.T) {
+	handler, env := newTestHandler(t)
+	pending := env.submitTestLeague(t)
+	approved := env.submitTestLeague(t)
+	if err := env.service.ApproveLeagueByUUID(context.Background(), "admin", *approved.ID); err != nil {
+		t.Fatalf("approve failed: %v", err)
 	}
-	handler.service.repo.(*MockLeagueRepository).Create(league)
-
-	req, err := http.NewRequest("GET", "/leagues/1", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	req = setChiParam(req, "id", "1")
 
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
 	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetLeagueByID).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("expected status %d, got %d", http.StatusOK, status)
-	}
-
-	var league_resp League
-	err = json.NewDecoder(rr.Body).Decode(&league_resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
-	}
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/leagues/", nil))
 
-	if league_resp.LeagueName == nil || *league_resp.LeagueName != "Test League" {
-		t.Error("league name mismatch")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
 	}
-}
-
-func TestGetLeagueByID_NotFound(t *testing.T) {
-	handler := createTestHandler()
-
-	req, err := http.NewRequest("GET", "/leagues/999", nil)
-	if err != nil {
-		t.Fatal(err)
+	var response GetLeaguesResponse
+	json.NewDecoder(rr.Body).Decode(&response)
+	if response.Count != 1 || len(response.Leagues) != 1 || *response.Leagues[0].ID != *approved.ID {
+		t.Errorf("expected only %s, got %+v", *approved.ID, response)
 	}
 
-	req = setChiParam(req, "id", "999")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetLeagueByID).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusNotFound {
-		t.Errorf("expected status %d, got %d", http.StatusNotFound, status)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/leagues/approved/"+*pending.ID, nil))
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected a pending league to be hidden, got %d", rr.Code)
 	}
 }
 
-func TestGetLeagueByID_InvalidID(t *testing.T) {
-	handler := createTestHandler()
-
-	req, err := http.NewRequest("GET", "/leagues/invalid", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	req = setChiParam(req, "id", "invalid")
+func TestCreateLeagueHandler(t *testing.T) {
+	handler, env := newTestHandler(t)
 
+	req := httptest.NewRequest(http.MethodPost, "/leagues?org_id="+env.orgID, createLeagueRequestBody(validTestRequest()))
+	req.Header.Set("X-Clerk-User-ID", "organizer")
 	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetLeagueByID).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusBadRequest {
-		t.Errorf("expected status %d, got %d", http.StatusBadRequest, status)
-	}
-}
-
-// ============= CREATE LEAGUE TESTS =============
-
-func TestCreateLeagueHandler_Success(t *testing.T) {
-	handler := createTestHandler()
-
-	req := &CreateLeagueRequest{
-		SportID:              1,
-		LeagueName:           stringPtr("New League"),
-		Division:             stringPtr("Beginner"),
-		RegistrationDeadline: stringPtr("2025-12-31"),
-		SeasonStartDate:      stringPtr("2026-01-15"),
-		GameOccurrences:      GameOccurrences{{Day: "Monday", StartTime: "19:00", EndTime: "21:00"}},
-		PricingStrategy:      PricingStrategyPerPerson,
-		PricingAmount:        float64Ptr(50.0),
-		AgeGroup:             stringPtr("Adult"),
-		Gender:               stringPtr("Co-ed"),
-		SeasonDetails:        stringPtr("Regular season"),
-		RegistrationURL:      stringPtr("https://example.com/register"),
-		Duration:             intPtr(10),
-		MinimumTeamPlayers:   intPtr(5),
-	}
-
-	httpReq, err := http.NewRequest("POST", "/leagues", createLeagueRequestBody(req))
-	if err != nil {
-		t.Fatal(err)
-	}
-	httpReq.Header.Set("X-Clerk-User-ID", "user_123")
-
+	handler.CreateLeague(rr, req)
+
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", rr.Code, rr.Body.String())
+	}
+	var response CreateLeagueResponse
+	json.NewDecoder(rr.Body).Decode(&response)
+	if response.League.ID == nil || response.League.Status != LeagueStatusPending {
+		t.Errorf("expected a pending league, got %+v", response.League)
+	}
+}
+
+func TestCreateLeagueHandler_Errors(t *testing.T) {
+	handler, env := newTestHandler(t)
+	invalid := validTestRequest()
+	invalid.SeasonStartDate = nil
+
+	tests := []struct {
+		name   string
+		userID string
+		target string
+		body   interface{}
+		status int
+	}{
+		{"no user", "", "/leagues?org_id=" + env.orgID, validTestRequest(), http.StatusUnauthorized},
+		{"no organization", "organizer", "/leagues", validTestRequest(), http.StatusBadRequest},
+		{"not a member", "stranger", "/leagues?org_id=" + env.orgID, validTestRequest(), http.StatusForbidden},
+		{"invalid request", "organizer", "/leagues?org_id=" + env.orgID, invalid, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, tt.target, createLeagueRequestBody(tt.body))
+		if tt.userID != "" {
+			req.Header.Set("X-Clerk-User-ID", tt.userID)
+		}
+		rr := httptest.NewRecorder()
+		handler.CreateLeague(rr, req)
+
+		if rr.Code != tt.status {
+			t.Errorf("%s: expected status %d, got %d: %s", tt.name, tt.status, rr.Code, rr.Body.String())
+			continue
+		}
+		if problem := decodeProblem(t, rr); problem.Status != tt.status {
+			t.Errorf("%s: unexpected problem %+v", tt.name, problem)
+		}
+	}
+}
+
+func TestApproveLeagueHandler(t *testing.T) {
+	handler, env := newTestHandler(t)
+	league := env.submitTestLeague(t)
+
+	req := setChiParam(httptest.NewRequest(http.MethodPut, "/leagues/admin/"+*league.ID+"/approve", nil), "id", *league.ID)
+	req.Header.Set("X-Clerk-User-ID", "admin")
 	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.CreateLeague).ServeHTTP(rr, httpReq)
+	handler.ApproveLeague(rr, req)
 
-	if status := rr.Code; status != http.StatusCreated {
-		t.Errorf("expected status %d, got %d", http.StatusCreated, status)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
 	}
-
-	var resp CreateLeagueResponse
-	err = json.NewDecoder(rr.Body).Decode(&resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
+	var response ApproveLeagueResponse
+	json.NewDecoder(rr.Body).Decode(&response)
+	if response.Status != "approved" {
+		t.Errorf("expected status approved, got %+v", response)
 	}
 
-	if resp.League.Status != LeagueStatusPending {
-		t.Errorf("expected status pending, got %s", resp.League.Status)
+	req = setChiParam(httptest.NewRequest(http.MethodPut, "/leagues/admin/"+*league.ID+"/approve", nil), "id", *league.ID)
+	req.Header.Set("X-Clerk-User-ID", "admin")
+	rr = httptest.NewRecorder()
+	handler.ApproveLeague(rr, req)
+	if rr.Code != http.StatusConflict || decodeProblem(t, rr).Code != shared.CodeConflict {
+		t.Errorf("expected a conflict approving twice, got %d", rr.Code)
 	}
 }
 
-func TestCreateLeague_NoUserID(t *testing.T) {
-	handler := createTestHandler()
-
-	req := &CreateLeagueRequest{
-		SportID: 1,
-		Division: stringPtr("Beginner"),
-		RegistrationDeadline: stringPtr("2025-12-31"),
-		SeasonStartDate: stringPtr("2026-01-15"),
-	}
-
-	httpReq, err := http.NewRequest("POST", "/leagues", createLeagueRequestBody(req))
-	if err != nil {
-		t.Fatal(err)
-	}
-	// Don't set user ID
+func TestRejectLeagueHandler(t *testing.T) {
+	handler, env := newTestHandler(t)
+	league := env.submitTestLeague(t)
 
+	body := createLeagueRequestBody(RejectLeagueRequest{RejectionReason: "Missing venue"})
+	req := setChiParam(httptest.NewRequest(http.MethodPut, "/leagues/admin/"+*league.ID+"/reject", body), "id", *league.ID)
+	req.Header.Set("X-Clerk-User-ID", "admin")
 	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.CreateLeague).ServeHTTP(rr, httpReq)
-
-	if status := rr.Code; status != http.StatusUnauthorized {
-		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, status)
-	}
-}
-
-// ============= ORGANIZATION LEAGUE TESTS =============
-
-func TestGetLeaguesByOrgIDHandler_Success(t *testing.T) {
-	handler := createTestHandler()
-
-	orgID := 1
-	league1 := &League{OrgID: &orgID, LeagueName: stringPtr("League 1"), Status: LeagueStatusApproved}
-	league2 := &League{OrgID: &orgID, LeagueName: stringPtr("League 2"), Status: LeagueStatusPending}
-	handler.service.repo.(*MockLeagueRepository).Create(league1)
-	handler.service.repo.(*MockLeagueRepository).Create(league2)
-
-	req, err := http.NewRequest("GET", "/leagues/org/1", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	req = setChiParam(req, "orgId", "1")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetLeaguesByOrgID).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("expected status %d, got %d", http.StatusOK, status)
-	}
+	handler.RejectLeague(rr, req)
 
-	var resp GetLeaguesResponse
-	err = json.NewDecoder(rr.Body).Decode(&resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
 	}
-
-	if len(resp.Leagues) != 2 {
-		t.Errorf("expected 2 leagues, got %d", len(resp.Leagues))
+	stored, _ := env.service.GetLeagueByUUID(context.Background(), *league.ID)
+	if stored.Status != LeagueStatusRejected {
+		t.Errorf("expected the league to be rejected, got %s", stored.Status)
 	}
 }
 
-// ============= ADMIN LEAGUE TESTS =============
-
-func TestGetAllLeagues_Admin(t *testing.T) {
-	handler := createTestHandler()
-
-	orgID := 1
-	league1 := &League{OrgID: &orgID, LeagueName: stringPtr("League 1"), Status: LeagueStatusApproved}
-	league2 := &League{OrgID: &orgID, LeagueName: stringPtr("League 2"), Status: LeagueStatusPending}
-	league3 := &League{OrgID: &orgID, LeagueName: stringPtr("League 3"), Status: LeagueStatusRejected}
-
-	handler.service.repo.(*MockLeagueRepository).Create(league1)
-	handler.service.repo.(*MockLeagueRepository).Create(league2)
-	handler.service.repo.(*MockLeagueRepository).Create(league3)
-
-	req, err := http.NewRequest("GET", "/admin/all", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
+func TestSaveDraftHandler(t *testing.T) {
+	handler, env := newTestHandler(t)
 
+	body := createLeagueRequestBody(SaveLeagueDraftRequest{FormData: FormData{"league_name": "Fall Kickball"}})
+	req := httptest.NewRequest(http.MethodPost, "/leagues/drafts?org_id="+env.orgID, body)
+	req.Header.Set("X-Clerk-User-ID", "organizer")
 	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetAllLeagues).ServeHTTP(rr, req)
+	handler.SaveDraft(rr, req)
 
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("expected status %d, got %d", http.StatusOK, status)
+	if rr.Code != http.StatusOK && rr.Code != http.StatusCreated {
+		t.Fatalf("expected the draft to be saved, got %d: %s", rr.Code, rr.Body.String())
 	}
 
-	var resp GetLeaguesResponse
-	err = json.NewDecoder(rr.Body).Decode(&resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
-	}
+	req = setChiParam(httptest.NewRequest(http.MethodGet, "/leagues/drafts/"+env.orgID, nil), "orgId", env.orgID)
+	req.Header.Set("X-Clerk-User-ID", "organizer")
+	rr = httptest.NewRecorder()
+	handler.GetDraftsByOrgID(rr, req)
 
-	if len(resp.Leagues) != 3 {
-		t.Errorf("expected 3 leagues, got %d", len(resp.Leagues))
+	if rr.Code != http.StatusOK || !bytes.Contains(rr.Body.Bytes(), []byte("Fall Kickball Draft")) {
+		t.Errorf("expected the saved draft to be listed, got %d: %s", rr.Code, rr.Body.String())
 	}
 }
 
-func TestGetPendingLeagues_Admin(t *testing.T) {
-	handler := createTestHandler()
-
-	orgID := 1
-	league1 := &League{OrgID: &orgID, LeagueName: stringPtr("League 1"), Status: LeagueStatusApproved}
-	league2 := &League{OrgID: &orgID, LeagueName: stringPtr("League 2"), Status: LeagueStatusPending}
-	league3 := &League{OrgID: &orgID, LeagueName: stringPtr("League 3"), Status: LeagueStatusPending}
-
-	handler.service.repo.(*MockLeagueRepository).Create(league1)
-	handler.service.repo.(*MockLeagueRepository).Create(league2)
-	handler.service.repo.(*MockLeagueRepository).Create(league3)
-
-	req, err := http.NewRequest("GET", "/admin/pending", nil)
-	if err != nil {
-		t.Fatal(err)
+func TestOrgScopedHandlersForbidNonMembers(t *testing.T) {
+	handler, env := newTestHandler(t)
+	env.submitTestLeague(t)
+	if _, err := env.service.SaveDraft(context.Background(), env.orgID, "organizer", "", nil, FormData{"league_name": "Fall Kickball"}); err != nil {
+		t.Fatalf("save draft: %v", err)
 	}
 
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetPendingLeagues).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("expected status %d, got %d", http.StatusOK, status)
-	}
-
-	var resp GetLeaguesResponse
-	err = json.NewDecoder(rr.Body).Decode(&resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
-	}
+	for name, serve := range map[string]http.HandlerFunc{
+		"GetLeaguesByOrgID":   handler.GetLeaguesByOrgID,
+		"GetDraft":            handler.GetDraft,
+		"GetDraftsByOrgID":    handler.GetDraftsByOrgID,
+		"GetTemplatesByOrgID": handler.GetTemplatesByOrgID,
+	} {
+		req := setChiParam(httptest.NewRequest(http.MethodGet, "/leagues/org/"+env.orgID, nil), "orgId", env.orgID)
+		req.Header.Set("X-Clerk-User-ID", "stranger")
+		rr := httptest.NewRecorder()
+		serve(rr, req)
+		if rr.Code != http.StatusForbidden || bytes.Contains(rr.Body.Bytes(), []byte("Fall Kickball")) {
+			t.Errorf("%s: expected 403 for a non-member, got %d: %s", name, rr.Code, rr.Body.String())
+		}
 
-	if len(resp.Leagues) != 2 {
-		t.Errorf("expected 2 pending leagues, got %d", len(resp.Leagues))
+		req = setChiParam(httptest.NewRequest(http.MethodGet, "/leagues/org/"+env.orgID, nil), "orgId", env.orgID)
+		req.Header.Set("X-Clerk-User-ID", "organizer")
+		rr = httptest.NewRecorder()
+		serve(rr, req)
+		if rr.Code != http.StatusOK {
+			t.Errorf("%s: expected 200 for a member, got %d: %s", name, rr.Code, rr.Body.String())
+		}
 	}
 }
 
-func TestApproveLeagueHandler_Success(t *testing.T) {
-	handler := createTestHandler()
-
-	orgID := 1
-	league := &League{OrgID: &orgID, LeagueName: stringPtr("Test League"), Status: LeagueStatusPending}
-	handler.service.repo.(*MockLeagueRepository).Create(league)
-
-	req, err := http.NewRequest("PUT", "/leagues/1/approve", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	req = setChiParam(req, "id", "1")
+func TestMarkLeagueDuplicateHandler(t *testing.T) {
+	handler, env := newTestHandler(t)
+	original := env.submitTestLeague(t)
+	duplicate := env.submitTestLeague(t)
 
+	req := httptest.NewRequest(http.MethodPut, "/leagues/admin/"+*duplicate.ID+"/duplicate", createLeagueRequestBody(MarkDuplicateRequest{DuplicateOfID: "not-a-uuid"}))
+	req.Header.Set("X-Clerk-User-ID", "admin")
 	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.ApproveLeague).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("expected status %d, got %d", http.StatusOK, status)
-	}
-
-	var resp map[string]string
-	err = json.NewDecoder(rr.Body).Decode(&resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
-	}
-
-	if resp["status"] != "approved" {
-		t.Errorf("expected status 'approved', got %s", resp["status"])
-	}
-}
-
-func TestRejectLeagueHandler_Success(t *testing.T) {
-	handler := createTestHandler()
-
-	orgID := 1
-	league := &League{OrgID: &orgID, LeagueName: stringPtr("Test League"), Status: LeagueStatusPending}
-	handler.service.repo.(*MockLeagueRepository).Create(league)
-
-	req_body := RejectLeagueRequest{RejectionReason: "Does not meet requirements"}
-
-	req, err := http.NewRequest("PUT", "/leagues/1/reject", createLeagueRequestBody(req_body))
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	req = setChiParam(req, "id", "1")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.RejectLeague).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("expected status %d, got %d", http.StatusOK, status)
-	}
-
-	var resp map[string]string
-	err = json.NewDecoder(rr.Body).Decode(&resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
-	}
-
-	if resp["status"] != "rejected" {
-		t.Errorf("expected status 'rejected', got %s", resp["status"])
-	}
-}
-
-// ============= DRAFT TESTS =============
-
-func TestSaveDraftHandler_Success(t *testing.T) {
-	handler := createTestHandler()
-
-	draftData := DraftData{"name": "Test League Draft", "sport_id": 1}
-	req_body := SaveLeagueDraftRequest{DraftData: draftData}
-
-	req, err := http.NewRequest("POST", "/drafts", createLeagueRequestBody(req_body))
-	if err != nil {
-		t.Fatal(err)
+	handler.MarkLeagueDuplicate(rr, setChiParam(req, "id", *duplicate.ID))
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400 for a malformed ID, got %d: %s", rr.Code, rr.Body.String())
 	}
-
-	req.Header.Set("X-Clerk-User-ID", "user_123")
-	q := req.URL.Query()
-	q.Add("org_id", "1")
-	req.URL.RawQuery = q.Encode()
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.SaveDraft).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("expected status %d, got %d", http.StatusOK, status)
-	}
-
-	var resp GetLeagueDraftResponse
-	err = json.NewDecoder(rr.Body).Decode(&resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
-	}
-
-	if resp.Draft == nil {
-		t.Error("expected draft in response")
-	}
-
-	if resp.Draft.OrgID != 1 {
-		t.Errorf("expected org_id 1, got %d", resp.Draft.OrgID)
-	}
-}
-
-func TestGetDraftHandler_Success(t *testing.T) {
-	handler := createTestHandler()
-
-	// Save a draft first
-	draftData := DraftData{"name": "Test Draft"}
-	handler.service.SaveDraft(1, "user_123", draftData)
-
-	req, err := http.NewRequest("GET", "/drafts/org/1", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	req = setChiParam(req, "orgId", "1")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetDraft).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("expected status %d, got %d", http.StatusOK, status)
-	}
-
-	var resp GetLeagueDraftResponse
-	err = json.NewDecoder(rr.Body).Decode(&resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
-	}
-
-	if resp.Draft == nil {
-		t.Error("expected draft in response")
-	}
-}
-
-func TestGetAllDraftsHandler_Admin(t *testing.T) {
-	handler := createTestHandler()
-
-	// Save multiple drafts
-	handler.service.SaveDraft(1, "user_123", DraftData{"name": "Draft 1"})
-	handler.service.SaveDraft(2, "user_456", DraftData{"name": "Draft 2"})
-	handler.service.SaveDraft(3, "user_789", DraftData{"name": "Draft 3"})
-
-	req, err := http.NewRequest("GET", "/admin/drafts", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetAllDrafts).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("expected status %d, got %d", http.StatusOK, status)
-	}
-
-	var resp map[string][]LeagueDraft
-	err = json.NewDecoder(rr.Body).Decode(&resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
-	}
-
-	if len(resp["drafts"]) != 3 {
-		t.Errorf("expected 3 drafts, got %d", len(resp["drafts"]))
-	}
-}
-
-func TestDeleteDraftHandler_Success(t *testing.T) {
-	handler := createTestHandler()
-
-	// Save a draft first
-	handler.service.SaveDraft(1, "user_123", DraftData{"name": "Test Draft"})
-
-	req, err := http.NewRequest("DELETE", "/drafts/org/1", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	req = setChiParam(req, "orgId", "1")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.DeleteDraft).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("expected status %d, got %d", http.StatusOK, status)
-	}
-
-	var resp map[string]string
-	err = json.NewDecoder(rr.Body).Decode(&resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
+	if problem := decodeProblem(t, rr); len(problem.Errors) != 1 || problem.Errors[0].Field != "duplicate_of_id" {
+		t.Errorf("expected a duplicate_of_id field error, got %+v", problem.Errors)
 	}
 
-	if resp["status"] != "deleted" {
-		t.Errorf("expected status 'deleted', got %s", resp["status"])
+	req = httptest.NewRequest(http.MethodPut, "/leagues/admin/"+*duplicate.ID+"/duplicate", createLeagueRequestBody(MarkDuplicateRequest{DuplicateOfID: *original.ID}))
+	req.Header.Set("X-Clerk-User-ID", "admin")
+	rr = httptest.NewRecorder()
+	handler.MarkLeagueDuplicate(rr, setChiParam(req, "id", *duplicate.ID))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
 	}
 }
@@ -0,0 +1,470 @@
+package leagues
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/shared"
+	"github.com/leaguefindr/backend/internal/sports"
+	"github.com/leaguefindr/backend/internal/venues"
+)
+
+const (
+	// maxImportBytes caps the size of an uploaded import file
+	maxImportBytes = 10 << 20
+	// maxImportRows caps the number of leagues in one import
+	maxImportRows = 1000
+	// minSuggestionScore is the fuzzy match score above which an unknown sport is reported with a suggestion
+	minSuggestionScore = 0.6
+)
+
+// importColumns are the spreadsheet columns an import understands, named after the CreateLeagueRequest JSON fields
+var importColumns = []string{
+	"league_name", "division", "sport_id", "sport_name", "organization_name",
+	"registration_deadline", "season_start_date", "season_end_date", "game_occurrences",
+	"pricing_strategy", "pricing_amount", "per_game_fee",
+	"venue_id", "venue_name", "venue_address", "venue_lat", "venue_lng",
+	"gender", "season_details", "registration_url", "duration", "minimum_team_players",
+}
+
+// importColumnAliases maps friendlier header names to import columns
+var importColumnAliases = map[string]string{
+	"name":          "league_name",
+	"league":        "league_name",
+	"sport":         "sport_name",
+	"organization":  "organization_name",
+	"deadline":      "registration_deadline",
+	"start_date":    "season_start_date",
+	"end_date":      "season_end_date",
+	"schedule":      "game_occurrences",
+	"games":         "game_occurrences",
+	"price":         "pricing_amount",
+	"pricing":       "pricing_strategy",
+	"venue":         "venue_name",
+	"address":       "venue_address",
+	"lat":           "venue_lat",
+	"latitude":      "venue_lat",
+	"lng":           "venue_lng",
+	"longitude":     "venue_lng",
+	"details":       "season_details",
+	"url":           "registration_url",
+	"weeks":         "duration",
+	"min_players":   "minimum_team_players",
+	"team_size_min": "minimum_team_players",
+}
+
+// importRow is one spreadsheet row converted into a create request
+type importRow struct {
+	Row     int // Spreadsheet row number, the header being row 1
+	Request CreateLeagueRequest
+	Errors  []string // Values that could not be parsed
+}
+
+// parseImportRows converts spreadsheet rows into create requests
+// The first non-empty row is the header; blank rows are skipped. The returned warnings concern the file as a whole
+func parseImportRows(records [][]string) ([]importRow, []string, error) {
+	headerIndex := -1
+	for i, record := range records {
+		if !isBlankRecord(record) {
+			headerIndex = i
+			break
+		}
+	}
+	if headerIndex < 0 {
+		return nil, nil, fmt.Errorf("file has no header row")
+	}
+
+	var warnings []string
+	columns := make(map[int]string)
+	seen := make(map[string]bool)
+	for i, header := range records[headerIndex] {
+		if strings.TrimSpace(header) == "" {
+			continue
+		}
+		column, ok := importColumnName(header)
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("ignoring unknown column %q", header))
+			continue
+		}
+		if seen[column] {
+			warnings = append(warnings, fmt.Sprintf("column %q appears more than once; using the first", header))
+			continue
+		}
+		seen[column] = true
+		columns[i] = column
+	}
+	if len(columns) == 0 {
+		return nil, warnings, fmt.Errorf("header row has no known columns")
+	}
+
+	var rows []importRow
+	for i := headerIndex + 1; i < len(records); i++ {
+		if isBlankRecord(records[i]) {
+			continue
+		}
+		if len(rows) == maxImportRows {
+			return nil, warnings, fmt.Errorf("file has more than %d leagues", maxImportRows)
+		}
+
+		values := make(map[string]string)
+		for index, column := range columns {
+			if index < len(records[i]) {
+				values[column] = strings.TrimSpace(records[i][index])
+			}
+		}
+		request, errs := importRequest(values)
+		rows = append(rows, importRow{Row: i + 1, Request: request, Errors: errs})
+	}
+
+	return rows, warnings, nil
+}
+
+// importColumnName normalizes a header ("Season Start Date", "season-start-date*") to an import column
+func importColumnName(header string) (string, bool) {
+	name := strings.ToLower(strings.TrimSpace(header))
+	name = strings.TrimSuffix(name, "*")
+	name = strings.NewReplacer(" ", "_", "-", "_").Replace(strings.TrimSpace(name))
+
+	if alias, ok := importColumnAliases[name]; ok {
+		return alias, true
+	}
+	for _, column := range importColumns {
+		if column == name {
+			return column, true
+		}
+	}
+	return "", false
+}
+
+func isBlankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// importRequest builds a create request from one row's values, collecting a message for each value that can't be parsed
+func importRequest(values map[string]string) (CreateLeagueRequest, []string) {
+	var request CreateLeagueRequest
+	var errs []string
+	fail := func(column string, err error) {
+		errs = append(errs, fmt.Sprintf("%s: %v", column, err))
+	}
+
+	text := func(column string) *string {
+		if value := values[column]; value != "" {
+			return &value
+		}
+		return nil
+	}
+	whole := func(column string) *int64 {
+		value := values[column]
+		if value == "" {
+			return nil
+		}
+		parsed, err := parseImportNumber(value)
+		if err != nil || parsed != math.Trunc(parsed) {
+			fail(column, fmt.Errorf("%q is not a whole number", value))
+			return nil
+		}
+		n := int64(parsed)
+		return &n
+	}
+	number := func(column string) *float64 {
+		value := values[column]
+		if value == "" {
+			return nil
+		}
+		parsed, err := parseImportNumber(value)
+		if err != nil {
+			fail(column, fmt.Errorf("%q is not a number", value))
+			return nil
+		}
+		return &parsed
+	}
+	date := func(column string) *string {
+		value := values[column]
+		if value == "" {
+			return nil
+		}
+		parsed, err := parseImportDate(value)
+		if err != nil {
+			fail(column, err)
+			return nil
+		}
+		return &parsed
+	}
+	count := func(column string) *int {
+		if n := whole(column); n != nil {
+			v := int(*n)
+			return &v
+		}
+		return nil
+	}
+
+	request.LeagueName = text("league_name")
+	request.Division = text("division")
+	request.SportID = whole("sport_id")
+	request.SportName = values["sport_name"]
+	request.OrganizationName = text("organization_name")
+	request.RegistrationDeadline = date("registration_deadline")
+	request.SeasonStartDate = date("season_start_date")
+	request.SeasonEndDate = date("season_end_date")
+	request.PricingAmount = number("pricing_amount")
+	request.PerGameFee = number("per_game_fee")
+	request.VenueID = whole("venue_id")
+	request.VenueName = text("venue_name")
+	request.VenueAddress = text("venue_address")
+	request.VenueLat = number("venue_lat")
+	request.VenueLng = number("venue_lng")
+	request.Gender = text("gender")
+	request.SeasonDetails = text("season_details")
+	request.RegistrationURL = text("registration_url")
+	request.Duration = count("duration")
+	request.MinimumTeamPlayers = count("minimum_team_players")
+
+	if value := values["pricing_strategy"]; value != "" {
+		strategy, err := parseImportPricingStrategy(value)
+		if err != nil {
+			fail("pricing_strategy", err)
+		}
+		request.PricingStrategy = strategy
+	}
+	if value := values["game_occurrences"]; value != "" {
+		occurrences, err := parseImportGameOccurrences(value)
+		if err != nil {
+			fail("game_occurrences", err)
+		}
+		request.GameOccurrences = occurrences
+	}
+
+	return request, errs
+}
+
+// parseImportNumber parses a number as typed in a spreadsheet, allowing a currency sign and thousands separators
+func parseImportNumber(value string) (float64, error) {
+	cleaned := strings.NewReplacer("$", "", ",", "", " ", "").Replace(value)
+	return strconv.ParseFloat(cleaned, 64)
+}
+
+// importDateLayouts are the date formats accepted besides Excel serial numbers
+var importDateLayouts = []string{"2006-01-02", "1/2/2006", "2006/1/2", "Jan 2, 2006", "January 2, 2006", "2 Jan 2006"}
+
+// excelEpoch is day 0 of Excel's date serial numbers (accounting for its 1900 leap year bug)
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// parseImportDate parses a spreadsheet date into the ISO 8601 format the create request expects
+// XLSX files store dates as serial numbers, which are converted too
+func parseImportDate(value string) (string, error) {
+	for _, layout := range importDateLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed.Format("2006-01-02"), nil
+		}
+	}
+
+	if serial, err := strconv.ParseFloat(value, 64); err == nil && serial >= 1 && serial < 2958466 {
+		return excelEpoch.AddDate(0, 0, int(serial)).Format("2006-01-02"), nil
+	}
+
+	return "", fmt.Errorf("%q is not a date (use YYYY-MM-DD)", value)
+}
+
+// parseImportPricingStrategy accepts "per_team", "Per Team", "team", "per person", "per player" and similar
+func parseImportPricingStrategy(value string) (PricingStrategy, error) {
+	normalized := strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(strings.TrimSpace(value)))
+	switch normalized {
+	case "per_team", "team":
+		return PricingStrategyPerTeam, nil
+	case "per_person", "person", "per_player", "player":
+		return PricingStrategyPerPerson, nil
+	}
+	return PricingStrategy(value), fmt.Errorf("%q is not a pricing strategy (use per_team or per_person)", value)
+}
+
+// parseImportGameOccurrences parses a schedule cell such as "Monday 19:00-21:00; Wed 6:30pm-8pm"
+// Occurrences are separated by semicolons or new lines
+func parseImportGameOccurrences(value string) (GameOccurrences, error) {
+	entries := strings.FieldsFunc(value, func(r rune) bool { return r == ';' || r == '\n' })
+
+	var occurrences GameOccurrences
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		dayPart, timePart, _ := strings.Cut(entry, " ")
+		day, ok := parseImportWeekday(dayPart)
+		if !ok {
+			return nil, fmt.Errorf("%q does not start with a weekday", entry)
+		}
+
+		timePart = strings.NewReplacer("–", "-", " to ", "-").Replace(timePart)
+		startPart, endPart, ok := strings.Cut(timePart, "-")
+		if !ok {
+			return nil, fmt.Errorf("%q needs a start and end time (e.g. Monday 19:00-21:00)", entry)
+		}
+		start, err := parseImportClock(startPart)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", entry, err)
+		}
+		end, err := parseImportClock(endPart)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", entry, err)
+		}
+
+		occurrences = append(occurrences, GameOccurrence{Day: day, StartTime: start, EndTime: end})
+	}
+
+	if len(occurrences) == 0 {
+		return nil, fmt.Errorf("no game times found")
+	}
+	return occurrences, nil
+}
+
+// parseImportWeekday accepts full weekday names and their three letter abbreviations
+func parseImportWeekday(value string) (string, bool) {
+	trimmed := strings.TrimRight(strings.TrimSpace(value), ".,:")
+	if day, ok := NormalizeWeekday(trimmed); ok {
+		return day, true
+	}
+	if len(trimmed) != 3 {
+		return "", false
+	}
+	for _, weekday := range Weekdays {
+		if strings.EqualFold(trimmed, weekday[:3]) {
+			return weekday, true
+		}
+	}
+	return "", false
+}
+
+// importClockLayouts are the accepted time of day formats
+var importClockLayouts = []string{"15:04", "3:04pm", "3pm", "3:04 pm", "3 pm"}
+
+// parseImportClock parses a time of day into the 24-hour HH:MM format used by GameOccurrence
+func parseImportClock(value string) (string, error) {
+	cleaned := strings.ToLower(strings.TrimSpace(value))
+	for _, layout := range importClockLayouts {
+		if parsed, err := time.Parse(layout, cleaned); err == nil {
+			return parsed.Format("15:04"), nil
+		}
+	}
+	return "", fmt.Errorf("%q is not a time", strings.TrimSpace(value))
+}
+
+// resolveImportReferences matches a row's sport and venue against the existing catalog, filling in their IDs
+// Unknown sports and venues with an address are allowed (they are created on approval) but reported as warnings
+func resolveImportReferences(request *CreateLeagueRequest, sportList []sports.Sport, venueList []venues.Venue) (warnings []string, errs []string) {
+	if request.SportID != nil {
+		sport := findImportSport(sportList, func(s sports.Sport) bool { return s.ID == *request.SportID })
+		if sport == nil {
+			errs = append(errs, fmt.Sprintf("sport_id %d does not exist", *request.SportID))
+		} else if request.SportName == "" {
+			request.SportName = sport.Name
+		}
+	} else if request.SportName != "" {
+		normalized := shared.NormalizeText(request.SportName)
+		sport := findImportSport(sportList, func(s sports.Sport) bool { return shared.NormalizeText(s.Name) == normalized })
+		if sport != nil {
+			request.SportID = &sport.ID
+			request.SportName = sport.Name
+		} else {
+			warning := fmt.Sprintf("sport %q is new and will be created when the league is approved", request.SportName)
+			if suggestion := suggestImportSport(sportList, request.SportName); suggestion != "" {
+				warning += fmt.Sprintf(" (did you mean %q?)", suggestion)
+			}
+			warnings = append(warnings, warning)
+		}
+	}
+
+	switch {
+	case request.VenueID != nil:
+		venue := findImportVenue(venueList, func(v venues.Venue) bool { return v.ID == *request.VenueID })
+		if venue == nil {
+			errs = append(errs, fmt.Sprintf("venue_id %d does not exist", *request.VenueID))
+		} else {
+			applyImportVenue(request, venue)
+		}
+	case request.VenueAddress != nil:
+		normalized := shared.NormalizeText(*request.VenueAddress)
+		venue := findImportVenue(venueList, func(v venues.Venue) bool { return shared.NormalizeText(v.Address) == normalized })
+		if venue != nil {
+			applyImportVenue(request, venue)
+			break
+		}
+		if request.VenueName == nil {
+			errs = append(errs, "venue_name is required for a new venue")
+			break
+		}
+		warnings = append(warnings, fmt.Sprintf("venue %q is new and will be created when the league is approved", *request.VenueAddress))
+		if request.VenueLat == nil || request.VenueLng == nil {
+			warnings = append(warnings, "new venue has no venue_lat/venue_lng and won't appear in location searches")
+		}
+	case request.VenueName != nil:
+		normalized := shared.NormalizeText(*request.VenueName)
+		var matches []venues.Venue
+		for _, venue := range venueList {
+			if shared.NormalizeText(venue.Name) == normalized {
+				matches = append(matches, venue)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			errs = append(errs, fmt.Sprintf("venue %q not found; add venue_address to create it", *request.VenueName))
+		case 1:
+			applyImportVenue(request, &matches[0])
+		default:
+			errs = append(errs, fmt.Sprintf("venue name %q matches %d venues; use venue_address or venue_id", *request.VenueName, len(matches)))
+		}
+	default:
+		warnings = append(warnings, "no venue given")
+	}
+
+	return warnings, errs
+}
+
+func findImportSport(sportList []sports.Sport, match func(sports.Sport) bool) *sports.Sport {
+	for i := range sportList {
+		if match(sportList[i]) {
+			return &sportList[i]
+		}
+	}
+	return nil
+}
+
+func findImportVenue(venueList []venues.Venue, match func(venues.Venue) bool) *venues.Venue {
+	for i := range venueList {
+		if match(venueList[i]) {
+			return &venueList[i]
+		}
+	}
+	return nil
+}
+
+// suggestImportSport returns the closest existing sport name for a likely typo, or ""
+func suggestImportSport(sportList []sports.Sport, name string) string {
+	best := ""
+	bestScore := minSuggestionScore
+	for _, sport := range sportList {
+		if score := shared.FuzzyMatchScore(name, sport.Name); score >= bestScore {
+			best = sport.Name
+			bestScore = score
+		}
+	}
+	return best
+}
+
+// applyImportVenue points the request at an existing venue
+func applyImportVenue(request *CreateLeagueRequest, venue *venues.Venue) {
+	request.VenueID = &venue.ID
+	request.VenueName = &venue.Name
+	request.VenueAddress = &venue.Address
+	request.VenueLat = &venue.Lat
+	request.VenueLng = &venue.Lng
+}
@@ -0,0 +1,268 @@
+package leagues
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/leaguefindr/backend/internal/sports"
+	"github.com/leaguefindr/backend/internal/venues"
+)
+
+const importTestCSV = `League Name,Division,Sport,Deadline,Season Start Date,Season End Date,Schedule,Pricing,Price,Venue,Venue Address,Gender,URL,Weeks,Min Players,Notes
+Spring Kickball,Rec,Kickball,2025-03-01,3/15/2025,2025-05-31,Monday 19:00-21:00; Wed 6:30pm-8pm,Per Team,"$1,200",Green Lake Park,7201 E Green Lake Dr N,Coed,https://example.com/register,10,8,bring cleats
+
+Summer Soccer,,Socer,not a date,2025-06-01,,Someday 7pm,per team,abc,,,Men's,https://example.com,8,eleven,
+`
+
+func TestParseImportRows(t *testing.T) {
+	records, err := readCSV([]byte(importTestCSV))
+	if err != nil {
+		t.Fatalf("readCSV: %v", err)
+	}
+	rows, warnings, err := parseImportRows(records)
+	if err != nil {
+		t.Fatalf("parseImportRows: %v", err)
+	}
+
+	if !reflect.DeepEqual(warnings, []string{`ignoring unknown column "Notes"`}) {
+		t.Errorf("unexpected file warnings: %q", warnings)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected the blank line to be skipped, got %d rows", len(rows))
+	}
+
+	first := rows[0]
+	if first.Row != 2 || len(first.Errors) != 0 {
+		t.Fatalf("expected row 2 without errors, got row %d with %q", first.Row, first.Errors)
+	}
+	req := first.Request
+	if *req.LeagueName != "Spring Kickball" || req.SportName != "Kickball" || *req.VenueName != "Green Lake Park" {
+		t.Errorf("text columns not mapped: %+v", req)
+	}
+	if *req.RegistrationDeadline != "2025-03-01" || *req.SeasonStartDate != "2025-03-15" || *req.SeasonEndDate != "2025-05-31" {
+		t.Errorf("dates not normalized: %s %s %s", *req.RegistrationDeadline, *req.SeasonStartDate, *req.SeasonEndDate)
+	}
+	if req.PricingStrategy != PricingStrategyPerTeam || *req.PricingAmount != 1200 {
+		t.Errorf("pricing not parsed: %s %v", req.PricingStrategy, *req.PricingAmount)
+	}
+	if *req.Duration != 10 || *req.MinimumTeamPlayers != 8 {
+		t.Errorf("counts not parsed: %d %d", *req.Duration, *req.MinimumTeamPlayers)
+	}
+	wantGames := GameOccurrences{
+		{Day: "Monday", StartTime: "19:00", EndTime: "21:00"},
+		{Day: "Wednesday", StartTime: "18:30", EndTime: "20:00"},
+	}
+	if !reflect.DeepEqual(req.GameOccurrences, wantGames) {
+		t.Errorf("game occurrences = %+v, want %+v", req.GameOccurrences, wantGames)
+	}
+
+	second := rows[1]
+	if second.Row != 4 {
+		t.Errorf("expected spreadsheet row 4, got %d", second.Row)
+	}
+	for _, column := range []string{"registration_deadline", "game_occurrences", "pricing_amount", "minimum_team_players"} {
+		if !containsPrefix(second.Errors, column+":") {
+			t.Errorf("expected an error for %s, got %q", column, second.Errors)
+		}
+	}
+}
+
+func TestParseImportRowsRequiresKnownHeader(t *testing.T) {
+	if _, _, err := parseImportRows([][]string{{"", ""}, {"foo", "bar"}, {"1", "2"}}); err == nil {
+		t.Errorf("expected an error when no column is recognized")
+	}
+	if _, _, err := parseImportRows(nil); err == nil {
+		t.Errorf("expected an error for an empty file")
+	}
+}
+
+func TestParseImportDate(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"2025-03-15", "2025-03-15", false},
+		{"3/15/2025", "2025-03-15", false},
+		{"03/05/2025", "2025-03-05", false},
+		{"Mar 15, 2025", "2025-03-15", false},
+		{"45731", "2025-03-15", false}, // Excel serial
+		{"15.03.2025", "", true},
+		{"soon", "", true},
+	}
+	for _, tt := range tests {
+		got, err := parseImportDate(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseImportDate(%q) = %q, %v; want %q", tt.value, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseImportGameOccurrences(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    GameOccurrences
+		wantErr bool
+	}{
+		{"Tuesday 18:00-20:00", GameOccurrences{{"Tuesday", "18:00", "20:00"}}, false},
+		{"sat: 9am to 11:30am\nSun 1 pm – 3 pm", GameOccurrences{{"Saturday", "09:00", "11:30"}, {"Sunday", "13:00", "15:00"}}, false},
+		{"Thu 7pm", nil, true},
+		{"Funday 7pm-9pm", nil, true},
+		{"Monday 25:00-26:00", nil, true},
+		{" ; ", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := parseImportGameOccurrences(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseImportGameOccurrences(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseImportGameOccurrences(%q) = %+v, want %+v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestResolveImportReferences(t *testing.T) {
+	sportList := []sports.Sport{{ID: 1, Name: "Kickball"}, {ID: 2, Name: "Soccer"}}
+	venueList := []venues.Venue{
+		{ID: 10, Name: "Green Lake Park", Address: "7201 E Green Lake Dr N", Lat: 47.68, Lng: -122.33},
+		{ID: 11, Name: "Community Center", Address: "1 Main St"},
+		{ID: 12, Name: "Community Center", Address: "2 Main St"},
+	}
+	int64Ptr := func(v int64) *int64 { return &v }
+
+	t.Run("matches existing sport and venue", func(t *testing.T) {
+		req := &CreateLeagueRequest{SportName: " KICKBALL", VenueAddress: stringPtr("7201 e green lake dr n.")}
+		warnings, errs := resolveImportReferences(req, sportList, venueList)
+		if len(warnings) != 0 || len(errs) != 0 {
+			t.Fatalf("unexpected warnings %q errors %q", warnings, errs)
+		}
+		if *req.SportID != 1 || req.SportName != "Kickball" || *req.VenueID != 10 || *req.VenueName != "Green Lake Park" || *req.VenueLat != 47.68 {
+			t.Errorf("references not resolved: %+v", req)
+		}
+	})
+
+	t.Run("new sport and venue are warnings", func(t *testing.T) {
+		req := &CreateLeagueRequest{SportName: "Socer", VenueName: stringPtr("New Field"), VenueAddress: stringPtr("9 Elm St")}
+		warnings, errs := resolveImportReferences(req, sportList, venueList)
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors %q", errs)
+		}
+		if req.SportID != nil || req.VenueID != nil {
+			t.Errorf("expected no IDs for new entities")
+		}
+		joined := strings.Join(warnings, "\n")
+		if !strings.Contains(joined, `did you mean "Soccer"?`) || !strings.Contains(joined, "no venue_lat/venue_lng") {
+			t.Errorf("unexpected warnings %q", warnings)
+		}
+	})
+
+	t.Run("venue name alone must match exactly one venue", func(t *testing.T) {
+		req := &CreateLeagueRequest{SportName: "Soccer", VenueName: stringPtr("green lake park")}
+		if _, errs := resolveImportReferences(req, sportList, venueList); len(errs) != 0 || *req.VenueID != 10 {
+			t.Errorf("expected a unique name match, got errors %q", errs)
+		}
+
+		req = &CreateLeagueRequest{SportName: "Soccer", VenueName: stringPtr("Community Center")}
+		if _, errs := resolveImportReferences(req, sportList, venueList); len(errs) != 1 {
+			t.Errorf("expected an ambiguity error, got %q", errs)
+		}
+
+		req = &CreateLeagueRequest{SportName: "Soccer", VenueName: stringPtr("Nowhere")}
+		if _, errs := resolveImportReferences(req, sportList, venueList); len(errs) != 1 {
+			t.Errorf("expected a not found error, got %q", errs)
+		}
+	})
+
+	t.Run("unknown IDs are errors", func(t *testing.T) {
+		req := &CreateLeagueRequest{SportID: int64Ptr(99), VenueID: int64Ptr(99)}
+		if _, errs := resolveImportReferences(req, sportList, venueList); len(errs) != 2 {
+			t.Errorf("expected two errors, got %q", errs)
+		}
+
+		req = &CreateLeagueRequest{SportID: int64Ptr(2), VenueID: int64Ptr(11)}
+		if _, errs := resolveImportReferences(req, sportList, venueList); len(errs) != 0 || req.SportName != "Soccer" || *req.VenueAddress != "1 Main St" {
+			t.Errorf("expected IDs to fill in names, got %q %+v", errs, req)
+		}
+	})
+
+	t.Run("new venue needs a name", func(t *testing.T) {
+		req := &CreateLeagueRequest{SportName: "Soccer", VenueAddress: stringPtr("9 Elm St")}
+		if _, errs := resolveImportReferences(req, sportList, venueList); len(errs) != 1 {
+			t.Errorf("expected an error, got %q", errs)
+		}
+	})
+}
+
+func TestValidateImportedRows(t *testing.T) {
+	records, _ := readCSV([]byte(importTestCSV))
+	rows, _, _ := parseImportRows(records)
+
+	if err := ValidateLeagueRequest(&rows[0].Request); err != nil {
+		t.Errorf("expected a complete row to pass, got %v", err)
+	}
+	errs := validationMessages(ValidateLeagueRequest(&rows[1].Request))
+	if !reflect.DeepEqual(errs, []string{"division is required", "registration_deadline is required", "game_occurrences is required", "pricing_amount is required", "minimum_team_players is required"}) {
+		t.Errorf("unexpected validation errors %q", errs)
+	}
+}
+
+func containsPrefix(values []string, prefix string) bool {
+	for _, value := range values {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestImportLeaguesScreensRows(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	lines := strings.Split(importTestCSV, "\n")
+	header, row := lines[0], lines[1]
+	data := []byte(header + "\n" + row + "\n" + row + "\n")
+
+	report, err := env.service.ImportLeagues(ctx, "organizer", env.orgID, "", data, ImportFormatCSV, false)
+	if err != nil {
+		t.Fatalf("import leagues: %v", err)
+	}
+	if report.Imported != 2 {
+		t.Fatalf("expected both rows imported, got %+v", report.Rows)
+	}
+	first, second := report.Rows[0], report.Rows[1]
+	if first.PolicyDecision == nil || first.PolicyDecision.Outcome != PolicyQueue || first.LeagueStatus != LeagueStatusPending {
+		t.Errorf("expected the first row queued by the policy, got %s/%+v", first.LeagueStatus, first.PolicyDecision)
+	}
+	if len(second.DuplicateCandidates) != 1 || second.DuplicateCandidates[0].LeagueID != *first.LeagueID || !containsPrefix(second.Warnings, "likely duplicate of") {
+		t.Errorf("expected the second row to duplicate the first, got %+v %q", second.DuplicateCandidates, second.Warnings)
+	}
+	if count := env.countNotifications(t, "admin", "league_submitted"); count != 1 {
+		t.Errorf("expected admins to be told once about the import, got %d", count)
+	}
+
+	// Rows the policy approves skip the queue
+	if _, err := env.service.CreatePolicyRule(ctx, "admin", &PolicyRuleRequest{
+		Name:       "verified organizations",
+		Outcome:    PolicyAutoApprove,
+		Conditions: PolicyConditions{OrgVerified: boolPtr(true)},
+	}); err != nil {
+		t.Fatalf("create policy rule: %v", err)
+	}
+	if err := env.service.orgService.SetOrganizationVerified(ctx, "admin", env.orgID, true); err != nil {
+		t.Fatalf("verify organization: %v", err)
+	}
+	report, err = env.service.ImportLeagues(ctx, "organizer", env.orgID, "", []byte(header+"\n"+row+"\n"), ImportFormatCSV, false)
+	if err != nil {
+		t.Fatalf("import leagues once verified: %v", err)
+	}
+	if report.Rows[0].LeagueStatus != LeagueStatusApproved {
+		t.Errorf("expected the row auto-approved, got %s", report.Rows[0].LeagueStatus)
+	}
+	if count := env.countNotifications(t, "admin", "league_submitted"); count != 1 {
+		t.Errorf("expected no notice about an approved import, got %d", count)
+	}
+}
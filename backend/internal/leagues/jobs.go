@@ -0,0 +1,276 @@
+package leagues
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/jobs"
+	"github.com/leaguefindr/backend/internal/notifications"
+	"github.com/supabase-community/postgrest-go"
+)
+
+// Names of the league background jobs
+const (
+	JobCloseRegistration = "close_registration"
+	JobStartSeasons      = "start_seasons"
+	JobCompleteSeasons   = "complete_seasons"
+	JobPurgeStaleDrafts  = "purge_stale_drafts"
+	JobEscalateReviews   = "escalate_overdue_reviews"
+)
+
+// JobConfig configures the league background jobs
+type JobConfig struct {
+	Interval       time.Duration // How often each job runs
+	DraftRetention time.Duration // Drafts untouched for longer than this are deleted
+	ReviewSLA      time.Duration // Leagues awaiting review for longer than this are escalated to every admin; 0 disables
+}
+
+// lifecycleJob moves leagues into Target once the given date column has passed
+type lifecycleJob struct {
+	Name       string
+	Target     LifecycleStatus
+	From       []LifecycleStatus // States eligible for the move
+	DateColumn string
+	Inclusive  bool   // The move happens on the date itself rather than the day after
+	Note       string // Stored on the revision recorded for each moved league
+}
+
+// lifecycleJobs lists the time-based lifecycle transitions, in the order they should run
+var lifecycleJobs = []lifecycleJob{
+	{
+		Name:       JobCloseRegistration,
+		Target:     LifecycleRegistrationClosed,
+		From:       []LifecycleStatus{LifecycleRegistrationOpen, LifecycleFull},
+		DateColumn: "registration_deadline",
+		Note:       "Registration deadline passed",
+	},
+	{
+		Name:       JobStartSeasons,
+		Target:     LifecycleInSeason,
+		From:       []LifecycleStatus{LifecycleRegistrationOpen, LifecycleFull, LifecycleRegistrationClosed},
+		DateColumn: "season_start_date",
+		Inclusive:  true,
+		Note:       "Season started",
+	},
+	{
+		Name:       JobCompleteSeasons,
+		Target:     LifecycleCompleted,
+		From:       []LifecycleStatus{LifecycleRegistrationOpen, LifecycleFull, LifecycleRegistrationClosed, LifecycleInSeason},
+		DateColumn: "season_end_date",
+		Note:       "Season ended",
+	},
+}
+
+// NewJobs returns the league background jobs
+// client must be a service-role client: the jobs act on every organization's leagues
+func NewJobs(client *postgrest.Client, notificationsService *notifications.Service, cfg JobConfig) []jobs.Job {
+	return NewJobsWithRepository(NewRepository(client), notificationsService, cfg)
+}
+
+// NewJobsWithRepository returns the league background jobs acting on the leagues in repo
+func NewJobsWithRepository(repo RepositoryInterface, notificationsService *notifications.Service, cfg JobConfig) []jobs.Job {
+	var leagueJobs []jobs.Job
+	for _, lj := range lifecycleJobs {
+		leagueJobs = append(leagueJobs, jobs.Job{
+			Name:     lj.Name,
+			Interval: cfg.Interval,
+			Run: func(ctx context.Context, now time.Time) (jobs.Result, error) {
+				return runLifecycleJob(ctx, repo, lj, now)
+			},
+		})
+	}
+
+	leagueJobs = append(leagueJobs, jobs.Job{
+		Name:     JobPurgeStaleDrafts,
+		Interval: cfg.Interval,
+		Run: func(ctx context.Context, now time.Time) (jobs.Result, error) {
+			deleted, err := repo.DeleteStaleDrafts(ctx, now.Add(-cfg.DraftRetention))
+			if err != nil {
+				return jobs.Result{}, err
+			}
+			return jobs.Result{Affected: deleted, Message: fmt.Sprintf("deleted %d drafts older than %s", deleted, cfg.DraftRetention)}, nil
+		},
+	})
+
+	if cfg.ReviewSLA > 0 {
+		leagueJobs = append(leagueJobs, jobs.Job{
+			Name:     JobEscalateReviews,
+			Interval: cfg.Interval,
+			Run: func(ctx context.Context, now time.Time) (jobs.Result, error) {
+				return escalateOverdueReviews(ctx, repo, notificationsService, cfg.ReviewSLA, now)
+			},
+		})
+	}
+
+	return leagueJobs
+}
+
+// escalateOverdueReviews sends every admin one notification listing the leagues that have waited longer than sla for review
+// Each league is escalated once per stay in the queue; being resubmitted or edited again starts its clock over
+func escalateOverdueReviews(ctx context.Context, repo RepositoryInterface, notificationsService *notifications.Service, sla time.Duration, now time.Time) (jobs.Result, error) {
+	overdue, err := repo.GetOverdueReviews(ctx, now.Add(-sla))
+	if err != nil {
+		return jobs.Result{}, err
+	}
+	if len(overdue) == 0 {
+		return jobs.Result{Message: "no overdue reviews"}, nil
+	}
+
+	names := make([]string, len(overdue))
+	for i := range overdue {
+		names[i] = leagueDisplayName(&overdue[i])
+	}
+	message := fmt.Sprintf("%d leagues have waited more than %s for review: %s", len(overdue), formatSLA(sla), listLeagueNames(names))
+	if len(overdue) == 1 {
+		message = fmt.Sprintf("The league %s has waited more than %s for review", listLeagueNames(names), formatSLA(sla))
+	}
+
+	err = notificationsService.CreateNotificationForAllAdmins(ctx, notifications.NotificationLeagueReviewOverdue.String(), "League Reviews Overdue", message, nil, nil)
+	if err != nil {
+		return jobs.Result{}, fmt.Errorf("failed to notify admins: %w", err)
+	}
+
+	// Admins have been told, so a failure here only means the league may be listed again next run
+	escalated := 0
+	for i := range overdue {
+		league := &overdue[i]
+		if league.ID == nil {
+			continue
+		}
+		if err := repo.UpdateFieldsByUUID(ctx, *league.ID, map[string]interface{}{"review_escalated_at": now}); err != nil {
+			slog.Error("failed to mark league review as escalated", "leagueID", *league.ID, "err", err)
+			continue
+		}
+		escalated++
+	}
+
+	return jobs.Result{Affected: escalated, Message: fmt.Sprintf("escalated %d leagues waiting longer than %s", escalated, formatSLA(sla))}, nil
+}
+
+// formatSLA describes a review SLA in whole days or hours when it is one
+func formatSLA(sla time.Duration) string {
+	switch {
+	case sla%(24*time.Hour) == 0:
+		days := int(sla / (24 * time.Hour))
+		if days == 1 {
+			return "1 day"
+		}
+		return fmt.Sprintf("%d days", days)
+	case sla%time.Hour == 0:
+		hours := int(sla / time.Hour)
+		if hours == 1 {
+			return "1 hour"
+		}
+		return fmt.Sprintf("%d hours", hours)
+	default:
+		return sla.String()
+	}
+}
+
+// runLifecycleJob moves every eligible league whose date has passed into the job's target state
+// Failures on individual leagues are logged and the job carries on; the run fails only if none could be moved
+func runLifecycleJob(ctx context.Context, repo RepositoryInterface, lj lifecycleJob, now time.Time) (jobs.Result, error) {
+	today := calendarDay(now)
+	cutoff := today
+	if lj.Inclusive {
+		cutoff = today.AddDate(0, 0, 1)
+	}
+
+	candidates, err := repo.GetForLifecycleUpdate(ctx, lj.From, lj.DateColumn, cutoff)
+	if err != nil {
+		return jobs.Result{}, err
+	}
+
+	moved := 0
+	var lastErr error
+	for i := range candidates {
+		league := &candidates[i]
+		if league.ID == nil || scheduledLifecycle(league, today) != lj.Target {
+			continue
+		}
+
+		if err := moveLifecycle(ctx, repo, league, lj.Target, now, lj.Note); err != nil {
+			slog.Error("failed to update league lifecycle", "job", lj.Name, "leagueID", *league.ID, "err", err)
+			lastErr = err
+			continue
+		}
+		moved++
+	}
+
+	if moved == 0 && lastErr != nil {
+		return jobs.Result{}, lastErr
+	}
+	return jobs.Result{Affected: moved, Message: fmt.Sprintf("moved %d leagues to %s", moved, lj.Target)}, nil
+}
+
+// moveLifecycle sets a league's lifecycle state and records a revision attributed to the system
+func moveLifecycle(ctx context.Context, repo RepositoryInterface, league *League, to LifecycleStatus, now time.Time, note string) error {
+	from := league.LifecycleStatus
+	if from == "" {
+		from = LifecycleRegistrationOpen
+	}
+	if !CanReach(from, to) {
+		return fmt.Errorf("league cannot move from %s to %s", from, to)
+	}
+
+	updateData := map[string]interface{}{
+		"lifecycle_status":     to.String(),
+		"lifecycle_updated_at": now,
+	}
+	if err := repo.UpdateFieldsByUUID(ctx, *league.ID, updateData); err != nil {
+		return err
+	}
+
+	league.LifecycleStatus = to
+	updatedAt := Timestamp{Time: now}
+	league.LifecycleUpdatedAt = &updatedAt
+	revision := &LeagueRevision{
+		LeagueID:      *league.ID,
+		Action:        RevisionLifecycle,
+		Status:        league.Status,
+		Snapshot:      revisionSnapshot(league),
+		ChangedFields: []string{"lifecycle_status"},
+		Note:          &note,
+	}
+	if err := repo.CreateRevision(ctx, revision); err != nil {
+		slog.Error("failed to record league revision", "leagueID", *league.ID, "action", RevisionLifecycle, "err", err)
+	}
+
+	return nil
+}
+
+// scheduledLifecycle returns the lifecycle state a league should be in on the given day based on its dates
+// Manually set states (full, cancelled) are kept until a later date moves the league past them
+func scheduledLifecycle(league *League, today time.Time) LifecycleStatus {
+	current := league.LifecycleStatus
+	if current == "" {
+		current = LifecycleRegistrationOpen
+	}
+	if current.IsTerminal() {
+		return current
+	}
+
+	if league.SeasonEndDate != nil && calendarDay(league.SeasonEndDate.Time).Before(today) {
+		return LifecycleCompleted
+	}
+	if current == LifecycleInSeason {
+		return current
+	}
+	if league.SeasonStartDate != nil && !calendarDay(league.SeasonStartDate.Time).After(today) {
+		return LifecycleInSeason
+	}
+	if current == LifecycleRegistrationClosed {
+		return current
+	}
+	if league.RegistrationDeadline != nil && calendarDay(league.RegistrationDeadline.Time).Before(today) {
+		return LifecycleRegistrationClosed
+	}
+	return current
+}
+
+// calendarDay truncates a time to midnight UTC of its calendar date
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,68 @@
+package leagues
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduledLifecycle(t *testing.T) {
+	date := func(value string) *Date {
+		parsed, _ := time.Parse("2006-01-02", value)
+		return &Date{Time: parsed}
+	}
+	league := func(state LifecycleStatus) *League {
+		return &League{
+			LifecycleStatus:      state,
+			RegistrationDeadline: date("2025-03-01"),
+			SeasonStartDate:      date("2025-03-15"),
+			SeasonEndDate:        date("2025-05-31"),
+		}
+	}
+
+	tests := []struct {
+		name  string
+		state LifecycleStatus
+		today string
+		want  LifecycleStatus
+	}{
+		{"open before deadline", LifecycleRegistrationOpen, "2025-02-20", LifecycleRegistrationOpen},
+		{"open on deadline day", LifecycleRegistrationOpen, "2025-03-01", LifecycleRegistrationOpen},
+		{"open after deadline", LifecycleRegistrationOpen, "2025-03-02", LifecycleRegistrationClosed},
+		{"full after deadline", LifecycleFull, "2025-03-02", LifecycleRegistrationClosed},
+		{"closed on start day", LifecycleRegistrationClosed, "2025-03-15", LifecycleInSeason},
+		{"open on start day", LifecycleRegistrationOpen, "2025-03-15", LifecycleInSeason},
+		{"in season on end day", LifecycleInSeason, "2025-05-31", LifecycleInSeason},
+		{"in season after end", LifecycleInSeason, "2025-06-01", LifecycleCompleted},
+		{"open years later", LifecycleRegistrationOpen, "2028-01-01", LifecycleCompleted},
+		{"cancelled stays cancelled", LifecycleCancelled, "2028-01-01", LifecycleCancelled},
+		{"unset treated as open", "", "2025-03-02", LifecycleRegistrationClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The clock time of day must not matter
+			now, _ := time.Parse("2006-01-02 15:04", tt.today+" 23:30")
+			got := scheduledLifecycle(league(tt.state), calendarDay(now))
+			if got != tt.want {
+				t.Errorf("scheduledLifecycle(%s, %s) = %s, want %s", tt.state, tt.today, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduledLifecycleMissingDates(t *testing.T) {
+	today := calendarDay(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	if got := scheduledLifecycle(&League{LifecycleStatus: LifecycleFull}, today); got != LifecycleFull {
+		t.Errorf("expected a league without dates to keep its state, got %s", got)
+	}
+}
+
+func TestLifecycleJobsReachTargets(t *testing.T) {
+	for _, lj := range lifecycleJobs {
+		for _, from := range lj.From {
+			if !CanReach(from, lj.Target) {
+				t.Errorf("job %s: %s cannot reach %s", lj.Name, from, lj.Target)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package leagues
+
+import (
+	"fmt"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// LifecycleStatus tracks where a league is in its season, separately from its moderation status
+type LifecycleStatus string
+
+const (
+	LifecycleRegistrationOpen   LifecycleStatus = "registration_open"
+	LifecycleRegistrationClosed LifecycleStatus = "registration_closed"
+	LifecycleFull               LifecycleStatus = "full" // Registration is open on paper but no spots are left
+	LifecycleInSeason           LifecycleStatus = "in_season"
+	LifecycleCompleted          LifecycleStatus = "completed"
+	LifecycleCancelled          LifecycleStatus = "cancelled"
+)
+
+// IsValid checks if the lifecycle status is a valid league lifecycle status
+func (l LifecycleStatus) IsValid() bool {
+	_, ok := lifecycleTransitions[l]
+	return ok
+}
+
+// String returns the string representation of the lifecycle status
+func (l LifecycleStatus) String() string {
+	return string(l)
+}
+
+// IsTerminal reports whether no further transitions are allowed
+func (l LifecycleStatus) IsTerminal() bool {
+	return l.IsValid() && len(lifecycleTransitions[l]) == 0
+}
+
+// lifecycleTransitions lists the states each lifecycle state can move to
+var lifecycleTransitions = map[LifecycleStatus][]LifecycleStatus{
+	LifecycleRegistrationOpen:   {LifecycleRegistrationClosed, LifecycleFull, LifecycleInSeason, LifecycleCancelled},
+	LifecycleFull:               {LifecycleRegistrationOpen, LifecycleRegistrationClosed, LifecycleInSeason, LifecycleCancelled},
+	LifecycleRegistrationClosed: {LifecycleRegistrationOpen, LifecycleInSeason, LifecycleCancelled},
+	LifecycleInSeason:           {LifecycleCompleted, LifecycleCancelled},
+	LifecycleCompleted:          {},
+	LifecycleCancelled:          {},
+}
+
+// LifecycleStatuses lists every lifecycle status in season order
+var LifecycleStatuses = []LifecycleStatus{
+	LifecycleRegistrationOpen,
+	LifecycleFull,
+	LifecycleRegistrationClosed,
+	LifecycleInSeason,
+	LifecycleCompleted,
+	LifecycleCancelled,
+}
+
+// publicLifecycleStatuses are the states shown on the public listing unless a lifecycle filter is given
+var publicLifecycleStatuses = []LifecycleStatus{
+	LifecycleRegistrationOpen,
+	LifecycleFull,
+	LifecycleRegistrationClosed,
+	LifecycleInSeason,
+}
+
+// CanTransition reports whether a league may move from one lifecycle state to another
+func CanTransition(from, to LifecycleStatus) bool {
+	for _, next := range lifecycleTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// CanReach reports whether a league can get from one lifecycle state to another through allowed transitions
+func CanReach(from, to LifecycleStatus) bool {
+	seen := map[LifecycleStatus]bool{from: true}
+	queue := []LifecycleStatus{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range lifecycleTransitions[current] {
+			if next == to {
+				return true
+			}
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
+
+// ValidateTransition returns an error describing why a lifecycle transition is not allowed
+func ValidateTransition(from, to LifecycleStatus) error {
+	if !to.IsValid() {
+		return shared.BadRequest("invalid lifecycle status: %s", to)
+	}
+	if !from.IsValid() {
+		return fmt.Errorf("invalid current lifecycle status: %s", from)
+	}
+	if from == to {
+		return shared.Conflict("league is already %s", to)
+	}
+	if from.IsTerminal() {
+		return shared.Conflict("league is %s and can no longer change", from)
+	}
+	if !CanTransition(from, to) {
+		return shared.Conflict("league cannot move from %s to %s", from, to)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package leagues
+
+import "testing"
+
+func TestValidateTransition(t *testing.T) {
+	tests := []struct {
+		from    LifecycleStatus
+		to      LifecycleStatus
+		wantErr bool
+	}{
+		{LifecycleRegistrationOpen, LifecycleFull, false},
+		{LifecycleRegistrationOpen, LifecycleCancelled, false},
+		{LifecycleFull, LifecycleRegistrationOpen, false},
+		{LifecycleRegistrationClosed, LifecycleInSeason, false},
+		{LifecycleInSeason, LifecycleCompleted, false},
+		{LifecycleInSeason, LifecycleCancelled, false},
+		{LifecycleRegistrationOpen, LifecycleRegistrationOpen, true},
+		{LifecycleRegistrationOpen, LifecycleCompleted, true},
+		{LifecycleInSeason, LifecycleFull, true},
+		{LifecycleInSeason, LifecycleRegistrationOpen, true},
+		{LifecycleCompleted, LifecycleRegistrationOpen, true},
+		{LifecycleCancelled, LifecycleRegistrationOpen, true},
+		{LifecycleRegistrationOpen, LifecycleStatus("archived"), true},
+		{LifecycleStatus(""), LifecycleFull, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			err := ValidateTransition(tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTransition(%q, %q) error = %v, wantErr %v", tt.from, tt.to, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLifecycleStatusesCovered(t *testing.T) {
+	if len(LifecycleStatuses) != len(lifecycleTransitions) {
+		t.Fatalf("LifecycleStatuses has %d entries, lifecycleTransitions has %d", len(LifecycleStatuses), len(lifecycleTransitions))
+	}
+	for _, state := range LifecycleStatuses {
+		if !state.IsValid() {
+			t.Errorf("%q has no transitions entry", state)
+		}
+	}
+	if !LifecycleCompleted.IsTerminal() || !LifecycleCancelled.IsTerminal() || LifecycleInSeason.IsTerminal() {
+		t.Errorf("expected only completed and cancelled to be terminal")
+	}
+}
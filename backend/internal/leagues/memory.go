@@ -0,0 +1,842 @@
+package leagues
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/shared"
+	"github.com/leaguefindr/backend/internal/sports"
+	"github.com/leaguefindr/backend/internal/venues"
+)
+
+// MemoryRepository is a thread-safe in-memory RepositoryInterface used in tests and local development
+// It behaves like the database: leagues get UUIDs, drafts and templates share one table split by type,
+// and revision numbers count up per league. Rows are copied in and out so callers can't change stored data
+type MemoryRepository struct {
+	mu               sync.RWMutex
+	leagues          []League
+	revisions        []LeagueRevision
+	exceptions       []ScheduleException
+	comments         []LeagueComment
+	policyRules      []PolicyRule
+	drafts           []LeagueDraft
+	nextRevisionID   int64
+	nextCommentID    int64
+	nextPolicyRuleID int64
+	nextExceptionID  int64
+	nextDraftID      int
+	sports           sports.RepositoryInterface // Sports and venues created by approvals
+	venues           venues.RepositoryInterface
+}
+
+// NewMemoryRepository creates an empty in-memory repository
+// Approvals create submitted sports and venues in sportsRepo and venuesRepo, usually memory repositories too
+func NewMemoryRepository(sportsRepo sports.RepositoryInterface, venuesRepo venues.RepositoryInterface) *MemoryRepository {
+	return &MemoryRepository{
+		sports:           sportsRepo,
+		venues:           venuesRepo,
+		nextRevisionID:   1,
+		nextExceptionID:  1,
+		nextCommentID:    1,
+		nextPolicyRuleID: 1,
+		nextDraftID:      1,
+	}
+}
+
+// ============= LEAGUE METHODS =============
+
+// GetAll retrieves all leagues regardless of status
+func (m *MemoryRepository) GetAll(ctx context.Context) ([]League, error) {
+	return m.findLeagues(func(league *League) bool { return true }), nil
+}
+
+// GetAllApproved retrieves all approved leagues
+func (m *MemoryRepository) GetAllApproved(ctx context.Context) ([]League, error) {
+	return m.findLeagues(func(league *League) bool { return league.Status == LeagueStatusApproved }), nil
+}
+
+// GetBatchAfterID retrieves up to limit leagues matching the filter with an ID greater than afterID, in ID order
+func (m *MemoryRepository) GetBatchAfterID(ctx context.Context, afterID string, limit int, filter LeagueFilter) ([]League, error) {
+	leagues := m.findLeagues(func(league *League) bool {
+		return league.ID != nil && *league.ID > afterID && matchesLeagueFilter(league, filter)
+	})
+	sort.SliceStable(leagues, func(i, j int) bool { return *leagues[i].ID < *leagues[j].ID })
+	if len(leagues) > limit {
+		leagues = leagues[:limit]
+	}
+	return leagues, nil
+}
+
+// GetApprovedBySeriesID retrieves every approved season of a league series, newest season first
+func (m *MemoryRepository) GetApprovedBySeriesID(ctx context.Context, seriesID string) ([]League, error) {
+	leagues := m.findLeagues(func(league *League) bool {
+		if league.Status != LeagueStatusApproved {
+			return false
+		}
+		return (league.SeriesID != nil && *league.SeriesID == seriesID) || (league.ID != nil && *league.ID == seriesID)
+	})
+	sortByDate(leagues, func(league League) *Date { return league.SeasonStartDate }, true)
+	return leagues, nil
+}
+
+// GetApprovedByVenueID retrieves every approved league played at a venue
+func (m *MemoryRepository) GetApprovedByVenueID(ctx context.Context, venueID int64) ([]League, error) {
+	leagues := m.findLeagues(func(league *League) bool {
+		return league.Status == LeagueStatusApproved && league.VenueID != nil && *league.VenueID == venueID
+	})
+	sortByDate(leagues, func(league League) *Date { return league.SeasonStartDate }, false)
+	return leagues, nil
+}
+
+// GetDuplicateCandidates retrieves the leagues of any status sharing the organization, sport or venue
+func (m *MemoryRepository) GetDuplicateCandidates(ctx context.Context, orgID string, sportID *int64, venueID *int64) ([]League, error) {
+	return m.findLeagues(func(league *League) bool {
+		return (league.OrgID != nil && *league.OrgID == orgID) ||
+			(sportID != nil && league.SportID != nil && *league.SportID == *sportID) ||
+			(venueID != nil && league.VenueID != nil && *league.VenueID == *venueID)
+	}), nil
+}
+
+// GetAllApprovedWithPagination retrieves a page of approved leagues matching the filter
+// Returns up to page.Limit+1 rows (see pagination.Trim) and the total number of matches
+func (m *MemoryRepository) GetAllApprovedWithPagination(ctx context.Context, filter LeagueFilter, page pagination.Params) ([]League, int64, error) {
+	return m.leaguesPage(page, func(league *League) bool {
+		return league.Status == LeagueStatusApproved && matchesLeagueFilter(league, filter)
+	})
+}
+
+// GetAllApprovedFiltered retrieves every approved league matching the filter without pagination
+func (m *MemoryRepository) GetAllApprovedFiltered(ctx context.Context, filter LeagueFilter) ([]League, error) {
+	return m.findLeagues(func(league *League) bool {
+		return league.Status == LeagueStatusApproved && matchesLeagueFilter(league, filter)
+	}), nil
+}
+
+// GetApprovedFacetRows retrieves the facet columns of every approved league matching the filter
+func (m *MemoryRepository) GetApprovedFacetRows(ctx context.Context, filter LeagueFilter) ([]leagueFacetRow, error) {
+	leagues, _ := m.GetAllApprovedFiltered(ctx, filter)
+	rows := make([]leagueFacetRow, len(leagues))
+	for i := range leagues {
+		rows[i] = leagueFacetRow{
+			SportID:  leagues[i].SportID,
+			Gender:   leagues[i].Gender,
+			GameDays: leagueGameDays(&leagues[i]),
+		}
+		if sportName, ok := leagues[i].FormData["sport_name"].(string); ok {
+			rows[i].SportName = &sportName
+		}
+	}
+	return rows, nil
+}
+
+// GetByID retrieves a league by ID
+// Deprecated: Use GetByUUID instead
+func (m *MemoryRepository) GetByID(ctx context.Context, id int) (*League, error) {
+	return m.GetByUUID(ctx, strconv.Itoa(id))
+}
+
+// GetByUUID retrieves a league by UUID
+func (m *MemoryRepository) GetByUUID(ctx context.Context, id string) (*League, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	league := m.league(id)
+	if league == nil {
+		return nil, shared.NotFound("league not found")
+	}
+	copied := copyRow(*league)
+	return &copied, nil
+}
+
+// GetByOrgID retrieves all leagues for an organization (all statuses)
+func (m *MemoryRepository) GetByOrgID(ctx context.Context, orgID string) ([]League, error) {
+	return m.findLeagues(func(league *League) bool {
+		return league.OrgID != nil && *league.OrgID == orgID
+	}), nil
+}
+
+// GetByOrgIDAndStatus retrieves leagues for an organization filtered by status
+func (m *MemoryRepository) GetByOrgIDAndStatus(ctx context.Context, orgID string, status LeagueStatus) ([]League, error) {
+	return m.findLeagues(func(league *League) bool {
+		return league.OrgID != nil && *league.OrgID == orgID && league.Status == status
+	}), nil
+}
+
+// Create stores a new league, filling in its ID and timestamps
+func (m *MemoryRepository) Create(ctx context.Context, league *League) error {
+	now := time.Now()
+	id := uuid.New().String()
+	league.ID = &id
+	league.CreatedAt = Timestamp{Time: now}
+	league.UpdatedAt = Timestamp{Time: now}
+	league.SubmittedAt = &Timestamp{Time: now}
+	if league.LifecycleStatus == "" {
+		league.LifecycleStatus = LifecycleRegistrationOpen
+	}
+
+	stored := copyRow(*league)
+	stored.DistanceKm = nil
+	stored.Relevance = nil
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.leagues = append(m.leagues, stored)
+	return nil
+}
+
+// GetPending retrieves all league submissions awaiting review, including pending edits of approved leagues
+func (m *MemoryRepository) GetPending(ctx context.Context) ([]League, error) {
+	return m.findLeagues(awaitingReview), nil
+}
+
+// GetPendingWithPagination retrieves a page of leagues awaiting review that pass the queue filter
+func (m *MemoryRepository) GetPendingWithPagination(ctx context.Context, filter ReviewQueueFilter, page pagination.Params) ([]League, int64, error) {
+	return m.leaguesPage(page, func(league *League) bool {
+		return awaitingReview(league) && matchesReviewQueueFilter(league, filter)
+	})
+}
+
+// GetOverdueReviews retrieves the leagues awaiting review since before submittedBefore that admins have not been told about
+func (m *MemoryRepository) GetOverdueReviews(ctx context.Context, submittedBefore time.Time) ([]League, error) {
+	return m.findLeagues(func(league *League) bool {
+		return awaitingReview(league) && league.ReviewEscalatedAt == nil && queuedAt(league).Before(submittedBefore)
+	}), nil
+}
+
+// ClaimLeague gives userID the review claim on a league until expiresAt
+// The claim is only taken if nobody else holds one that is still active at now; returns false otherwise
+func (m *MemoryRepository) ClaimLeague(ctx context.Context, id string, userID string, now time.Time, expiresAt time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	league := m.league(id)
+	if league == nil {
+		return false, nil
+	}
+	if claimant := activeClaimant(league, now); claimant != "" && claimant != userID {
+		return false, nil
+	}
+	claimedBy := userID
+	league.ClaimedBy = &claimedBy
+	league.ClaimExpiresAt = &Timestamp{Time: expiresAt}
+	return true, nil
+}
+
+// GetAllWithPagination retrieves a page of leagues regardless of status
+func (m *MemoryRepository) GetAllWithPagination(ctx context.Context, page pagination.Params) ([]League, int64, error) {
+	return m.leaguesPage(page, func(league *League) bool { return true })
+}
+
+// UpdateStatus updates the status of a league
+func (m *MemoryRepository) UpdateStatus(ctx context.Context, id int, status LeagueStatus, rejectionReason *string) error {
+	return m.UpdateStatusByUUID(ctx, strconv.Itoa(id), status, rejectionReason, nil, nil)
+}
+
+// UpdateStatusByUUID updates a league status by UUID and optionally updates sport_id and venue_id
+// Like an UPDATE matching no rows, an unknown ID is not an error
+func (m *MemoryRepository) UpdateStatusByUUID(ctx context.Context, id string, status LeagueStatus, rejectionReason *string, sportID *int64, venueID *int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if league := m.league(id); league != nil {
+		league.Status = status
+		league.RejectionReason = copyRow(rejectionReason)
+		if sportID != nil {
+			league.SportID = copyRow(sportID)
+		}
+		if venueID != nil {
+			league.VenueID = copyRow(venueID)
+		}
+		league.UpdatedAt = Timestamp{Time: time.Now()}
+	}
+	return nil
+}
+
+// UpdateLeague updates the sport and venue of an existing league
+func (m *MemoryRepository) UpdateLeague(ctx context.Context, league *League) error {
+	if league.ID == nil {
+		return fmt.Errorf("league ID is required")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if stored := m.league(*league.ID); stored != nil {
+		stored.SportID = copyRow(league.SportID)
+		stored.VenueID = copyRow(league.VenueID)
+		stored.UpdatedAt = Timestamp{Time: time.Now()}
+	}
+	return nil
+}
+
+// UpdateFieldsByUUID updates the given columns of a league by UUID
+// Keys must be leagues column names; updated_at is always set
+func (m *MemoryRepository) UpdateFieldsByUUID(ctx context.Context, id string, data map[string]interface{}) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	league := m.league(id)
+	if league == nil {
+		return nil
+	}
+
+	// Columns are the League JSON names, so the update is applied by overlaying them on the encoded row
+	row := make(map[string]interface{})
+	encoded, err := json.Marshal(league)
+	if err == nil {
+		err = json.Unmarshal(encoded, &row)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update league: %w", err)
+	}
+	for column, value := range data {
+		row[column] = value
+	}
+	row["updated_at"] = time.Now()
+
+	var updated League
+	encoded, err = json.Marshal(row)
+	if err == nil {
+		err = json.Unmarshal(encoded, &updated)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update league: %w", err)
+	}
+
+	*league = updated
+	return nil
+}
+
+// ApproveLeague finds or creates the sport and venue and updates the league
+// Game times stay in form_data; there is no separate game_occurrences table to fill in memory
+func (m *MemoryRepository) ApproveLeague(ctx context.Context, id string, approval *LeagueApproval) error {
+	league, err := m.GetByUUID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkApprovable(league, approval.ReviewedBy, time.Now()); err != nil {
+		return err
+	}
+
+	if err := resolveApprovalEntities(ctx, approval, m.sports, m.venues); err != nil {
+		return err
+	}
+
+	return m.UpdateFieldsByUUID(ctx, id, approvalColumns(approval))
+}
+
+// GetForLifecycleUpdate retrieves leagues in one of the given lifecycle states whose date column is before cutoff
+func (m *MemoryRepository) GetForLifecycleUpdate(ctx context.Context, states []LifecycleStatus, dateColumn string, cutoff time.Time) ([]League, error) {
+	day := cutoff.Format("2006-01-02")
+	return m.findLeagues(func(league *League) bool {
+		date := leagueDate(league, dateColumn)
+		return slices.Contains(states, league.LifecycleStatus) && date != nil && date.Format("2006-01-02") < day
+	}), nil
+}
+
+// ============= REVISION METHODS =============
+
+// CreateRevision stores a league revision, numbering it after the league's latest revision
+func (m *MemoryRepository) CreateRevision(ctx context.Context, revision *LeagueRevision) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	number := 1
+	for _, existing := range m.revisions {
+		if existing.LeagueID == revision.LeagueID && existing.RevisionNumber >= number {
+			number = existing.RevisionNumber + 1
+		}
+	}
+
+	revision.ID = m.nextRevisionID
+	revision.RevisionNumber = number
+	revision.CreatedAt = Timestamp{Time: time.Now()}
+	m.nextRevisionID++
+	m.revisions = append(m.revisions, copyRow(*revision))
+	return nil
+}
+
+// GetRevisionsByLeagueID retrieves all revisions of a league, oldest first
+func (m *MemoryRepository) GetRevisionsByLeagueID(ctx context.Context, leagueID string) ([]LeagueRevision, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var revisions []LeagueRevision
+	for _, revision := range m.revisions {
+		if revision.LeagueID == leagueID {
+			revisions = append(revisions, copyRow(revision))
+		}
+	}
+	sort.SliceStable(revisions, func(i, j int) bool { return revisions[i].RevisionNumber < revisions[j].RevisionNumber })
+	return revisions, nil
+}
+
+// GetReviewEvents retrieves the revisions with the given actions recorded since a time, oldest first
+// Snapshots are not loaded
+func (m *MemoryRepository) GetReviewEvents(ctx context.Context, actions []RevisionAction, since time.Time) ([]LeagueRevision, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var revisions []LeagueRevision
+	for _, revision := range m.revisions {
+		if containsAction(actions, revision.Action) && !revision.CreatedAt.Before(since) {
+			event := copyRow(revision)
+			event.Snapshot = nil
+			revisions = append(revisions, event)
+		}
+	}
+	return revisions, nil
+}
+
+// ============= COMMENT METHODS =============
+
+// CreateComment stores a comment in a league's review thread, filling in its ID and creation time
+func (m *MemoryRepository) CreateComment(ctx context.Context, comment *LeagueComment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	comment.ID = m.nextCommentID
+	comment.CreatedAt = Timestamp{Time: time.Now()}
+	if comment.Fields == nil {
+		comment.Fields = []string{}
+	}
+	m.nextCommentID++
+	m.comments = append(m.comments, copyRow(*comment))
+	return nil
+}
+
+// GetCommentsByLeagueID retrieves a league's review thread, oldest first
+func (m *MemoryRepository) GetCommentsByLeagueID(ctx context.Context, leagueID string) ([]LeagueComment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var comments []LeagueComment
+	for _, comment := range m.comments {
+		if comment.LeagueID == leagueID {
+			comments = append(comments, copyRow(comment))
+		}
+	}
+	return comments, nil
+}
+
+// ============= POLICY RULE METHODS =============
+
+// GetPolicyRules retrieves the auto-approval policy rules in the order they're tried
+func (m *MemoryRepository) GetPolicyRules(ctx context.Context) ([]PolicyRule, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	rules := make([]PolicyRule, len(m.policyRules))
+	for i, rule := range m.policyRules {
+		rules[i] = copyRow(rule)
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Priority != rules[j].Priority {
+			return rules[i].Priority < rules[j].Priority
+		}
+		return rules[i].ID < rules[j].ID
+	})
+	return rules, nil
+}
+
+// CreatePolicyRule stores a policy rule, filling in its ID and timestamps
+func (m *MemoryRepository) CreatePolicyRule(ctx context.Context, rule *PolicyRule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rule.ID = m.nextPolicyRuleID
+	rule.CreatedAt = Timestamp{Time: time.Now()}
+	rule.UpdatedAt = rule.CreatedAt
+	m.nextPolicyRuleID++
+	m.policyRules = append(m.policyRules, copyRow(*rule))
+	return nil
+}
+
+// UpdatePolicyRule replaces a policy rule's settings, filling in its stored fields
+func (m *MemoryRepository) UpdatePolicyRule(ctx context.Context, rule *PolicyRule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.policyRules {
+		if m.policyRules[i].ID == rule.ID {
+			rule.CreatedBy = m.policyRules[i].CreatedBy
+			rule.CreatedAt = m.policyRules[i].CreatedAt
+			rule.UpdatedAt = Timestamp{Time: time.Now()}
+			m.policyRules[i] = copyRow(*rule)
+			return nil
+		}
+	}
+	return shared.NotFound("policy rule not found")
+}
+
+// DeletePolicyRule removes a policy rule
+func (m *MemoryRepository) DeletePolicyRule(ctx context.Context, id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, rule := range m.policyRules {
+		if rule.ID == id {
+			m.policyRules = slices.Delete(m.policyRules, i, i+1)
+			return nil
+		}
+	}
+	return shared.NotFound("policy rule not found")
+}
+
+// ============= SCHEDULE EXCEPTION METHODS =============
+
+// GetScheduleExceptions retrieves a league's blackout dates and game changes, by date
+func (m *MemoryRepository) GetScheduleExceptions(ctx context.Context, leagueID string) ([]ScheduleException, error) {
+	return m.GetScheduleExceptionsByLeagueIDs(ctx, []string{leagueID})
+}
+
+// GetScheduleExceptionsByLeagueIDs retrieves the schedule exceptions of several leagues at once, by date
+func (m *MemoryRepository) GetScheduleExceptionsByLeagueIDs(ctx context.Context, leagueIDs []string) ([]ScheduleException, error) {
+	if len(leagueIDs) == 0 {
+		return nil, nil
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var exceptions []ScheduleException
+	for _, exception := range m.exceptions {
+		if slices.Contains(leagueIDs, exception.LeagueID) {
+			exceptions = append(exceptions, copyRow(exception))
+		}
+	}
+	sort.SliceStable(exceptions, func(i, j int) bool { return exceptions[i].GameDate.Before(exceptions[j].GameDate.Time) })
+	return exceptions, nil
+}
+
+// CreateScheduleException stores a schedule exception, filling in its ID and creation time
+func (m *MemoryRepository) CreateScheduleException(ctx context.Context, exception *ScheduleException) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	exception.ID = m.nextExceptionID
+	exception.CreatedAt = Timestamp{Time: time.Now()}
+	m.nextExceptionID++
+	m.exceptions = append(m.exceptions, copyRow(*exception))
+	return nil
+}
+
+// DeleteScheduleException removes one of a league's schedule exceptions
+func (m *MemoryRepository) DeleteScheduleException(ctx context.Context, leagueID string, exceptionID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, exception := range m.exceptions {
+		if exception.ID == exceptionID && exception.LeagueID == leagueID {
+			m.exceptions = slices.Delete(m.exceptions, i, i+1)
+			return nil
+		}
+	}
+	return shared.NotFound("schedule exception not found")
+}
+
+// ============= DRAFT METHODS =============
+
+// GetDraftByOrgID retrieves the first draft or template of an organization, or nil when there is none
+func (m *MemoryRepository) GetDraftByOrgID(ctx context.Context, orgID string) (*LeagueDraft, error) {
+	drafts := m.findDrafts(func(draft *LeagueDraft) bool { return draft.OrgID == orgID })
+	if len(drafts) == 0 {
+		return nil, nil
+	}
+	return &drafts[0], nil
+}
+
+// GetDraftByID retrieves a draft or template by its ID
+func (m *MemoryRepository) GetDraftByID(ctx context.Context, draftID int) (*LeagueDraft, error) {
+	drafts := m.findDrafts(func(draft *LeagueDraft) bool { return draft.ID == draftID })
+	if len(drafts) == 0 {
+		return nil, shared.NotFound("draft not found")
+	}
+	return &drafts[0], nil
+}
+
+// SaveDraft updates the form data of an existing draft, or inserts a new draft or template when it has no ID
+func (m *MemoryRepository) SaveDraft(ctx context.Context, draft *LeagueDraft) error {
+	now := Timestamp{Time: time.Now()}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if draft.ID > 0 {
+		if stored := m.draft(draft.ID, draft.OrgID, DraftTypeDraft); stored != nil {
+			stored.FormData = copyRow(draft.FormData)
+			stored.UpdatedAt = now
+		}
+		return nil
+	}
+
+	draft.ID = m.nextDraftID
+	if draft.Type == "" {
+		draft.Type = DraftTypeDraft
+	}
+	draft.CreatedAt = now
+	draft.UpdatedAt = now
+	m.nextDraftID++
+	m.drafts = append(m.drafts, copyRow(*draft))
+	return nil
+}
+
+// DeleteDraftByID deletes a draft (not a template) by ID for a specific organization
+func (m *MemoryRepository) DeleteDraftByID(ctx context.Context, draftID int, orgID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.drafts = slices.DeleteFunc(m.drafts, func(draft LeagueDraft) bool {
+		return draft.ID == draftID && draft.OrgID == orgID && draft.Type == DraftTypeDraft
+	})
+	return nil
+}
+
+// DeleteStaleDrafts deletes drafts (not templates) last updated before cutoff
+func (m *MemoryRepository) DeleteStaleDrafts(ctx context.Context, cutoff time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	before := len(m.drafts)
+	m.drafts = slices.DeleteFunc(m.drafts, func(draft LeagueDraft) bool {
+		return draft.Type == DraftTypeDraft && draft.UpdatedAt.Before(cutoff)
+	})
+	return before - len(m.drafts), nil
+}
+
+// GetAllDrafts retrieves every draft and template across all organizations
+func (m *MemoryRepository) GetAllDrafts(ctx context.Context) ([]LeagueDraft, error) {
+	return m.findDrafts(func(draft *LeagueDraft) bool { return true }), nil
+}
+
+// GetDraftsByOrgID retrieves all drafts for a specific organization
+func (m *MemoryRepository) GetDraftsByOrgID(ctx context.Context, orgID string) ([]LeagueDraft, error) {
+	return m.findDrafts(func(draft *LeagueDraft) bool {
+		return draft.OrgID == orgID && draft.Type == DraftTypeDraft
+	}), nil
+}
+
+// GetTemplatesByOrgID retrieves all templates for a specific organization
+func (m *MemoryRepository) GetTemplatesByOrgID(ctx context.Context, orgID string) ([]LeagueDraft, error) {
+	return m.findDrafts(func(draft *LeagueDraft) bool {
+		return draft.OrgID == orgID && draft.Type == DraftTypeTemplate
+	}), nil
+}
+
+// GetAllTemplates retrieves all templates across all organizations
+func (m *MemoryRepository) GetAllTemplates(ctx context.Context) ([]LeagueDraft, error) {
+	return m.findDrafts(func(draft *LeagueDraft) bool { return draft.Type == DraftTypeTemplate }), nil
+}
+
+// UpdateTemplate updates the name and form data of an organization's template
+func (m *MemoryRepository) UpdateTemplate(ctx context.Context, template *LeagueDraft) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := m.draft(template.ID, template.OrgID, DraftTypeTemplate)
+	if stored == nil {
+		return shared.NotFound("template not found or access denied")
+	}
+	stored.Name = copyRow(template.Name)
+	stored.FormData = copyRow(template.FormData)
+	stored.UpdatedAt = Timestamp{Time: time.Now()}
+	return nil
+}
+
+// DeleteTemplate deletes one of an organization's templates
+func (m *MemoryRepository) DeleteTemplate(ctx context.Context, templateID int, orgID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.draft(templateID, orgID, DraftTypeTemplate) == nil {
+		return shared.NotFound("template not found or access denied")
+	}
+	m.drafts = slices.DeleteFunc(m.drafts, func(draft LeagueDraft) bool { return draft.ID == templateID })
+	return nil
+}
+
+// ============= HELPERS =============
+
+// league returns the stored league with the given ID, or nil; the caller must hold the lock
+func (m *MemoryRepository) league(id string) *League {
+	for i := range m.leagues {
+		if m.leagues[i].ID != nil && *m.leagues[i].ID == id {
+			return &m.leagues[i]
+		}
+	}
+	return nil
+}
+
+// draft returns the stored row of the given type, ID and organization, or nil; the caller must hold the lock
+func (m *MemoryRepository) draft(id int, orgID string, draftType DraftType) *LeagueDraft {
+	for i := range m.drafts {
+		if m.drafts[i].ID == id && m.drafts[i].OrgID == orgID && m.drafts[i].Type == draftType {
+			return &m.drafts[i]
+		}
+	}
+	return nil
+}
+
+// findLeagues returns copies of the leagues matching match, in insertion order
+func (m *MemoryRepository) findLeagues(match func(league *League) bool) []League {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	leagues := []League{}
+	for i := range m.leagues {
+		if match(&m.leagues[i]) {
+			leagues = append(leagues, copyRow(m.leagues[i]))
+		}
+	}
+	return leagues
+}
+
+// findDrafts returns copies of the drafts and templates matching match, in ID order
+func (m *MemoryRepository) findDrafts(match func(draft *LeagueDraft) bool) []LeagueDraft {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	drafts := []LeagueDraft{}
+	for i := range m.drafts {
+		if match(&m.drafts[i]) {
+			drafts = append(drafts, copyRow(m.drafts[i]))
+		}
+	}
+	return drafts
+}
+
+// leaguesPage returns a page of the leagues matching match and the total number of matches
+func (m *MemoryRepository) leaguesPage(page pagination.Params, match func(league *League) bool) ([]League, int64, error) {
+	leagues := m.findLeagues(match)
+	window := pagination.Window(leagues, page,
+		func(league League) *string { return leagueSortKey(league, page.Sort.Column) },
+		func(league League) string { return *league.ID },
+	)
+	return window, int64(len(leagues)), nil
+}
+
+// matchesLeagueFilter reports whether a league passes the filter, the way applyLeagueFilter does in a query
+func matchesLeagueFilter(league *League, filter LeagueFilter) bool {
+	if filter.SportID != nil && (league.SportID == nil || *league.SportID != *filter.SportID) {
+		return false
+	}
+	if filter.Gender != nil && (league.Gender == nil || !strings.EqualFold(*league.Gender, *filter.Gender)) {
+		return false
+	}
+	if filter.Division != nil && (league.Division == nil || !strings.EqualFold(*league.Division, *filter.Division)) {
+		return false
+	}
+	if len(filter.Days) > 0 {
+		days := leagueGameDays(league)
+		if !slices.ContainsFunc(filter.Days, func(day string) bool { return slices.Contains(days, strings.ToLower(day)) }) {
+			return false
+		}
+	}
+	if filter.MinPrice != nil && (league.PricingPerPlayer == nil || *league.PricingPerPlayer < *filter.MinPrice) {
+		return false
+	}
+	if filter.MaxPrice != nil && (league.PricingPerPlayer == nil || *league.PricingPerPlayer > *filter.MaxPrice) {
+		return false
+	}
+	if !dateInRange(league.RegistrationDeadline, filter.DeadlineAfter, filter.DeadlineBefore) {
+		return false
+	}
+	if !dateInRange(league.SeasonStartDate, filter.SeasonStartAfter, filter.SeasonStartBefore) {
+		return false
+	}
+	if filter.VenueIDs != nil && (league.VenueID == nil || !slices.Contains(filter.VenueIDs, *league.VenueID)) {
+		return false
+	}
+	if filter.LeagueIDs != nil && (league.ID == nil || !slices.Contains(filter.LeagueIDs, *league.ID)) {
+		return false
+	}
+	if filter.OrgID != "" && (league.OrgID == nil || *league.OrgID != filter.OrgID) {
+		return false
+	}
+	if filter.Status != nil && league.Status != *filter.Status {
+		return false
+	}
+
+	lifecycle := filter.Lifecycle
+	if len(lifecycle) == 0 {
+		lifecycle = publicLifecycleStatuses
+	}
+	return slices.Contains(lifecycle, league.LifecycleStatus)
+}
+
+// dateInRange reports whether date is within the optional inclusive bounds; a NULL date never is
+func dateInRange(date *Date, after, before *time.Time) bool {
+	if after == nil && before == nil {
+		return true
+	}
+	if date == nil {
+		return false
+	}
+	day := date.Format("2006-01-02")
+	if after != nil && day < after.Format("2006-01-02") {
+		return false
+	}
+	return before == nil || day <= before.Format("2006-01-02")
+}
+
+// leagueGameDays returns the lowercase weekday names of a league's games, like the league_game_days column
+func leagueGameDays(league *League) []string {
+	var days []string
+	for _, occurrence := range occurrencesOf(league) {
+		day := strings.ToLower(occurrence.Day)
+		if !slices.Contains(days, day) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+// leagueDate returns the date column of a league by name, or nil
+func leagueDate(league *League, column string) *Date {
+	switch column {
+	case "registration_deadline":
+		return league.RegistrationDeadline
+	case "season_start_date":
+		return league.SeasonStartDate
+	case "season_end_date":
+		return league.SeasonEndDate
+	default:
+		return nil
+	}
+}
+
+// sortByDate orders leagues by a date, NULL dates last
+func sortByDate(leagues []League, date func(League) *Date, descending bool) {
+	sort.SliceStable(leagues, func(i, j int) bool {
+		a, b := date(leagues[i]), date(leagues[j])
+		if a == nil || b == nil {
+			return a != nil
+		}
+		if descending {
+			return a.After(b.Time)
+		}
+		return a.Before(b.Time)
+	})
+}
+
+// copyRow deep copies a value through JSON, the way a row read back from the database is a fresh value
+func copyRow[T any](value T) T {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var copied T
+	if err := json.Unmarshal(data, &copied); err != nil {
+		return value
+	}
+	return copied
+}
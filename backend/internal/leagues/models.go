@@ -3,6 +3,7 @@ package leagues
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/leaguefindr/backend/internal/shared"
@@ -16,15 +17,16 @@ type Timestamp = shared.Timestamp
 type LeagueStatus string
 
 const (
-	LeagueStatusPending  LeagueStatus = "pending"
-	LeagueStatusApproved LeagueStatus = "approved"
-	LeagueStatusRejected LeagueStatus = "rejected"
+	LeagueStatusPending          LeagueStatus = "pending"
+	LeagueStatusApproved         LeagueStatus = "approved"
+	LeagueStatusRejected         LeagueStatus = "rejected"
+	LeagueStatusChangesRequested LeagueStatus = "changes_requested" // Sent back to the organizer to fix and resubmit
 )
 
 // IsValid checks if the status is a valid league status
 func (l LeagueStatus) IsValid() bool {
 	switch l {
-	case LeagueStatusPending, LeagueStatusApproved, LeagueStatusRejected:
+	case LeagueStatusPending, LeagueStatusApproved, LeagueStatusRejected, LeagueStatusChangesRequested:
 		return true
 	default:
 		return false
@@ -66,6 +68,21 @@ type GameOccurrence struct {
 	EndTime   string `json:"endTime"`   // e.g., "21:00"
 }
 
+// Weekdays lists the valid values for GameOccurrence.Day in calendar order
+var Weekdays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// NormalizeWeekday returns the canonical weekday name (e.g. "monday" -> "Monday")
+// The second return value is false if the input is not a weekday
+func NormalizeWeekday(day string) (string, bool) {
+	trimmed := strings.TrimSpace(day)
+	for _, weekday := range Weekdays {
+		if strings.EqualFold(trimmed, weekday) {
+			return weekday, true
+		}
+	}
+	return "", false
+}
+
 // GameOccurrenceRow represents a row in the game_occurrences table
 type GameOccurrenceRow struct {
 	ID        int       `db:"id"`
@@ -140,6 +157,23 @@ type League struct {
 	UpdatedAt            Timestamp             `json:"updated_at"`   // TIMESTAMP column - uses custom Timestamp type
 	CreatedBy            *string               `json:"created_by"`   // UUID of the user who submitted it
 	RejectionReason      *string               `json:"rejection_reason"` // Reason for rejection if applicable
+	LifecycleStatus      LifecycleStatus       `json:"lifecycle_status"`            // Season state, separate from moderation status
+	LifecycleUpdatedAt   *Timestamp            `json:"lifecycle_updated_at"`
+	CancellationReason   *string               `json:"cancellation_reason"`
+	PreviousSeasonID     *string               `json:"previous_season_id"` // League this season was cloned from
+	SeriesID             *string               `json:"series_id"`          // First season of the chain, shared by all its seasons
+	PendingChanges       *League               `json:"pending_changes,omitempty"`    // Proposed edit to an approved league awaiting review
+	PendingChangesAt     *Timestamp            `json:"pending_changes_at,omitempty"` // When the pending edit was submitted
+	PendingChangesBy     *string               `json:"pending_changes_by,omitempty"` // Clerk user ID of the organizer who submitted it
+	DuplicateCandidates  []DuplicateCandidate  `json:"duplicate_candidates,omitempty"` // Likely duplicates found at submission, for review
+	DuplicateOfID        *string               `json:"duplicate_of_id,omitempty"`      // League this one was rejected as a duplicate of
+	SubmittedAt          *Timestamp            `json:"submitted_at,omitempty"`         // When the league last entered the review queue
+	ClaimedBy            *string               `json:"claimed_by,omitempty"`           // Admin reviewing the league, until ClaimExpiresAt
+	ClaimExpiresAt       *Timestamp            `json:"claim_expires_at,omitempty"`
+	ReviewEscalatedAt    *Timestamp            `json:"review_escalated_at,omitempty"` // When admins were told the review is overdue
+	PolicyDecision       *PolicyDecision       `json:"policy_decision,omitempty"`     // What the auto-approval policy decided at submission
+	DistanceKm           *float64              `json:"distance_km,omitempty"` // Distance from the searched point, not stored
+	Relevance            *float64              `json:"relevance,omitempty"`   // Text search score, not stored
 }
 
 // CreateLeagueRequest represents the request to create/submit a new league
@@ -168,9 +202,146 @@ type CreateLeagueRequest struct {
 	PerGameFee           *float64        `json:"per_game_fee"`
 }
 
-// ApproveLeagueRequest represents the request to approve a league submission
+// CancelLeagueRequest represents the request to cancel a league
+type CancelLeagueRequest struct {
+	Reason string `json:"reason" validate:"max=500"`
+}
+
+// CloneLeagueRequest represents the request to copy a league into a new season
+// Exactly one of OffsetDays and SeasonStartDate must be set
+type CloneLeagueRequest struct {
+	OffsetDays      *int    `json:"offset_days" validate:"omitempty,min=1,max=3650"` // Days to move every date forward
+	SeasonStartDate *string `json:"season_start_date"`                               // ISO 8601 date; other dates keep their distance from the start
+}
+
+// ImportRowStatus is the outcome of one spreadsheet row in a league import
+type ImportRowStatus string
+
+const (
+	ImportRowValid    ImportRowStatus = "valid"    // Passed validation (dry run)
+	ImportRowImported ImportRowStatus = "imported" // Created as a league
+	ImportRowFailed   ImportRowStatus = "failed"   // Not imported, see Errors
+)
+
+// ImportRowResult reports what happened to one spreadsheet row
+type ImportRowResult struct {
+	Row        int             `json:"row"` // Spreadsheet row number, the header being row 1
+	LeagueName *string         `json:"league_name,omitempty"`
+	Status     ImportRowStatus `json:"status"`
+	LeagueID   *string         `json:"league_id,omitempty"` // Set once imported
+	SportID    *int64          `json:"sport_id,omitempty"`  // Resolved from sport_name when it matches an existing sport
+	VenueID    *int64          `json:"venue_id,omitempty"`  // Resolved from venue_address/venue_name when it matches an existing venue
+	Errors     []string        `json:"errors,omitempty"`
+	Warnings   []string        `json:"warnings,omitempty"`
+
+	// Organizers' rows are screened like any submission
+	DuplicateCandidates []DuplicateCandidate `json:"duplicate_candidates,omitempty"`
+	PolicyDecision      *PolicyDecision      `json:"policy_decision,omitempty"`
+	LeagueStatus        LeagueStatus         `json:"league_status,omitempty"` // Set once imported; approved when the policy approved the row
+}
+
+// ImportLeaguesResponse is the per-row report of a league import
+type ImportLeaguesResponse struct {
+	DryRun   bool              `json:"dry_run"`
+	Total    int               `json:"total"`
+	Valid    int               `json:"valid"`
+	Imported int               `json:"imported"`
+	Failed   int               `json:"failed"`
+	Warnings []string          `json:"warnings,omitempty"` // Problems with the file as a whole, e.g. unknown columns
+	Rows     []ImportRowResult `json:"rows"`
+}
+
+// ScheduleExceptionKind is what a schedule exception does to a league's games
+type ScheduleExceptionKind string
+
+const (
+	ScheduleExceptionBlackout    ScheduleExceptionKind = "blackout"    // No games that day; doesn't count towards the duration
+	ScheduleExceptionCancelled   ScheduleExceptionKind = "cancelled"   // One game (or every game that day) is cancelled
+	ScheduleExceptionRescheduled ScheduleExceptionKind = "rescheduled" // One game (or every game that day) is moved
+)
+
+// ScheduleException is a blackout date or a change to a single game, stored in league_schedule_exceptions
+type ScheduleException struct {
+	ID           int64                 `json:"id"`
+	LeagueID     string                `json:"league_id"`
+	Kind         ScheduleExceptionKind `json:"kind"`
+	GameDate     Date                  `json:"game_date"`
+	StartTime    *string               `json:"start_time"` // HH:MM of the affected game, nil for the whole day
+	NewDate      *Date                 `json:"new_date"`
+	NewStartTime *string               `json:"new_start_time"`
+	NewEndTime   *string               `json:"new_end_time"`
+	Reason       *string               `json:"reason"`
+	CreatedBy    string                `json:"created_by"`
+	CreatedAt    Timestamp             `json:"created_at"`
+}
+
+// CreateScheduleExceptionRequest blacks out a date or cancels or reschedules the games on it
+// StartTime picks one game when there are several that day; a rescheduled game keeps its length unless NewEndTime is set
+type CreateScheduleExceptionRequest struct {
+	Kind         ScheduleExceptionKind `json:"kind" validate:"required,oneof=blackout cancelled rescheduled"`
+	Date         string                `json:"date" validate:"required,datetime=2006-01-02"`
+	StartTime    *string               `json:"start_time" validate:"omitempty,datetime=15:04"`
+	NewDate      *string               `json:"new_date" validate:"required_if=Kind rescheduled,omitempty,datetime=2006-01-02"`
+	NewStartTime *string               `json:"new_start_time" validate:"required_if=Kind rescheduled,omitempty,datetime=15:04"`
+	NewEndTime   *string               `json:"new_end_time" validate:"omitempty,datetime=15:04"`
+	Reason       *string               `json:"reason" validate:"omitempty,max=500"`
+}
+
+// ScheduledGame is one dated game of a league
+type ScheduledGame struct {
+	Week              int    `json:"week"` // Counts weeks with games, so blacked out weeks don't have a number
+	Date              string `json:"date"` // ISO 8601 date
+	Day               string `json:"day"`
+	StartTime         string `json:"start_time"`
+	EndTime           string `json:"end_time"`
+	Status            string `json:"status"`                        // scheduled, cancelled or rescheduled
+	OriginalDate      string `json:"original_date,omitempty"`       // Rescheduled games only
+	OriginalStartTime string `json:"original_start_time,omitempty"` // Rescheduled games only
+	Reason            string `json:"reason,omitempty"`
+}
+
+// SkippedGameDate is a game day left out of the schedule by a blackout or an organization holiday
+type SkippedGameDate struct {
+	Date      string `json:"date"`
+	Day       string `json:"day"`
+	StartTime string `json:"start_time"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// LeagueScheduleResponse is the expanded schedule of a league
+type LeagueScheduleResponse struct {
+	LeagueID   string              `json:"league_id"`
+	Duration   *int                `json:"duration"` // Weeks of games the league was created with
+	Weeks      int                 `json:"weeks"`    // Weeks of games in the schedule
+	Incomplete bool                `json:"incomplete"` // The season end date leaves fewer weeks than the duration
+	Games      []ScheduledGame     `json:"games"`
+	Skipped    []SkippedGameDate   `json:"skipped"`
+	Exceptions []ScheduleException `json:"exceptions"`
+}
+
+// ApproveLeagueRequest represents the optional body of an approval: corrections an admin makes as they approve
+// SportID and VenueID point the league at an existing sport or venue instead of creating the submitted one
 type ApproveLeagueRequest struct {
-	// No body needed, just the ID in the path
+	Changes FormData `json:"changes"` // League fields to correct, keyed like CreateLeagueRequest
+	SportID *int64   `json:"sport_id" validate:"omitempty,min=1"`
+	VenueID *int64   `json:"venue_id" validate:"omitempty,min=1"`
+}
+
+// HasCorrections reports whether the approval changes anything about the submission
+func (r *ApproveLeagueRequest) HasCorrections() bool {
+	return r != nil && (len(r.Changes) > 0 || r.SportID != nil || r.VenueID != nil)
+}
+
+// LeagueApproval is everything that changes when a league (or its pending edit) is approved
+// Repositories apply it as one unit; the database store runs it in a single transaction
+type LeagueApproval struct {
+	Fields          map[string]interface{} // League columns to set, keyed by column name
+	SportID         *int64                 // Set to the found or created sport when Sport is used
+	Sport           *SupplementalSport     // Found by name or created when SportID is nil
+	VenueID         *int64                 // Set to the found or created venue when Venue is used
+	Venue           *SupplementalVenue     // Found by address or created when VenueID is nil
+	GameOccurrences GameOccurrences        // Replace the league's game_occurrences rows
+	ReviewedBy      string                 // Admin approving; empty for approvals the policy makes on its own
 }
 
 // RejectLeagueRequest represents the request to reject a league submission
@@ -178,15 +349,368 @@ type RejectLeagueRequest struct {
 	RejectionReason string `json:"rejection_reason" validate:"required,min=1,max=500"`
 }
 
+// RequestChangesRequest represents the request to send a league submission back to its organizer
+// Fields optionally points at the league fields the message is about
+type RequestChangesRequest struct {
+	Message string   `json:"message" validate:"required,min=1,max=2000"`
+	Fields  []string `json:"fields" validate:"omitempty,max=30"`
+}
+
+// CommentAuthorRole is the side of the review a league comment comes from
+type CommentAuthorRole string
+
+const (
+	CommentAuthorAdmin     CommentAuthorRole = "admin"
+	CommentAuthorOrganizer CommentAuthorRole = "organizer"
+)
+
+// LeagueComment is one message in the review thread between admins and a league's organizers
+type LeagueComment struct {
+	ID         int64             `json:"id"`
+	LeagueID   string            `json:"league_id"`
+	AuthorID   string            `json:"author_id"` // Clerk user ID
+	AuthorRole CommentAuthorRole `json:"author_role"`
+	Body       string            `json:"body"`
+	Fields     []string          `json:"fields"`           // League fields the comment points at, may be empty
+	Status     LeagueStatus      `json:"status,omitempty"` // Moderation status the comment moved the league to, if any
+	CreatedAt  Timestamp         `json:"created_at"`
+}
+
+// CreateLeagueCommentRequest represents the request to add a comment to a league's review thread
+type CreateLeagueCommentRequest struct {
+	Body   string   `json:"body" validate:"required,min=1,max=2000"`
+	Fields []string `json:"fields" validate:"omitempty,max=30"`
+}
+
+// GetLeagueCommentsResponse represents the response when getting a league's review thread
+type GetLeagueCommentsResponse struct {
+	LeagueID string          `json:"league_id"`
+	Comments []LeagueComment `json:"comments"`
+}
+
 // CreateLeagueResponse represents the response when creating a league
 type CreateLeagueResponse struct {
-	League League `json:"league"`
+	League   League          `json:"league"`
+	Warnings []VenueConflict `json:"warnings,omitempty"` // Approved leagues booked at the same venue and time
+}
+
+// VenueConflict is another approved league playing at the same venue at an overlapping time in the same weeks
+type VenueConflict struct {
+	LeagueID   string `json:"league_id"`
+	LeagueName string `json:"league_name"`
+	OrgID      string `json:"org_id"`
+	Day        string `json:"day"`        // Day of the other league's overlapping slot
+	StartTime  string `json:"start_time"` // HH:MM
+	EndTime    string `json:"end_time"`   // HH:MM
+	FirstDate  string `json:"first_date"` // First date both leagues play in the slot
+	LastDate   string `json:"last_date"`  // Last week both leagues play in the slot
+	Message    string `json:"message"`
+}
+
+// DuplicateCandidate is an existing league that a submission likely duplicates
+type DuplicateCandidate struct {
+	LeagueID   string       `json:"league_id"`
+	LeagueName string       `json:"league_name"`
+	OrgID      string       `json:"org_id"`
+	Status     LeagueStatus `json:"status"`
+	Score      float64      `json:"score"`   // Between 0 and 1, higher is more alike
+	Reasons    []string     `json:"reasons"` // What the two leagues have in common
+}
+
+// GetDuplicatesResponse represents the response when checking a league for duplicates
+type GetDuplicatesResponse struct {
+	Duplicates []DuplicateCandidate `json:"duplicates"`
+}
+
+// MarkDuplicateRequest represents the request to reject a league as a duplicate of another
+type MarkDuplicateRequest struct {
+	DuplicateOfID string `json:"duplicate_of_id" validate:"required,uuid"`
+}
+
+// BulkReviewAction is the decision a bulk review applies to every league
+type BulkReviewAction string
+
+const (
+	BulkReviewApprove BulkReviewAction = "approve"
+	BulkReviewReject  BulkReviewAction = "reject"
+)
+
+// BulkReviewRequest represents the request to approve or reject many leagues at once
+// RejectionReason is required to reject and is given for every league
+type BulkReviewRequest struct {
+	IDs             []string         `json:"ids" validate:"required,min=1,max=100,unique,dive,uuid"`
+	Action          BulkReviewAction `json:"action" validate:"required,oneof=approve reject"`
+	RejectionReason string           `json:"rejection_reason" validate:"max=500"`
+}
+
+// BulkReviewItemStatus is the outcome of one league in a bulk review
+type BulkReviewItemStatus string
+
+const (
+	BulkReviewApproved BulkReviewItemStatus = "approved"
+	BulkReviewRejected BulkReviewItemStatus = "rejected"
+	BulkReviewFailed   BulkReviewItemStatus = "failed" // Left as it was, see Code and Error
+)
+
+// BulkReviewResult reports what happened to one league of a bulk review
+type BulkReviewResult struct {
+	ID     string               `json:"id"`
+	Status BulkReviewItemStatus `json:"status"`
+	Code   shared.ErrorCode     `json:"code,omitempty"`  // e.g. conflict for a league that was already decided
+	Error  string               `json:"error,omitempty"`
+}
+
+// BulkReviewResponse is the per-league report of a bulk review
+type BulkReviewResponse struct {
+	Action    BulkReviewAction   `json:"action"`
+	Total     int                `json:"total"`
+	Succeeded int                `json:"succeeded"`
+	Failed    int                `json:"failed"`
+	Results   []BulkReviewResult `json:"results"`
+}
+
+// PolicyOutcome is what the auto-approval policy does with a new submission
+type PolicyOutcome string
+
+const (
+	PolicyAutoApprove PolicyOutcome = "auto_approve" // Approved without review
+	PolicyQueue       PolicyOutcome = "queue"        // Waits in the review queue
+	PolicyFlag        PolicyOutcome = "flag"         // Waits in the review queue and admins are warned about it
+)
+
+// PolicyConditions are the checks a policy rule makes on a submission
+// Every condition that is set must hold; a rule without conditions matches every submission
+type PolicyConditions struct {
+	OrgVerified             *bool `json:"org_verified,omitempty"`
+	MinApprovedLeagues      *int  `json:"min_approved_leagues,omitempty" validate:"omitempty,min=0"` // The organization's approved leagues
+	MaxRejectedLeagues      *int  `json:"max_rejected_leagues,omitempty" validate:"omitempty,min=0"` // The organization's rejected leagues
+	HasSupplementalRequests *bool `json:"has_supplemental_requests,omitempty"`                        // A sport or venue that doesn't exist yet is requested
+	PriceOutlier            *bool `json:"price_outlier,omitempty"`
+	SuspiciousURL           *bool `json:"suspicious_url,omitempty"`
+}
+
+// PolicyRule is an admin-managed rule of the auto-approval policy
+// Enabled rules are tried by ascending priority, then ID, and the first whose conditions hold decides
+type PolicyRule struct {
+	ID         int64            `json:"id"`
+	Name       string           `json:"name"`
+	Priority   int              `json:"priority"`
+	Outcome    PolicyOutcome    `json:"outcome"`
+	Conditions PolicyConditions `json:"conditions"`
+	Enabled    bool             `json:"enabled"`
+	CreatedBy  *string          `json:"created_by"`
+	CreatedAt  Timestamp        `json:"created_at"`
+	UpdatedAt  Timestamp        `json:"updated_at"`
+}
+
+// PolicyRuleRequest represents the request to create or replace a policy rule
+type PolicyRuleRequest struct {
+	Name       string           `json:"name" validate:"required,min=1,max=100"`
+	Priority   int              `json:"priority" validate:"min=0,max=10000"`
+	Outcome    PolicyOutcome    `json:"outcome" validate:"required,oneof=auto_approve queue flag"`
+	Conditions PolicyConditions `json:"conditions"`
+	Enabled    *bool            `json:"enabled"` // Defaults to true
+}
+
+// PolicySignals are the facts about a submission that policy rules check
+type PolicySignals struct {
+	OrgVerified             bool     `json:"org_verified"`
+	ApprovedLeagues         int      `json:"approved_leagues"`
+	RejectedLeagues         int      `json:"rejected_leagues"`
+	HasSupplementalRequests bool     `json:"has_supplemental_requests"`
+	PriceOutlier            bool     `json:"price_outlier"`
+	SuspiciousURL           bool     `json:"suspicious_url"`
+	Reasons                 []string `json:"reasons,omitempty"` // Why the price or registration URL was singled out
+}
+
+// PolicyDecision records what the auto-approval policy decided for a submission and which rule fired
+type PolicyDecision struct {
+	Outcome   PolicyOutcome `json:"outcome"`
+	RuleID    *int64        `json:"rule_id"` // nil when no rule matched and the submission was queued by default
+	RuleName  string        `json:"rule_name"`
+	Signals   PolicySignals `json:"signals"`
+	DecidedAt Timestamp     `json:"decided_at"`
+}
+
+// ReviewQueueFilter narrows the moderation queue
+// A claim that expired before Now counts as released
+type ReviewQueueFilter struct {
+	AssignedTo      *string    // Leagues claimed by this admin
+	Unassigned      bool       // Leagues nobody has claimed
+	SubmittedBefore *time.Time // Leagues waiting since before this time
+	Now             time.Time
+}
+
+// LeagueClaim is an admin's lock on reviewing a league
+type LeagueClaim struct {
+	LeagueID  string    `json:"league_id"`
+	ClaimedBy string    `json:"claimed_by"`
+	ExpiresAt Timestamp `json:"expires_at"`
+}
+
+// BacklogBucket counts the leagues awaiting review for an age range
+type BacklogBucket struct {
+	Label    string `json:"label"`
+	MinHours int    `json:"min_hours"`
+	MaxHours *int   `json:"max_hours"` // nil for the open-ended last bucket
+	Count    int    `json:"count"`
+}
+
+// ReviewMetrics summarizes how quickly submissions are reviewed and how old the current queue is
+type ReviewMetrics struct {
+	Since       Timestamp       `json:"since"`        // Start of the window the decision times cover
+	Decisions   int             `json:"decisions"`    // Decisions in the window with a submission to measure from
+	MedianHours *float64        `json:"median_hours"` // nil when there were no decisions
+	P90Hours    *float64        `json:"p90_hours"`
+	Pending     int             `json:"pending"`
+	Backlog     []BacklogBucket `json:"backlog"`
+}
+
+// ApproveLeagueResponse represents the response when approving a league
+type ApproveLeagueResponse struct {
+	Status        string          `json:"status"`
+	ChangedFields []string        `json:"changed_fields,omitempty"` // Fields the admin corrected while approving
+	Warnings      []VenueConflict `json:"warnings,omitempty"`
+}
+
+// GetVenueConflictsResponse represents the response when checking a league for venue conflicts
+type GetVenueConflictsResponse struct {
+	Conflicts []VenueConflict `json:"conflicts"`
+}
+
+// EditOutcome describes what happened to an organizer's edit of a league
+type EditOutcome string
+
+const (
+	EditOutcomeUnchanged     EditOutcome = "unchanged"      // Nothing differed from the stored league
+	EditOutcomeApplied       EditOutcome = "applied"        // Changes are live immediately
+	EditOutcomeResubmitted   EditOutcome = "resubmitted"    // Pending/rejected league went back to the review queue
+	EditOutcomePendingReview EditOutcome = "pending_review" // Approved league keeps its live version until the revision is approved
+)
+
+// UpdateLeagueResponse represents the response when editing a league
+type UpdateLeagueResponse struct {
+	League        League      `json:"league"`
+	Outcome       EditOutcome `json:"outcome"`
+	ChangedFields []string    `json:"changed_fields"`
+}
+
+// RevisionAction describes the change recorded by a league revision
+type RevisionAction string
+
+const (
+	RevisionCreated          RevisionAction = "created"
+	RevisionEdited           RevisionAction = "edited"         // Change applied to the live league
+	RevisionResubmitted      RevisionAction = "resubmitted"    // Pending/rejected league edited and sent back to review
+	RevisionEditSubmitted    RevisionAction = "edit_submitted" // Edit of an approved league awaiting review
+	RevisionApproved         RevisionAction = "approved"
+	RevisionRejected         RevisionAction = "rejected"
+	RevisionEditApproved     RevisionAction = "edit_approved"
+	RevisionEditRejected     RevisionAction = "edit_rejected"
+	RevisionChangesRequested RevisionAction = "changes_requested" // Submission sent back to the organizer
+	RevisionLifecycle        RevisionAction = "lifecycle_changed"
+)
+
+// String returns the string representation of the revision action
+func (a RevisionAction) String() string {
+	return string(a)
+}
+
+// LeagueRevision is an immutable snapshot of a league taken after each change
+type LeagueRevision struct {
+	ID             int64          `json:"id"`
+	LeagueID       string         `json:"league_id"`
+	RevisionNumber int            `json:"revision_number"`
+	Action         RevisionAction `json:"action"`
+	Status         LeagueStatus   `json:"status"`
+	Snapshot       *League        `json:"snapshot"`       // For edit_submitted/edit_rejected, the proposed version
+	ChangedFields  []string       `json:"changed_fields"` // Fields changed relative to the live league
+	Note           *string        `json:"note"`           // Rejection reason, if any
+	CreatedBy      *string        `json:"created_by"`
+	CreatedAt      Timestamp      `json:"created_at"`
+}
+
+// FieldChange is a single field that differs between two revisions
+type FieldChange struct {
+	Field   string           `json:"field"`
+	From    interface{}      `json:"from"`
+	To      interface{}      `json:"to"`
+	Added   []GameOccurrence `json:"added,omitempty"`   // game_occurrences only
+	Removed []GameOccurrence `json:"removed,omitempty"` // game_occurrences only
+}
+
+// RevisionDiff is the field-by-field comparison of two revisions of a league
+type RevisionDiff struct {
+	LeagueID string        `json:"league_id"`
+	From     int           `json:"from"` // Revision numbers
+	To       int           `json:"to"`
+	Changes  []FieldChange `json:"changes"`
+}
+
+// GetLeagueHistoryResponse represents the response when getting a league's revisions
+type GetLeagueHistoryResponse struct {
+	LeagueID  string           `json:"league_id"`
+	Revisions []LeagueRevision `json:"revisions"`
 }
 
 // GetLeaguesResponse represents the response when getting multiple leagues
 type GetLeaguesResponse struct {
-	Leagues []League `json:"leagues"`
-	Count   int64    `json:"count"`
+	Leagues    []League      `json:"leagues"`
+	Count      int64         `json:"count"`
+	Facets     *LeagueFacets `json:"facets,omitempty"`
+	NextCursor string        `json:"next_cursor,omitempty"` // Pass as ?cursor= to fetch the next page
+}
+
+// LeagueFilter holds the optional criteria used to narrow the public league listing
+// Nil/empty fields are not applied
+type LeagueFilter struct {
+	Query             string // Free-text search, results are ranked by relevance
+	SportID           *int64
+	Gender            *string
+	Division          *string
+	Days              []string // Canonical weekday names, matches leagues playing on any of them
+	MinPrice          *float64 // Compared against pricing_per_player
+	MaxPrice          *float64
+	DeadlineAfter     *time.Time // Registration deadline on or after
+	DeadlineBefore    *time.Time // Registration deadline on or before
+	SeasonStartAfter  *time.Time
+	SeasonStartBefore *time.Time
+	Near              *shared.GeoPoint    // Only leagues at venues within RadiusKm of this point
+	RadiusKm          float64
+	BBox              *shared.BoundingBox // Only leagues at venues inside this box
+	VenueIDs          []int64             // Resolved from Near/BBox by the service; non-nil means restrict to these venues
+	LeagueIDs         []string            // Resolved from Query by the service; non-nil means restrict to these leagues
+	Lifecycle         []LifecycleStatus   // Empty means every state except completed and cancelled
+	OrgID             string              // Set by the service for organization exports; "" means every organization
+	Status            *LeagueStatus       // Set by the service for exports; nil means every moderation status
+}
+
+// HasLocation reports whether the filter restricts results by venue location
+func (f LeagueFilter) HasLocation() bool {
+	return f.Near != nil || f.BBox != nil
+}
+
+// FacetCount is the number of matching leagues for a single facet value
+type FacetCount struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+	Count int64  `json:"count"`
+}
+
+// LeagueFacets holds per-value counts used to render filter chips
+// Each facet ignores its own filter so the other options stay visible
+type LeagueFacets struct {
+	Sports  []FacetCount `json:"sports"`
+	Genders []FacetCount `json:"genders"`
+	Days    []FacetCount `json:"days"`
+}
+
+// leagueFacetRow is the minimal projection of a league used to compute facets
+type leagueFacetRow struct {
+	SportID   *int64   `json:"sport_id"`
+	SportName *string  `json:"sport_name"`
+	Gender    *string  `json:"gender"`
+	GameDays  []string `json:"league_game_days"`
 }
 
 // DraftType represents the type of draft (draft or template)
@@ -0,0 +1,83 @@
+package leagues
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/pagination"
+)
+
+// leagueSorts are the sort options accepted by the league list endpoints
+var leagueSorts = []pagination.SortField{
+	{Name: "deadline", Column: "registration_deadline"},
+	{Name: "start_date", Column: "season_start_date"},
+	{Name: "price", Column: "pricing_per_player"},
+	{Name: "created_at", Column: "created_at"},
+}
+
+// approvedLeaguesPaging configures the public listing: soonest registration deadline first
+var approvedLeaguesPaging = pagination.Config{
+	DefaultLimit: 10,
+	MaxLimit:     100,
+	Sorts:        leagueSorts,
+	DefaultSort:  "deadline",
+}
+
+// pendingLeaguesPaging configures the moderation queue: oldest submission first
+var pendingLeaguesPaging = pagination.Config{
+	DefaultLimit: 20,
+	MaxLimit:     100,
+	Sorts:        leagueSorts,
+	DefaultSort:  "created_at",
+}
+
+// allLeaguesPaging configures the admin listing of every league: newest first
+var allLeaguesPaging = pagination.Config{
+	DefaultLimit: 20,
+	MaxLimit:     100,
+	Sorts:        leagueSorts,
+	DefaultSort:  "-created_at",
+}
+
+// leagueSortKey returns the value of the sort column for a league in the form PostgREST compares against
+// Returns nil when the value is NULL
+func leagueSortKey(league League, column string) *string {
+	var key string
+	switch column {
+	case "registration_deadline":
+		if league.RegistrationDeadline == nil {
+			return nil
+		}
+		key = league.RegistrationDeadline.Format("2006-01-02")
+	case "season_start_date":
+		if league.SeasonStartDate == nil {
+			return nil
+		}
+		key = league.SeasonStartDate.Format("2006-01-02")
+	case "pricing_per_player":
+		if league.PricingPerPlayer == nil {
+			return nil
+		}
+		key = strconv.FormatFloat(*league.PricingPerPlayer, 'f', -1, 64)
+	case "created_at":
+		if league.CreatedAt.IsZero() {
+			return nil
+		}
+		key = league.CreatedAt.Format(time.RFC3339Nano)
+	default:
+		return nil
+	}
+	return &key
+}
+
+// nextLeagueCursor returns the cursor for the page after leagues, or "" when it was the last page
+func nextLeagueCursor(page pagination.Params, leagues []League, hasMore bool) string {
+	if !hasMore || len(leagues) == 0 {
+		return ""
+	}
+	last := leagues[len(leagues)-1]
+	if last.ID == nil {
+		return ""
+	}
+	return page.NextCursor(leagueSortKey(last, page.Sort.Column), *last.ID)
+}
@@ -0,0 +1,78 @@
+package leagues
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/pagination"
+)
+
+func TestLeagueSortKey(t *testing.T) {
+	price := 42.5
+	league := League{
+		RegistrationDeadline: &Date{Time: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		PricingPerPlayer:     &price,
+		CreatedAt:            Timestamp{Time: time.Date(2025, 1, 2, 3, 4, 5, 123456000, time.UTC)},
+	}
+
+	tests := []struct {
+		column string
+		want   string
+	}{
+		{"registration_deadline", "2025-03-01"},
+		{"pricing_per_player", "42.5"},
+		{"created_at", "2025-01-02T03:04:05.123456Z"},
+	}
+
+	for _, tt := range tests {
+		key := leagueSortKey(league, tt.column)
+		if key == nil || *key != tt.want {
+			t.Errorf("%s: expected %q, got %v", tt.column, tt.want, key)
+		}
+	}
+
+	if key := leagueSortKey(league, "season_start_date"); key != nil {
+		t.Errorf("expected nil key for NULL season_start_date, got %q", *key)
+	}
+}
+
+func TestNextLeagueCursor(t *testing.T) {
+	page, err := pagination.Parse(url.Values{"sort": {"-price"}}, approvedLeaguesPaging)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	price := 20.0
+	leagues := []League{{ID: stringPtr("a")}, {ID: stringPtr("b"), PricingPerPlayer: &price}}
+
+	if cursor := nextLeagueCursor(page, leagues, false); cursor != "" {
+		t.Errorf("expected no cursor on the last page, got %q", cursor)
+	}
+
+	encoded := nextLeagueCursor(page, leagues, true)
+	cursor, err := pagination.DecodeCursor(encoded)
+	if err != nil {
+		t.Fatalf("expected valid cursor, got %v", err)
+	}
+	if cursor.Sort != "-price" || cursor.ID != "b" || cursor.Key == nil || *cursor.Key != "20" {
+		t.Errorf("unexpected cursor: %+v", cursor)
+	}
+}
+
+func TestLeaguePagingConfigs(t *testing.T) {
+	for name, cfg := range map[string]pagination.Config{
+		"approved": approvedLeaguesPaging,
+		"pending":  pendingLeaguesPaging,
+		"all":      allLeaguesPaging,
+	} {
+		if _, err := pagination.Parse(url.Values{}, cfg); err != nil {
+			t.Errorf("%s: default sort should be whitelisted, got %v", name, err)
+		}
+		for _, sort := range []string{"deadline", "-start_date", "price", "-created_at"} {
+			if _, err := pagination.Parse(url.Values{"sort": {sort}}, cfg); err != nil {
+				t.Errorf("%s: expected sort %q to be allowed, got %v", name, sort, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,924 @@
+package leagues
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/leaguefindr/backend/internal/database"
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// PgxRepository stores leagues in Postgres over a direct connection pool
+// Rows are read with to_jsonb and written with jsonb_populate_record, so they are encoded exactly as with PostgREST
+type PgxRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPgxRepository creates a repository on a connection pool
+func NewPgxRepository(pool *pgxpool.Pool) *PgxRepository {
+	return &PgxRepository{pool: pool}
+}
+
+// selectLeagues is the start of every query returning whole leagues
+const selectLeagues = "SELECT to_jsonb(l) FROM leagues l"
+
+// pendingReviewCondition matches pending submissions and approved leagues with an edit awaiting review
+const pendingReviewCondition = "(status = 'pending' OR pending_changes IS NOT NULL)"
+
+// ============= LEAGUE METHODS =============
+
+// GetAll retrieves all leagues regardless of status (admin only)
+func (r *PgxRepository) GetAll(ctx context.Context) ([]League, error) {
+	return r.queryLeagues(ctx, selectLeagues)
+}
+
+// GetAllApproved retrieves all approved leagues
+func (r *PgxRepository) GetAllApproved(ctx context.Context) ([]League, error) {
+	return r.queryLeagues(ctx, selectLeagues+" WHERE status = 'approved'")
+}
+
+// GetBatchAfterID retrieves up to limit leagues matching the filter with an ID greater than afterID, in ID order
+func (r *PgxRepository) GetBatchAfterID(ctx context.Context, afterID string, limit int, filter LeagueFilter) ([]League, error) {
+	conditions := &database.Conditions{}
+	addLeagueFilter(conditions, filter)
+	if afterID != "" {
+		conditions.Add("id > " + conditions.Arg(afterID))
+	}
+	sql := selectLeagues + conditions.Where() + " ORDER BY id LIMIT " + strconv.Itoa(limit)
+	return r.queryLeagues(ctx, sql, conditions.Args()...)
+}
+
+// GetApprovedBySeriesID retrieves every approved season of a league series, newest season first
+// The first season of a series has no series_id of its own, so it is matched by its ID
+func (r *PgxRepository) GetApprovedBySeriesID(ctx context.Context, seriesID string) ([]League, error) {
+	leagues, err := r.queryLeagues(ctx,
+		selectLeagues+" WHERE status = 'approved' AND (series_id = $1 OR id = $1) ORDER BY season_start_date DESC NULLS LAST",
+		seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query league seasons: %w", err)
+	}
+	return leagues, nil
+}
+
+// GetApprovedByVenueID retrieves every approved league played at a venue
+func (r *PgxRepository) GetApprovedByVenueID(ctx context.Context, venueID int64) ([]League, error) {
+	leagues, err := r.queryLeagues(ctx,
+		selectLeagues+" WHERE status = 'approved' AND venue_id = $1 ORDER BY season_start_date NULLS LAST",
+		venueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query venue leagues: %w", err)
+	}
+	return leagues, nil
+}
+
+// GetDuplicateCandidates retrieves the leagues of any status sharing the organization, sport or venue
+// A nil sport or venue compares as NULL and matches nothing
+func (r *PgxRepository) GetDuplicateCandidates(ctx context.Context, orgID string, sportID *int64, venueID *int64) ([]League, error) {
+	leagues, err := r.queryLeagues(ctx,
+		selectLeagues+" WHERE org_id = $1 OR sport_id = $2 OR venue_id = $3",
+		orgID, sportID, venueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query duplicate candidates: %w", err)
+	}
+	return leagues, nil
+}
+
+// GetAllApprovedWithPagination retrieves a page of approved leagues matching the filter
+// Returns up to page.Limit+1 rows (see pagination.Trim) and the total number of matches
+func (r *PgxRepository) GetAllApprovedWithPagination(ctx context.Context, filter LeagueFilter, page pagination.Params) ([]League, int64, error) {
+	return r.getLeaguesPage(ctx, page, func(conditions *database.Conditions) {
+		conditions.Add("status = 'approved'")
+		addLeagueFilter(conditions, filter)
+	})
+}
+
+// GetAllApprovedFiltered retrieves every approved league matching the filter without pagination
+func (r *PgxRepository) GetAllApprovedFiltered(ctx context.Context, filter LeagueFilter) ([]League, error) {
+	conditions := &database.Conditions{}
+	conditions.Add("status = 'approved'")
+	addLeagueFilter(conditions, filter)
+	return r.queryLeagues(ctx, selectLeagues+conditions.Where(), conditions.Args()...)
+}
+
+// GetApprovedFacetRows retrieves the facet columns of every approved league matching the filter
+func (r *PgxRepository) GetApprovedFacetRows(ctx context.Context, filter LeagueFilter) ([]leagueFacetRow, error) {
+	conditions := &database.Conditions{}
+	conditions.Add("status = 'approved'")
+	addLeagueFilter(conditions, filter)
+
+	sql := "SELECT jsonb_build_object('sport_id', sport_id, 'sport_name', form_data->>'sport_name', 'gender', gender, " +
+		"'league_game_days', league_game_days(l)) FROM leagues l" + conditions.Where()
+	rows, err := database.SelectJSON[leagueFacetRow](ctx, r.pool, sql, conditions.Args()...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query league facets: %w", err)
+	}
+	return rows, nil
+}
+
+// addLeagueFilter adds the optional filter criteria to a leagues query, as applyLeagueFilter does for PostgREST
+func addLeagueFilter(conditions *database.Conditions, filter LeagueFilter) {
+	if filter.SportID != nil {
+		conditions.Add("sport_id = " + conditions.Arg(*filter.SportID))
+	}
+	if filter.Gender != nil {
+		conditions.Add("gender ILIKE " + conditions.Arg(escapeLike(*filter.Gender)))
+	}
+	if filter.Division != nil {
+		conditions.Add("division ILIKE " + conditions.Arg(escapeLike(*filter.Division)))
+	}
+	if len(filter.Days) > 0 {
+		days := make([]string, len(filter.Days))
+		for i, day := range filter.Days {
+			days[i] = strings.ToLower(day)
+		}
+		conditions.Add("league_game_days(l) && " + conditions.Arg(days) + "::text[]")
+	}
+	if filter.MinPrice != nil {
+		conditions.Add("pricing_per_player >= " + conditions.Arg(*filter.MinPrice))
+	}
+	if filter.MaxPrice != nil {
+		conditions.Add("pricing_per_player <= " + conditions.Arg(*filter.MaxPrice))
+	}
+	if filter.DeadlineAfter != nil {
+		conditions.Add("registration_deadline >= " + conditions.Arg(filter.DeadlineAfter.Format("2006-01-02")))
+	}
+	if filter.DeadlineBefore != nil {
+		conditions.Add("registration_deadline <= " + conditions.Arg(filter.DeadlineBefore.Format("2006-01-02")))
+	}
+	if filter.SeasonStartAfter != nil {
+		conditions.Add("season_start_date >= " + conditions.Arg(filter.SeasonStartAfter.Format("2006-01-02")))
+	}
+	if filter.SeasonStartBefore != nil {
+		conditions.Add("season_start_date <= " + conditions.Arg(filter.SeasonStartBefore.Format("2006-01-02")))
+	}
+	if filter.VenueIDs != nil {
+		conditions.Add("venue_id = ANY(" + conditions.Arg(filter.VenueIDs) + "::bigint[])")
+	}
+	if filter.LeagueIDs != nil {
+		conditions.Add("id::text = ANY(" + conditions.Arg(filter.LeagueIDs) + "::text[])")
+	}
+	if filter.OrgID != "" {
+		conditions.Add("org_id = " + conditions.Arg(filter.OrgID))
+	}
+	if filter.Status != nil {
+		conditions.Add("status = " + conditions.Arg(filter.Status.String()))
+	}
+
+	lifecycle := filter.Lifecycle
+	if len(lifecycle) == 0 {
+		lifecycle = publicLifecycleStatuses
+	}
+	states := make([]string, len(lifecycle))
+	for i, state := range lifecycle {
+		states[i] = state.String()
+	}
+	conditions.Add("lifecycle_status::text = ANY(" + conditions.Arg(states) + "::text[])")
+}
+
+// GetByID retrieves a league by ID (any status - auth required at route level)
+// Deprecated: Use GetByUUID instead
+func (r *PgxRepository) GetByID(ctx context.Context, id int) (*League, error) {
+	return r.GetByUUID(ctx, strconv.Itoa(id))
+}
+
+// GetByUUID retrieves a league by UUID
+func (r *PgxRepository) GetByUUID(ctx context.Context, id string) (*League, error) {
+	if !isLeagueID(id) {
+		return nil, shared.NotFound("league not found")
+	}
+
+	leagues, err := r.queryLeagues(ctx, selectLeagues+" WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(leagues) == 0 {
+		return nil, shared.NotFound("league not found")
+	}
+	return &leagues[0], nil
+}
+
+// GetByOrgID retrieves all leagues for an organization (all statuses)
+func (r *PgxRepository) GetByOrgID(ctx context.Context, orgID string) ([]League, error) {
+	return r.queryLeagues(ctx, selectLeagues+" WHERE org_id = $1", orgID)
+}
+
+// GetByOrgIDAndStatus retrieves leagues for an organization filtered by status
+func (r *PgxRepository) GetByOrgIDAndStatus(ctx context.Context, orgID string, status LeagueStatus) ([]League, error) {
+	return r.queryLeagues(ctx, selectLeagues+" WHERE org_id = $1 AND status = $2", orgID, status.String())
+}
+
+// Create creates a new league in the database, filling in its ID and timestamps
+func (r *PgxRepository) Create(ctx context.Context, league *League) error {
+	now := time.Now()
+	league.CreatedAt = Timestamp{Time: now}
+	league.UpdatedAt = Timestamp{Time: now}
+	league.SubmittedAt = &Timestamp{Time: now}
+
+	insertData := map[string]interface{}{
+		"org_id":                league.OrgID,
+		"sport_id":              league.SportID,
+		"league_name":           league.LeagueName,
+		"division":              league.Division,
+		"registration_deadline": league.RegistrationDeadline,
+		"season_start_date":     league.SeasonStartDate,
+		"season_end_date":       league.SeasonEndDate,
+		"pricing_strategy":      league.PricingStrategy,
+		"pricing_amount":        league.PricingAmount,
+		"pricing_per_player":    league.PricingPerPlayer,
+		"venue_id":              league.VenueID,
+		"gender":                league.Gender,
+		"season_details":        league.SeasonDetails,
+		"registration_url":      league.RegistrationURL,
+		"duration":              league.Duration,
+		"minimum_team_players":  league.MinimumTeamPlayers,
+		"per_game_fee":          league.PerGameFee,
+		"form_data":             league.FormData,
+		"status":                league.Status,
+		"previous_season_id":    league.PreviousSeasonID,
+		"series_id":             league.SeriesID,
+		"created_at":            now,
+		"updated_at":            now,
+		"created_by":            league.CreatedBy,
+		"duplicate_candidates":  league.DuplicateCandidates,
+		"policy_decision":       league.PolicyDecision,
+		"submitted_at":          now,
+	}
+	// Leave an unset lifecycle status to the column default
+	if league.LifecycleStatus != "" {
+		insertData["lifecycle_status"] = league.LifecycleStatus
+	}
+
+	var created League
+	if err := database.Insert(ctx, r.pool, "leagues", insertData, &created); err != nil {
+		return fmt.Errorf("failed to create league: %w", err)
+	}
+	league.ID = created.ID
+	league.LifecycleStatus = created.LifecycleStatus
+
+	return nil
+}
+
+// GetPending retrieves all league submissions awaiting review, including pending edits of approved leagues
+func (r *PgxRepository) GetPending(ctx context.Context) ([]League, error) {
+	return r.queryLeagues(ctx, selectLeagues+" WHERE "+pendingReviewCondition)
+}
+
+// GetPendingWithPagination retrieves a page of leagues awaiting review that pass the queue filter
+// Returns up to page.Limit+1 rows (see pagination.Trim) and the total number of matching leagues
+func (r *PgxRepository) GetPendingWithPagination(ctx context.Context, filter ReviewQueueFilter, page pagination.Params) ([]League, int64, error) {
+	return r.getLeaguesPage(ctx, page, func(conditions *database.Conditions) {
+		conditions.Add(pendingReviewCondition)
+		if filter.AssignedTo != nil {
+			conditions.Add("claimed_by = " + conditions.Arg(*filter.AssignedTo) + " AND claim_expires_at > " + conditions.Arg(filter.Now))
+		}
+		if filter.Unassigned {
+			conditions.Add("(claimed_by IS NULL OR claim_expires_at <= " + conditions.Arg(filter.Now) + ")")
+		}
+		if filter.SubmittedBefore != nil {
+			conditions.Add("submitted_at < " + conditions.Arg(*filter.SubmittedBefore))
+		}
+	})
+}
+
+// GetOverdueReviews retrieves the leagues awaiting review since before submittedBefore that admins have not been told about
+func (r *PgxRepository) GetOverdueReviews(ctx context.Context, submittedBefore time.Time) ([]League, error) {
+	return r.queryLeagues(ctx, selectLeagues+" WHERE "+pendingReviewCondition+" AND submitted_at < $1 AND review_escalated_at IS NULL", submittedBefore)
+}
+
+// ClaimLeague gives userID the review claim on a league until expiresAt
+// The claim is only taken if nobody else holds one that is still active at now; returns false otherwise
+func (r *PgxRepository) ClaimLeague(ctx context.Context, id string, userID string, now time.Time, expiresAt time.Time) (bool, error) {
+	tag, err := r.pool.Exec(ctx,
+		"UPDATE leagues SET claimed_by = $2, claim_expires_at = $3 WHERE id = $1 AND (claimed_by IS NULL OR claimed_by = $2 OR claim_expires_at <= $4)",
+		id, userID, expiresAt, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim league: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// GetAllWithPagination retrieves a page of leagues regardless of status
+// Returns up to page.Limit+1 rows (see pagination.Trim) and the total number of leagues
+func (r *PgxRepository) GetAllWithPagination(ctx context.Context, page pagination.Params) ([]League, int64, error) {
+	return r.getLeaguesPage(ctx, page, func(conditions *database.Conditions) {})
+}
+
+// getLeaguesPage runs a paged leagues query with the conditions added by filter
+// The total is counted before the cursor condition is added, so it covers every page
+func (r *PgxRepository) getLeaguesPage(ctx context.Context, page pagination.Params, filter func(*database.Conditions)) ([]League, int64, error) {
+	conditions := &database.Conditions{}
+	filter(conditions)
+
+	var count int64
+	err := r.pool.QueryRow(ctx, "SELECT count(*) FROM leagues l"+conditions.Where(), conditions.Args()...).Scan(&count)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count leagues: %w", err)
+	}
+
+	after, suffix := page.ApplySQL(conditions.Arg)
+	if after != "" {
+		conditions.Add(after)
+	}
+	leagues, err := r.queryLeagues(ctx, selectLeagues+conditions.Where()+suffix, conditions.Args()...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return leagues, count, nil
+}
+
+// UpdateStatus updates the status of a league
+func (r *PgxRepository) UpdateStatus(ctx context.Context, id int, status LeagueStatus, rejectionReason *string) error {
+	return r.UpdateStatusByUUID(ctx, strconv.Itoa(id), status, rejectionReason, nil, nil)
+}
+
+// UpdateStatusByUUID updates a league status by UUID and optionally updates sport_id and venue_id
+func (r *PgxRepository) UpdateStatusByUUID(ctx context.Context, id string, status LeagueStatus, rejectionReason *string, sportID *int64, venueID *int64) error {
+	updateData := map[string]interface{}{
+		"status":           status.String(),
+		"rejection_reason": rejectionReason,
+	}
+	if sportID != nil {
+		updateData["sport_id"] = *sportID
+	}
+	if venueID != nil {
+		updateData["venue_id"] = *venueID
+	}
+
+	if err := r.UpdateFieldsByUUID(ctx, id, updateData); err != nil {
+		return fmt.Errorf("failed to update league status: %w", err)
+	}
+	return nil
+}
+
+// UpdateLeague updates the sport and venue of an existing league
+func (r *PgxRepository) UpdateLeague(ctx context.Context, league *League) error {
+	if league.ID == nil {
+		return fmt.Errorf("league ID is required")
+	}
+
+	return r.UpdateFieldsByUUID(ctx, *league.ID, map[string]interface{}{
+		"sport_id": league.SportID,
+		"venue_id": league.VenueID,
+	})
+}
+
+// UpdateFieldsByUUID updates the given columns of a league by UUID
+// Keys must be leagues column names; updated_at is always set
+func (r *PgxRepository) UpdateFieldsByUUID(ctx context.Context, id string, data map[string]interface{}) error {
+	if err := updateLeagueFields(ctx, r.pool, id, data); err != nil {
+		return fmt.Errorf("failed to update league: %w", err)
+	}
+	return nil
+}
+
+// updateLeagueFields sets columns of a league, with updated_at, on the pool or inside a transaction
+func updateLeagueFields(ctx context.Context, q database.Querier, id string, data map[string]interface{}) error {
+	updateData := map[string]interface{}{
+		"updated_at": time.Now(),
+	}
+	for column, value := range data {
+		updateData[column] = value
+	}
+
+	_, err := database.Update(ctx, q, "leagues", updateData, "id = $1", id)
+	return err
+}
+
+// ApproveLeague applies an approval in one transaction: the league row is locked and re-checked, the sport and
+// venue are found or created, the game_occurrences rows are replaced and the league columns are updated
+// Any failure rolls everything back, including newly created sports and venues. A league decided or claimed by
+// another admin since the caller read it is a Conflict, so concurrent decisions can't both commit
+func (r *PgxRepository) ApproveLeague(ctx context.Context, id string, approval *LeagueApproval) error {
+	sportID, venueID := approval.SportID, approval.VenueID
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var locked League
+		var status string
+		var hasPendingChanges bool
+		var claimExpiresAt *time.Time
+		err := tx.QueryRow(ctx,
+			"SELECT status::text, pending_changes IS NOT NULL, claimed_by, claim_expires_at FROM leagues WHERE id = $1 FOR UPDATE", id).
+			Scan(&status, &hasPendingChanges, &locked.ClaimedBy, &claimExpiresAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return shared.NotFound("league not found")
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock league: %w", err)
+		}
+		locked.Status = LeagueStatus(status)
+		if hasPendingChanges {
+			locked.PendingChanges = &League{} // Only whether there is an edit matters here
+		}
+		if claimExpiresAt != nil {
+			locked.ClaimExpiresAt = &Timestamp{Time: *claimExpiresAt}
+		}
+		if err := checkApprovable(&locked, approval.ReviewedBy, time.Now()); err != nil {
+			return err
+		}
+
+		if sportID == nil && approval.Sport != nil {
+			if sportID, err = findOrCreateSport(ctx, tx, approval.Sport.Name); err != nil {
+				return err
+			}
+		}
+		if venueID == nil && approval.Venue != nil {
+			if venueID, err = findOrCreateVenue(ctx, tx, approval.Venue); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.Exec(ctx, "DELETE FROM game_occurrences WHERE league_id = $1", id); err != nil {
+			return fmt.Errorf("failed to clear game occurrences: %w", err)
+		}
+		for _, occurrence := range occurrencesByDay(approval.GameOccurrences) {
+			_, err := tx.Exec(ctx,
+				"INSERT INTO game_occurrences (league_id, day, start_time, end_time) VALUES ($1, $2, $3, $4)",
+				id, occurrence.Day, occurrence.StartTime, occurrence.EndTime)
+			if err != nil {
+				return fmt.Errorf("failed to create game occurrence: %w", err)
+			}
+		}
+
+		resolved := *approval
+		resolved.SportID, resolved.VenueID = sportID, venueID
+		if err := updateLeagueFields(ctx, tx, id, approvalColumns(&resolved)); err != nil {
+			return fmt.Errorf("failed to update league: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Only report the new IDs once they are committed
+	approval.SportID, approval.VenueID = sportID, venueID
+	return nil
+}
+
+// findOrCreateSport returns the ID of the sport matching name, creating it when there is none
+// Names are matched the way the sports service matches them, ignoring case and punctuation
+func findOrCreateSport(ctx context.Context, tx pgx.Tx, name string) (*int64, error) {
+	rows, err := tx.Query(ctx, "SELECT id, name FROM sports")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check sport existence: %w", err)
+	}
+	type sportRow struct {
+		ID   int64
+		Name string
+	}
+	existing, err := pgx.CollectRows(rows, pgx.RowToStructByPos[sportRow])
+	if err != nil {
+		return nil, fmt.Errorf("failed to check sport existence: %w", err)
+	}
+
+	if normalized := shared.NormalizeText(name); normalized != "" {
+		for _, sport := range existing {
+			if shared.NormalizeText(sport.Name) == normalized {
+				return &sport.ID, nil
+			}
+		}
+	}
+
+	var id int64
+	if err := tx.QueryRow(ctx, "INSERT INTO sports (name) VALUES ($1) RETURNING id", strings.TrimSpace(name)).Scan(&id); err != nil {
+		return nil, fmt.Errorf("failed to create sport: %w", err)
+	}
+	return &id, nil
+}
+
+// findOrCreateVenue returns the ID of the venue at the submitted address, creating it when there is none
+// Addresses are matched the way the venues service matches them, ignoring case and punctuation
+func findOrCreateVenue(ctx context.Context, tx pgx.Tx, venue *SupplementalVenue) (*int64, error) {
+	rows, err := tx.Query(ctx, "SELECT id, coalesce(address, '') FROM venues")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check venue existence: %w", err)
+	}
+	type venueRow struct {
+		ID      int64
+		Address string
+	}
+	existing, err := pgx.CollectRows(rows, pgx.RowToStructByPos[venueRow])
+	if err != nil {
+		return nil, fmt.Errorf("failed to check venue existence: %w", err)
+	}
+
+	if normalized := shared.NormalizeText(venue.Address); normalized != "" {
+		for _, row := range existing {
+			if shared.NormalizeText(row.Address) == normalized {
+				return &row.ID, nil
+			}
+		}
+	}
+
+	lat, lng := 0.0, 0.0
+	if venue.Lat != nil {
+		lat = *venue.Lat
+	}
+	if venue.Lng != nil {
+		lng = *venue.Lng
+	}
+
+	var id int64
+	err = tx.QueryRow(ctx, "INSERT INTO venues (name, address, lat, lng) VALUES ($1, $2, $3, $4) RETURNING id",
+		venue.Name, venue.Address, lat, lng).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create venue: %w", err)
+	}
+	return &id, nil
+}
+
+// GetForLifecycleUpdate retrieves leagues in one of the given lifecycle states whose date column is before cutoff
+func (r *PgxRepository) GetForLifecycleUpdate(ctx context.Context, states []LifecycleStatus, dateColumn string, cutoff time.Time) ([]League, error) {
+	values := make([]string, len(states))
+	for i, state := range states {
+		values[i] = state.String()
+	}
+
+	sql := selectLeagues + " WHERE lifecycle_status::text = ANY($1::text[]) AND " + pgx.Identifier{dateColumn}.Sanitize() + " < $2"
+	return r.queryLeagues(ctx, sql, values, cutoff.Format("2006-01-02"))
+}
+
+// queryLeagues runs a query selecting to_jsonb(l) and decodes the leagues
+func (r *PgxRepository) queryLeagues(ctx context.Context, sql string, args ...any) ([]League, error) {
+	leagues, err := database.SelectJSON[League](ctx, r.pool, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query leagues: %w", err)
+	}
+	return leagues, nil
+}
+
+// ============= REVISION METHODS =============
+
+// CreateRevision stores a league revision; the revision number is assigned by the database
+func (r *PgxRepository) CreateRevision(ctx context.Context, revision *LeagueRevision) error {
+	insertData := map[string]interface{}{
+		"league_id":      revision.LeagueID,
+		"action":         revision.Action.String(),
+		"status":         revision.Status.String(),
+		"snapshot":       revision.Snapshot,
+		"changed_fields": revision.ChangedFields,
+		"note":           revision.Note,
+		"created_by":     revision.CreatedBy,
+	}
+
+	var created LeagueRevision
+	if err := database.Insert(ctx, r.pool, "league_revisions", insertData, &created); err != nil {
+		return fmt.Errorf("failed to create league revision: %w", err)
+	}
+	revision.ID = created.ID
+	revision.RevisionNumber = created.RevisionNumber
+	revision.CreatedAt = created.CreatedAt
+
+	return nil
+}
+
+// GetRevisionsByLeagueID retrieves all revisions of a league, oldest first
+func (r *PgxRepository) GetRevisionsByLeagueID(ctx context.Context, leagueID string) ([]LeagueRevision, error) {
+	revisions, err := database.SelectJSON[LeagueRevision](ctx, r.pool,
+		"SELECT to_jsonb(r) FROM league_revisions r WHERE league_id = $1 ORDER BY revision_number", leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query league revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// GetReviewEvents retrieves the revisions with the given actions recorded since a time, oldest first
+// Snapshots are not loaded
+func (r *PgxRepository) GetReviewEvents(ctx context.Context, actions []RevisionAction, since time.Time) ([]LeagueRevision, error) {
+	values := make([]string, len(actions))
+	for i, action := range actions {
+		values[i] = action.String()
+	}
+
+	revisions, err := database.SelectJSON[LeagueRevision](ctx, r.pool,
+		"SELECT to_jsonb(r) - 'snapshot' FROM league_revisions r WHERE action = ANY($1::text[]) AND created_at >= $2 ORDER BY id",
+		values, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query league revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// ============= COMMENT METHODS =============
+
+// CreateComment stores a comment in a league's review thread, filling in its ID and creation time
+func (r *PgxRepository) CreateComment(ctx context.Context, comment *LeagueComment) error {
+	insertData := map[string]interface{}{
+		"league_id":   comment.LeagueID,
+		"author_id":   comment.AuthorID,
+		"author_role": string(comment.AuthorRole),
+		"body":        comment.Body,
+		"fields":      comment.Fields,
+	}
+	if comment.Status != "" {
+		insertData["status"] = comment.Status.String()
+	}
+
+	var created LeagueComment
+	if err := database.Insert(ctx, r.pool, "league_comments", insertData, &created); err != nil {
+		return fmt.Errorf("failed to create league comment: %w", err)
+	}
+	comment.ID = created.ID
+	comment.CreatedAt = created.CreatedAt
+
+	return nil
+}
+
+// GetCommentsByLeagueID retrieves a league's review thread, oldest first
+func (r *PgxRepository) GetCommentsByLeagueID(ctx context.Context, leagueID string) ([]LeagueComment, error) {
+	comments, err := database.SelectJSON[LeagueComment](ctx, r.pool,
+		"SELECT to_jsonb(c) FROM league_comments c WHERE league_id = $1 ORDER BY id", leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query league comments: %w", err)
+	}
+	return comments, nil
+}
+
+// ============= POLICY RULE METHODS =============
+
+// GetPolicyRules retrieves the auto-approval policy rules in the order they're tried
+func (r *PgxRepository) GetPolicyRules(ctx context.Context) ([]PolicyRule, error) {
+	rules, err := database.SelectJSON[PolicyRule](ctx, r.pool,
+		"SELECT to_jsonb(p) FROM league_policy_rules p ORDER BY priority, id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query policy rules: %w", err)
+	}
+	return rules, nil
+}
+
+// CreatePolicyRule stores a policy rule, filling in its ID and timestamps
+func (r *PgxRepository) CreatePolicyRule(ctx context.Context, rule *PolicyRule) error {
+	insertData := map[string]interface{}{
+		"name":       rule.Name,
+		"priority":   rule.Priority,
+		"outcome":    string(rule.Outcome),
+		"conditions": rule.Conditions,
+		"enabled":    rule.Enabled,
+		"created_by": rule.CreatedBy,
+	}
+
+	var created PolicyRule
+	if err := database.Insert(ctx, r.pool, "league_policy_rules", insertData, &created); err != nil {
+		return fmt.Errorf("failed to create policy rule: %w", err)
+	}
+	rule.ID = created.ID
+	rule.CreatedAt = created.CreatedAt
+	rule.UpdatedAt = created.UpdatedAt
+
+	return nil
+}
+
+// UpdatePolicyRule replaces a policy rule's settings, filling in its stored fields
+func (r *PgxRepository) UpdatePolicyRule(ctx context.Context, rule *PolicyRule) error {
+	updateData := map[string]interface{}{
+		"name":       rule.Name,
+		"priority":   rule.Priority,
+		"outcome":    string(rule.Outcome),
+		"conditions": rule.Conditions,
+		"enabled":    rule.Enabled,
+		"updated_at": time.Now(),
+	}
+
+	updated, err := database.Update(ctx, r.pool, "league_policy_rules", updateData, "id = $1", rule.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update policy rule: %w", err)
+	}
+	if updated == 0 {
+		return shared.NotFound("policy rule not found")
+	}
+
+	rules, err := database.SelectJSON[PolicyRule](ctx, r.pool,
+		"SELECT to_jsonb(p) FROM league_policy_rules p WHERE id = $1", rule.ID)
+	if err != nil {
+		return fmt.Errorf("failed to query policy rule: %w", err)
+	}
+	if len(rules) > 0 {
+		*rule = rules[0]
+	}
+	return nil
+}
+
+// DeletePolicyRule removes a policy rule
+func (r *PgxRepository) DeletePolicyRule(ctx context.Context, id int64) error {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM league_policy_rules WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete policy rule: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return shared.NotFound("policy rule not found")
+	}
+	return nil
+}
+
+// ============= SCHEDULE EXCEPTION METHODS =============
+
+// GetScheduleExceptions retrieves a league's blackout dates and game changes, by date
+func (r *PgxRepository) GetScheduleExceptions(ctx context.Context, leagueID string) ([]ScheduleException, error) {
+	return r.GetScheduleExceptionsByLeagueIDs(ctx, []string{leagueID})
+}
+
+// GetScheduleExceptionsByLeagueIDs retrieves the schedule exceptions of several leagues at once, by date
+func (r *PgxRepository) GetScheduleExceptionsByLeagueIDs(ctx context.Context, leagueIDs []string) ([]ScheduleException, error) {
+	if len(leagueIDs) == 0 {
+		return nil, nil
+	}
+
+	exceptions, err := database.SelectJSON[ScheduleException](ctx, r.pool,
+		"SELECT to_jsonb(e) FROM league_schedule_exceptions e WHERE league_id::text = ANY($1::text[]) ORDER BY game_date, id",
+		leagueIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schedule exceptions: %w", err)
+	}
+	return exceptions, nil
+}
+
+// CreateScheduleException stores a schedule exception, filling in its ID and creation time
+func (r *PgxRepository) CreateScheduleException(ctx context.Context, exception *ScheduleException) error {
+	insertData := map[string]interface{}{
+		"league_id":      exception.LeagueID,
+		"kind":           string(exception.Kind),
+		"game_date":      exception.GameDate.Format("2006-01-02"),
+		"start_time":     exception.StartTime,
+		"new_start_time": exception.NewStartTime,
+		"new_end_time":   exception.NewEndTime,
+		"reason":         exception.Reason,
+		"created_by":     exception.CreatedBy,
+	}
+	if exception.NewDate != nil {
+		insertData["new_date"] = exception.NewDate.Format("2006-01-02")
+	}
+
+	var created ScheduleException
+	if err := database.Insert(ctx, r.pool, "league_schedule_exceptions", insertData, &created); err != nil {
+		return fmt.Errorf("failed to create schedule exception: %w", err)
+	}
+	exception.ID = created.ID
+	exception.CreatedAt = created.CreatedAt
+
+	return nil
+}
+
+// DeleteScheduleException removes one of a league's schedule exceptions
+func (r *PgxRepository) DeleteScheduleException(ctx context.Context, leagueID string, exceptionID int64) error {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM league_schedule_exceptions WHERE id = $1 AND league_id = $2", exceptionID, leagueID)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule exception: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return shared.NotFound("schedule exception not found")
+	}
+	return nil
+}
+
+// ============= DRAFT METHODS =============
+
+// selectDrafts is the start of every query returning drafts or templates
+const selectDrafts = "SELECT to_jsonb(d) FROM leagues_drafts d"
+
+// GetDraftByOrgID retrieves the draft for an organization, or nil when it has none
+func (r *PgxRepository) GetDraftByOrgID(ctx context.Context, orgID string) (*LeagueDraft, error) {
+	drafts, err := r.queryDrafts(ctx, selectDrafts+" WHERE org_id = $1 LIMIT 1", orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(drafts) == 0 {
+		return nil, nil
+	}
+	return &drafts[0], nil
+}
+
+// GetDraftByID retrieves a draft by its ID
+func (r *PgxRepository) GetDraftByID(ctx context.Context, draftID int) (*LeagueDraft, error) {
+	drafts, err := r.queryDrafts(ctx, selectDrafts+" WHERE id = $1", draftID)
+	if err != nil {
+		return nil, err
+	}
+	if len(drafts) == 0 {
+		return nil, shared.NotFound("draft not found")
+	}
+	return &drafts[0], nil
+}
+
+// SaveDraft saves or updates a draft for an organization
+func (r *PgxRepository) SaveDraft(ctx context.Context, draft *LeagueDraft) error {
+	now := time.Now()
+
+	// If draft has an ID, update it; otherwise insert a new one
+	if draft.ID > 0 {
+		updateData := map[string]interface{}{
+			"form_data":  draft.FormData,
+			"updated_at": now,
+		}
+		_, err := database.Update(ctx, r.pool, "leagues_drafts", updateData,
+			"id = $1 AND org_id = $2 AND type = 'draft'", draft.ID, draft.OrgID)
+		if err != nil {
+			return fmt.Errorf("failed to update draft: %w", err)
+		}
+		return nil
+	}
+
+	insertData := map[string]interface{}{
+		"org_id":     draft.OrgID,
+		"type":       draft.Type,
+		"name":       draft.Name,
+		"form_data":  draft.FormData,
+		"created_at": now,
+		"updated_at": now,
+		"created_by": draft.CreatedBy,
+	}
+
+	var created LeagueDraft
+	if err := database.Insert(ctx, r.pool, "leagues_drafts", insertData, &created); err != nil {
+		return fmt.Errorf("failed to save draft: %w", err)
+	}
+	draft.ID = created.ID
+
+	return nil
+}
+
+// DeleteDraftByID deletes a draft by ID for a specific organization
+func (r *PgxRepository) DeleteDraftByID(ctx context.Context, draftID int, orgID string) error {
+	_, err := r.pool.Exec(ctx, "DELETE FROM leagues_drafts WHERE id = $1 AND org_id = $2 AND type = 'draft'", draftID, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to delete draft: %w", err)
+	}
+	return nil
+}
+
+// DeleteStaleDrafts deletes drafts (not templates) last updated before cutoff
+// Returns the number of drafts deleted
+func (r *PgxRepository) DeleteStaleDrafts(ctx context.Context, cutoff time.Time) (int, error) {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM leagues_drafts WHERE type = $1 AND updated_at < $2", string(DraftTypeDraft), cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale drafts: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// GetAllDrafts retrieves all league drafts across all organizations (admin only)
+func (r *PgxRepository) GetAllDrafts(ctx context.Context) ([]LeagueDraft, error) {
+	return r.queryDrafts(ctx, selectDrafts)
+}
+
+// GetDraftsByOrgID retrieves all drafts for a specific organization
+func (r *PgxRepository) GetDraftsByOrgID(ctx context.Context, orgID string) ([]LeagueDraft, error) {
+	return r.queryDrafts(ctx, selectDrafts+" WHERE org_id = $1 AND type = 'draft'", orgID)
+}
+
+// GetTemplatesByOrgID retrieves all templates for a specific organization
+func (r *PgxRepository) GetTemplatesByOrgID(ctx context.Context, orgID string) ([]LeagueDraft, error) {
+	return r.queryDrafts(ctx, selectDrafts+" WHERE org_id = $1 AND type = 'template'", orgID)
+}
+
+// GetAllTemplates retrieves all templates across all organizations (admin only)
+func (r *PgxRepository) GetAllTemplates(ctx context.Context) ([]LeagueDraft, error) {
+	return r.queryDrafts(ctx, selectDrafts+" WHERE type = 'template'")
+}
+
+// UpdateTemplate updates an existing template (with org_id validation)
+func (r *PgxRepository) UpdateTemplate(ctx context.Context, template *LeagueDraft) error {
+	updateData := map[string]interface{}{
+		"name":       template.Name,
+		"form_data":  template.FormData,
+		"updated_at": time.Now(),
+	}
+
+	updated, err := database.Update(ctx, r.pool, "leagues_drafts", updateData,
+		"id = $1 AND org_id = $2 AND type = 'template'", template.ID, template.OrgID)
+	if err != nil {
+		return fmt.Errorf("failed to update template: %w", err)
+	}
+	if updated == 0 {
+		return shared.NotFound("template not found or access denied")
+	}
+	return nil
+}
+
+// DeleteTemplate deletes a template (with org_id validation)
+func (r *PgxRepository) DeleteTemplate(ctx context.Context, templateID int, orgID string) error {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM leagues_drafts WHERE id = $1 AND org_id = $2 AND type = 'template'", templateID, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return shared.NotFound("template not found or access denied")
+	}
+	return nil
+}
+
+// queryDrafts runs a query selecting to_jsonb(d) and decodes the drafts
+func (r *PgxRepository) queryDrafts(ctx context.Context, sql string, args ...any) ([]LeagueDraft, error) {
+	drafts, err := database.SelectJSON[LeagueDraft](ctx, r.pool, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query drafts: %w", err)
+	}
+	return drafts, nil
+}
@@ -0,0 +1,174 @@
+package leagues
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Names recorded on decisions no admin rule made
+const (
+	defaultPolicyRuleName     = "default"             // No rule matched; the submission is queued
+	unavailablePolicyRuleName = "signals_unavailable" // The facts couldn't be gathered; the submission is queued
+)
+
+// A price is an outlier when the sport has at least minPricePeers approved leagues to compare with and the
+// price per player is more than priceOutlierFactor times their median, or less than the median divided by it
+const (
+	minPricePeers      = 5
+	priceOutlierFactor = 3.0
+)
+
+// urlShorteners hide where a registration link leads
+var urlShorteners = []string{"bit.ly", "tinyurl.com", "t.co", "goo.gl", "ow.ly", "is.gd", "buff.ly", "cutt.ly", "rebrand.ly", "shorturl.at", "tiny.cc"}
+
+// evaluatePolicy decides a submission by the first enabled rule, in priority order, whose conditions hold
+// Without a matching rule the submission is queued for review
+func evaluatePolicy(rules []PolicyRule, signals PolicySignals, now time.Time) *PolicyDecision {
+	ordered := append([]PolicyRule(nil), rules...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority < ordered[j].Priority
+		}
+		return ordered[i].ID < ordered[j].ID
+	})
+
+	decision := &PolicyDecision{Outcome: PolicyQueue, RuleName: defaultPolicyRuleName, Signals: signals, DecidedAt: Timestamp{Time: now}}
+	for _, rule := range ordered {
+		if rule.Enabled && rule.Conditions.matches(signals) {
+			ruleID := rule.ID
+			decision.Outcome = rule.Outcome
+			decision.RuleID = &ruleID
+			decision.RuleName = rule.Name
+			break
+		}
+	}
+	return decision
+}
+
+// matches reports whether every condition that is set holds for the signals
+func (c PolicyConditions) matches(signals PolicySignals) bool {
+	switch {
+	case c.OrgVerified != nil && *c.OrgVerified != signals.OrgVerified:
+		return false
+	case c.MinApprovedLeagues != nil && signals.ApprovedLeagues < *c.MinApprovedLeagues:
+		return false
+	case c.MaxRejectedLeagues != nil && signals.RejectedLeagues > *c.MaxRejectedLeagues:
+		return false
+	case c.HasSupplementalRequests != nil && *c.HasSupplementalRequests != signals.HasSupplementalRequests:
+		return false
+	case c.PriceOutlier != nil && *c.PriceOutlier != signals.PriceOutlier:
+		return false
+	case c.SuspiciousURL != nil && *c.SuspiciousURL != signals.SuspiciousURL:
+		return false
+	}
+	return true
+}
+
+// policyRuleFromRequest builds the rule an admin asked for
+func policyRuleFromRequest(request *PolicyRuleRequest) *PolicyRule {
+	enabled := true
+	if request.Enabled != nil {
+		enabled = *request.Enabled
+	}
+	return &PolicyRule{
+		Name:       strings.TrimSpace(request.Name),
+		Priority:   request.Priority,
+		Outcome:    request.Outcome,
+		Conditions: request.Conditions,
+		Enabled:    enabled,
+	}
+}
+
+// approvalHistory counts an organization's approved and rejected leagues
+// Approved leagues with an edit awaiting review still count as approved
+func approvalHistory(leagues []League) (approved int, rejected int) {
+	for _, league := range leagues {
+		switch league.Status {
+		case LeagueStatusApproved:
+			approved++
+		case LeagueStatusRejected:
+			rejected++
+		}
+	}
+	return approved, rejected
+}
+
+// hasSupplementalRequests reports whether a league asks for a sport or venue that doesn't exist yet
+func hasSupplementalRequests(league *League) bool {
+	requests := league.SupplementalRequests
+	return requests != nil && (requests.Sport != nil || requests.Venue != nil)
+}
+
+// priceOutlierReason explains why a price per player is far from what comparable leagues charge, or returns ""
+// Free leagues are never outliers
+func priceOutlierReason(price *float64, peers []League) string {
+	if price == nil || *price <= 0 {
+		return ""
+	}
+
+	var prices []float64
+	for _, peer := range peers {
+		if peer.PricingPerPlayer != nil && *peer.PricingPerPlayer > 0 {
+			prices = append(prices, *peer.PricingPerPlayer)
+		}
+	}
+	if len(prices) < minPricePeers {
+		return ""
+	}
+	sort.Float64s(prices)
+	median := prices[len(prices)/2]
+	if len(prices)%2 == 0 {
+		median = (prices[len(prices)/2-1] + prices[len(prices)/2]) / 2
+	}
+
+	switch {
+	case *price > median*priceOutlierFactor:
+		return fmt.Sprintf("price per player of %.2f is more than %g times the sport's median of %.2f", *price, priceOutlierFactor, median)
+	case *price < median/priceOutlierFactor:
+		return fmt.Sprintf("price per player of %.2f is less than 1/%g of the sport's median of %.2f", *price, priceOutlierFactor, median)
+	}
+	return ""
+}
+
+// suspiciousURLReasons lists what makes a registration URL look unsafe; empty when nothing does
+func suspiciousURLReasons(raw *string) []string {
+	if raw == nil || strings.TrimSpace(*raw) == "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(strings.TrimSpace(*raw))
+	if err != nil || parsed.Hostname() == "" {
+		return []string{"registration URL can't be parsed"}
+	}
+
+	var reasons []string
+	switch scheme := strings.ToLower(parsed.Scheme); scheme {
+	case "https":
+	case "http":
+		reasons = append(reasons, "registration URL doesn't use HTTPS")
+	default:
+		reasons = append(reasons, fmt.Sprintf("registration URL uses the %s scheme", scheme))
+	}
+	if parsed.User != nil {
+		reasons = append(reasons, "registration URL contains credentials")
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if net.ParseIP(host) != nil {
+		reasons = append(reasons, "registration URL points at an IP address")
+	}
+	if strings.HasPrefix(host, "xn--") || strings.Contains(host, ".xn--") {
+		reasons = append(reasons, "registration URL uses an internationalized (punycode) domain")
+	}
+	for _, shortener := range urlShorteners {
+		if host == shortener || strings.HasSuffix(host, "."+shortener) {
+			reasons = append(reasons, fmt.Sprintf("registration URL uses the link shortener %s", shortener))
+			break
+		}
+	}
+	return reasons
+}
@@ -4,33 +4,75 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/shared"
+	"github.com/leaguefindr/backend/internal/sports"
+	"github.com/leaguefindr/backend/internal/venues"
 	"github.com/supabase-community/postgrest-go"
 )
 
 // RepositoryInterface defines the contract for repository implementations
+// Repository stores leagues in Supabase through PostgREST, PgxRepository in Postgres over a connection pool
+// and MemoryRepository keeps them in memory
 type RepositoryInterface interface {
 	// League methods
 	GetAll(ctx context.Context) ([]League, error)
 	GetAllApproved(ctx context.Context) ([]League, error)
-	GetAllApprovedWithPagination(ctx context.Context, limit, offset int) ([]League, int64, error)
+	GetBatchAfterID(ctx context.Context, afterID string, limit int, filter LeagueFilter) ([]League, error)
+	GetApprovedBySeriesID(ctx context.Context, seriesID string) ([]League, error)
+	GetApprovedByVenueID(ctx context.Context, venueID int64) ([]League, error)
+	GetDuplicateCandidates(ctx context.Context, orgID string, sportID *int64, venueID *int64) ([]League, error)
+	GetAllApprovedWithPagination(ctx context.Context, filter LeagueFilter, page pagination.Params) ([]League, int64, error)
+	GetAllApprovedFiltered(ctx context.Context, filter LeagueFilter) ([]League, error)
+	GetApprovedFacetRows(ctx context.Context, filter LeagueFilter) ([]leagueFacetRow, error)
 	GetByID(ctx context.Context, id int) (*League, error)
+	GetByUUID(ctx context.Context, id string) (*League, error)
 	GetByOrgID(ctx context.Context, orgID string) ([]League, error)
 	GetByOrgIDAndStatus(ctx context.Context, orgID string, status LeagueStatus) ([]League, error)
 	Create(ctx context.Context, league *League) error
 	GetPending(ctx context.Context) ([]League, error)
-	GetPendingWithPagination(ctx context.Context, limit, offset int) ([]League, int64, error)
-	GetAllWithPagination(ctx context.Context, limit, offset int) ([]League, int64, error)
+	GetPendingWithPagination(ctx context.Context, filter ReviewQueueFilter, page pagination.Params) ([]League, int64, error)
+	GetOverdueReviews(ctx context.Context, submittedBefore time.Time) ([]League, error)
+	ClaimLeague(ctx context.Context, id string, userID string, now time.Time, expiresAt time.Time) (bool, error)
+	GetAllWithPagination(ctx context.Context, page pagination.Params) ([]League, int64, error)
 	UpdateStatus(ctx context.Context, id int, status LeagueStatus, rejectionReason *string) error
+	UpdateStatusByUUID(ctx context.Context, id string, status LeagueStatus, rejectionReason *string, sportID *int64, venueID *int64) error
 	UpdateLeague(ctx context.Context, league *League) error
-	ApproveLeagueWithTransaction(ctx context.Context, id int, sportID *int, venueID *int) error
+	UpdateFieldsByUUID(ctx context.Context, id string, data map[string]interface{}) error
+	ApproveLeague(ctx context.Context, id string, approval *LeagueApproval) error
+	GetForLifecycleUpdate(ctx context.Context, states []LifecycleStatus, dateColumn string, cutoff time.Time) ([]League, error)
+
+	// Revision methods
+	CreateRevision(ctx context.Context, revision *LeagueRevision) error
+	GetRevisionsByLeagueID(ctx context.Context, leagueID string) ([]LeagueRevision, error)
+	GetReviewEvents(ctx context.Context, actions []RevisionAction, since time.Time) ([]LeagueRevision, error)
+
+	// Comment methods
+	CreateComment(ctx context.Context, comment *LeagueComment) error
+	GetCommentsByLeagueID(ctx context.Context, leagueID string) ([]LeagueComment, error)
+
+	// Policy rule methods
+	GetPolicyRules(ctx context.Context) ([]PolicyRule, error)
+	CreatePolicyRule(ctx context.Context, rule *PolicyRule) error
+	UpdatePolicyRule(ctx context.Context, rule *PolicyRule) error
+	DeletePolicyRule(ctx context.Context, id int64) error
+
+	// Schedule exception methods
+	GetScheduleExceptions(ctx context.Context, leagueID string) ([]ScheduleException, error)
+	GetScheduleExceptionsByLeagueIDs(ctx context.Context, leagueIDs []string) ([]ScheduleException, error)
+	CreateScheduleException(ctx context.Context, exception *ScheduleException) error
+	DeleteScheduleException(ctx context.Context, leagueID string, exceptionID int64) error
 
 	// Draft methods
 	GetDraftByOrgID(ctx context.Context, orgID string) (*LeagueDraft, error)
 	GetDraftByID(ctx context.Context, draftID int) (*LeagueDraft, error)
 	SaveDraft(ctx context.Context, draft *LeagueDraft) error
 	DeleteDraftByID(ctx context.Context, draftID int, orgID string) error
+	DeleteStaleDrafts(ctx context.Context, cutoff time.Time) (int, error)
 	GetAllDrafts(ctx context.Context) ([]LeagueDraft, error)
 	GetDraftsByOrgID(ctx context.Context, orgID string) ([]LeagueDraft, error)
 	GetTemplatesByOrgID(ctx context.Context, orgID string) ([]LeagueDraft, error)
@@ -41,12 +83,16 @@ type RepositoryInterface interface {
 
 type Repository struct {
 	client *postgrest.Client
+	sports sports.RepositoryInterface // Sports and venues created by approvals
+	venues venues.RepositoryInterface
 }
 
 // NewRepository creates a repository with a postgrest client
 func NewRepository(client *postgrest.Client) *Repository {
 	return &Repository{
 		client: client,
+		sports: sports.NewRepository(client),
+		venues: venues.NewRepository(client),
 	}
 }
 
@@ -81,48 +127,220 @@ func (r *Repository) GetAllApproved(ctx context.Context) ([]League, error) {
 	return leagues, nil
 }
 
-// GetAllApprovedWithPagination retrieves approved leagues with pagination
-func (r *Repository) GetAllApprovedWithPagination(ctx context.Context, limit, offset int) ([]League, int64, error) {
+// GetBatchAfterID retrieves up to limit leagues matching the filter with an ID greater than afterID, in ID order
+// Used to walk a large result set one batch at a time; pass "" to start from the beginning
+func (r *Repository) GetBatchAfterID(ctx context.Context, afterID string, limit int, filter LeagueFilter) ([]League, error) {
+	query := applyLeagueFilter(r.client.From("leagues").Select("*", "", false), filter)
+	if afterID != "" {
+		query = query.Gt("id", afterID)
+	}
+
+	var leagues []League
+	_, err := query.
+		Order("id", &postgrest.OrderOpts{Ascending: true}).
+		Limit(limit, "").
+		ExecuteToWithContext(ctx, &leagues)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query leagues: %w", err)
+	}
+
+	return leagues, nil
+}
+
+// GetApprovedBySeriesID retrieves every approved season of a league series, newest season first
+// The first season of a series has no series_id of its own, so it is matched by its ID
+func (r *Repository) GetApprovedBySeriesID(ctx context.Context, seriesID string) ([]League, error) {
 	var leagues []League
-	count, err := r.client.From("leagues").
-		Select("*", "exact", false).
+	_, err := r.client.From("leagues").
+		Select("*", "", false).
 		Eq("status", "approved").
-		Range(offset, offset+limit-1, "").
+		Or(fmt.Sprintf("series_id.eq.%s,id.eq.%s", seriesID, seriesID), "").
+		Order("season_start_date", &postgrest.OrderOpts{Ascending: false}).
 		ExecuteToWithContext(ctx, &leagues)
 
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to query leagues: %w", err)
+		return nil, fmt.Errorf("failed to query league seasons: %w", err)
 	}
 
-	return leagues, int64(count), nil
+	return leagues, nil
 }
 
-// GetByID retrieves a league by ID (any status - auth required at route level)
-// Deprecated: Use GetByUUID instead
-func (r *Repository) GetByID(ctx context.Context, id int) (*League, error) {
+// GetApprovedByVenueID retrieves every approved league played at a venue
+func (r *Repository) GetApprovedByVenueID(ctx context.Context, venueID int64) ([]League, error) {
 	var leagues []League
 	_, err := r.client.From("leagues").
 		Select("*", "", false).
-		Eq("id", strconv.Itoa(id)).
+		Eq("status", "approved").
+		Eq("venue_id", strconv.FormatInt(venueID, 10)).
+		Order("season_start_date", &postgrest.OrderOpts{Ascending: true}).
 		ExecuteToWithContext(ctx, &leagues)
 
-	if err != nil || len(leagues) == 0 {
-		return nil, fmt.Errorf("league not found")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query venue leagues: %w", err)
 	}
 
-	return &leagues[0], nil
+	return leagues, nil
+}
+
+// GetDuplicateCandidates retrieves the leagues of any status sharing the organization, sport or venue
+// A nil sport or venue is not matched on
+func (r *Repository) GetDuplicateCandidates(ctx context.Context, orgID string, sportID *int64, venueID *int64) ([]League, error) {
+	conditions := []string{"org_id.eq." + orgID}
+	if sportID != nil {
+		conditions = append(conditions, "sport_id.eq."+strconv.FormatInt(*sportID, 10))
+	}
+	if venueID != nil {
+		conditions = append(conditions, "venue_id.eq."+strconv.FormatInt(*venueID, 10))
+	}
+
+	var leagues []League
+	_, err := r.client.From("leagues").
+		Select("*", "", false).
+		Or(strings.Join(conditions, ","), "").
+		ExecuteToWithContext(ctx, &leagues)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query duplicate candidates: %w", err)
+	}
+
+	return leagues, nil
+}
+
+// GetAllApprovedWithPagination retrieves a page of approved leagues matching the filter
+// Returns up to page.Limit+1 rows (see pagination.Trim) and the total number of matches
+func (r *Repository) GetAllApprovedWithPagination(ctx context.Context, filter LeagueFilter, page pagination.Params) ([]League, int64, error) {
+	return r.getLeaguesPage(ctx, page, func(query *postgrest.FilterBuilder) *postgrest.FilterBuilder {
+		return applyLeagueFilter(query.Eq("status", "approved"), filter)
+	})
+}
+
+// GetAllApprovedFiltered retrieves every approved league matching the filter without pagination
+// Used when results have to be ordered in Go (e.g. by distance)
+func (r *Repository) GetAllApprovedFiltered(ctx context.Context, filter LeagueFilter) ([]League, error) {
+	var leagues []League
+	query := r.client.From("leagues").
+		Select("*", "", false).
+		Eq("status", "approved")
+
+	_, err := applyLeagueFilter(query, filter).
+		ExecuteToWithContext(ctx, &leagues)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query leagues: %w", err)
+	}
+
+	return leagues, nil
+}
+
+// GetApprovedFacetRows retrieves the facet columns of every approved league matching the filter
+// Only the columns needed to count facets are selected to keep the payload small
+func (r *Repository) GetApprovedFacetRows(ctx context.Context, filter LeagueFilter) ([]leagueFacetRow, error) {
+	var rows []leagueFacetRow
+	query := r.client.From("leagues").
+		Select("sport_id,sport_name:form_data->>sport_name,gender,league_game_days", "", false).
+		Eq("status", "approved")
+
+	_, err := applyLeagueFilter(query, filter).
+		ExecuteToWithContext(ctx, &rows)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query league facets: %w", err)
+	}
+
+	return rows, nil
+}
+
+// applyLeagueFilter adds the optional filter criteria to a leagues query
+func applyLeagueFilter(query *postgrest.FilterBuilder, filter LeagueFilter) *postgrest.FilterBuilder {
+	if filter.SportID != nil {
+		query = query.Eq("sport_id", strconv.FormatInt(*filter.SportID, 10))
+	}
+	if filter.Gender != nil {
+		query = query.Ilike("gender", escapeLike(*filter.Gender))
+	}
+	if filter.Division != nil {
+		query = query.Ilike("division", escapeLike(*filter.Division))
+	}
+	if len(filter.Days) > 0 {
+		// league_game_days is a computed column holding lowercase weekday names
+		days := make([]string, len(filter.Days))
+		for i, day := range filter.Days {
+			days[i] = strings.ToLower(day)
+		}
+		query = query.Overlaps("league_game_days", days)
+	}
+	if filter.MinPrice != nil {
+		query = query.Gte("pricing_per_player", strconv.FormatFloat(*filter.MinPrice, 'f', -1, 64))
+	}
+	if filter.MaxPrice != nil {
+		query = query.Lte("pricing_per_player", strconv.FormatFloat(*filter.MaxPrice, 'f', -1, 64))
+	}
+	if filter.DeadlineAfter != nil {
+		query = query.Gte("registration_deadline", filter.DeadlineAfter.Format("2006-01-02"))
+	}
+	if filter.DeadlineBefore != nil {
+		query = query.Lte("registration_deadline", filter.DeadlineBefore.Format("2006-01-02"))
+	}
+	if filter.SeasonStartAfter != nil {
+		query = query.Gte("season_start_date", filter.SeasonStartAfter.Format("2006-01-02"))
+	}
+	if filter.SeasonStartBefore != nil {
+		query = query.Lte("season_start_date", filter.SeasonStartBefore.Format("2006-01-02"))
+	}
+	if filter.VenueIDs != nil {
+		venueIDs := make([]string, len(filter.VenueIDs))
+		for i, id := range filter.VenueIDs {
+			venueIDs[i] = strconv.FormatInt(id, 10)
+		}
+		query = query.In("venue_id", venueIDs)
+	}
+	if filter.LeagueIDs != nil {
+		query = query.In("id", filter.LeagueIDs)
+	}
+	if filter.OrgID != "" {
+		query = query.Eq("org_id", filter.OrgID)
+	}
+	if filter.Status != nil {
+		query = query.Eq("status", filter.Status.String())
+	}
+
+	lifecycle := filter.Lifecycle
+	if len(lifecycle) == 0 {
+		lifecycle = publicLifecycleStatuses
+	}
+	states := make([]string, len(lifecycle))
+	for i, state := range lifecycle {
+		states[i] = state.String()
+	}
+	query = query.In("lifecycle_status", states)
+
+	return query
+}
+
+// GetByID retrieves a league by ID (any status - auth required at route level)
+// Deprecated: Use GetByUUID instead
+func (r *Repository) GetByID(ctx context.Context, id int) (*League, error) {
+	return r.GetByUUID(ctx, strconv.Itoa(id))
 }
 
 // GetByUUID retrieves a league by UUID
 func (r *Repository) GetByUUID(ctx context.Context, id string) (*League, error) {
+	if !isLeagueID(id) {
+		return nil, shared.NotFound("league not found")
+	}
+
 	var leagues []League
 	_, err := r.client.From("leagues").
 		Select("*", "", false).
 		Eq("id", id).
 		ExecuteToWithContext(ctx, &leagues)
 
-	if err != nil || len(leagues) == 0 {
-		return nil, fmt.Errorf("league not found")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query league: %w", err)
+	}
+	if len(leagues) == 0 {
+		return nil, shared.NotFound("league not found")
 	}
 
 	return &leagues[0], nil
@@ -162,8 +380,9 @@ func (r *Repository) GetByOrgIDAndStatus(ctx context.Context, orgID string, stat
 // Create creates a new league in the database
 func (r *Repository) Create(ctx context.Context, league *League) error {
 	now := time.Now()
-	league.CreatedAt = Timestamp{now}
-	league.UpdatedAt = Timestamp{now}
+	league.CreatedAt = Timestamp{Time: now}
+	league.UpdatedAt = Timestamp{Time: now}
+	league.SubmittedAt = &Timestamp{Time: now}
 
 	// Create request body with all league data
 	insertData := map[string]interface{}{
@@ -186,9 +405,15 @@ func (r *Repository) Create(ctx context.Context, league *League) error {
 		"per_game_fee":          league.PerGameFee,
 		"form_data":             league.FormData,
 		"status":                league.Status,
+		"lifecycle_status":      league.LifecycleStatus,
+		"previous_season_id":    league.PreviousSeasonID,
+		"series_id":             league.SeriesID,
 		"created_at":            now,
 		"updated_at":            now,
 		"created_by":            league.CreatedBy,
+		"duplicate_candidates":  league.DuplicateCandidates,
+		"policy_decision":       league.PolicyDecision,
+		"submitted_at":          now,
 	}
 
 	var result []map[string]interface{}
@@ -210,12 +435,15 @@ func (r *Repository) Create(ctx context.Context, league *League) error {
 	return nil
 }
 
-// GetPending retrieves all pending league submissions
+// pendingReviewFilter matches pending submissions and approved leagues with an edit awaiting review
+const pendingReviewFilter = "status.eq.pending,pending_changes.not.is.null"
+
+// GetPending retrieves all league submissions awaiting review, including pending edits of approved leagues
 func (r *Repository) GetPending(ctx context.Context) ([]League, error) {
 	var leagues []League
 	_, err := r.client.From("leagues").
 		Select("*", "", false).
-		Eq("status", "pending").
+		Or(pendingReviewFilter, "").
 		ExecuteToWithContext(ctx, &leagues)
 
 	if err != nil {
@@ -225,35 +453,117 @@ func (r *Repository) GetPending(ctx context.Context) ([]League, error) {
 	return leagues, nil
 }
 
-// GetPendingWithPagination retrieves pending leagues with limit and offset for pagination
-func (r *Repository) GetPendingWithPagination(ctx context.Context, limit, offset int) ([]League, int64, error) {
+// GetPendingWithPagination retrieves a page of leagues awaiting review that pass the queue filter
+// Returns up to page.Limit+1 rows (see pagination.Trim) and the total number of matching leagues
+func (r *Repository) GetPendingWithPagination(ctx context.Context, filter ReviewQueueFilter, page pagination.Params) ([]League, int64, error) {
+	return r.getLeaguesPage(ctx, page, func(query *postgrest.FilterBuilder) *postgrest.FilterBuilder {
+		return query.Or(reviewQueueFilter(filter), "")
+	})
+}
+
+// reviewQueueFilter combines the pending review filter with the queue filter into one PostgREST logic tree
+func reviewQueueFilter(filter ReviewQueueFilter) string {
+	now := postgrestTimestamp(filter.Now)
+	conditions := []string{"or(" + pendingReviewFilter + ")"}
+	if filter.AssignedTo != nil {
+		conditions = append(conditions, "claimed_by.eq."+postgrestQuote(*filter.AssignedTo), "claim_expires_at.gt."+now)
+	}
+	if filter.Unassigned {
+		conditions = append(conditions, "or(claimed_by.is.null,claim_expires_at.lte."+now+")")
+	}
+	if filter.SubmittedBefore != nil {
+		conditions = append(conditions, "submitted_at.lt."+postgrestTimestamp(*filter.SubmittedBefore))
+	}
+	return "and(" + strings.Join(conditions, ",") + ")"
+}
+
+// isLeagueID reports whether id is a UUID, the type of leagues.id
+// Looking up anything else would fail in the query rather than find nothing, so callers treat it as not found
+func isLeagueID(id string) bool {
+	_, err := uuid.Parse(id)
+	return err == nil
+}
+
+// postgrestQuoter escapes the characters that are special inside a double-quoted PostgREST value
+var postgrestQuoter = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// postgrestQuote double-quotes a value for a logic tree, so commas and parentheses in it can't end the condition
+func postgrestQuote(value string) string {
+	return `"` + postgrestQuoter.Replace(value) + `"`
+}
+
+// postgrestTimestamp formats a time for comparison with a TIMESTAMP column
+func postgrestTimestamp(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05")
+}
+
+// GetOverdueReviews retrieves the leagues awaiting review since before submittedBefore that admins have not been told about
+func (r *Repository) GetOverdueReviews(ctx context.Context, submittedBefore time.Time) ([]League, error) {
 	var leagues []League
-	count, err := r.client.From("leagues").
-		Select("*", "exact", false).
-		Eq("status", "pending").
-		Range(offset, offset+limit-1, "").
+	_, err := r.client.From("leagues").
+		Select("*", "", false).
+		Or(pendingReviewFilter, "").
+		Lt("submitted_at", postgrestTimestamp(submittedBefore)).
+		Is("review_escalated_at", "null").
 		ExecuteToWithContext(ctx, &leagues)
 
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to query leagues: %w", err)
+		return nil, fmt.Errorf("failed to query leagues: %w", err)
 	}
 
-	return leagues, int64(count), nil
+	return leagues, nil
 }
 
-// GetAllWithPagination retrieves all leagues regardless of status with limit and offset for pagination
-func (r *Repository) GetAllWithPagination(ctx context.Context, limit, offset int) ([]League, int64, error) {
+// ClaimLeague gives userID the review claim on a league until expiresAt
+// The claim is only taken if nobody else holds one that is still active at now; returns false otherwise
+func (r *Repository) ClaimLeague(ctx context.Context, id string, userID string, now time.Time, expiresAt time.Time) (bool, error) {
+	updateData := map[string]interface{}{
+		"claimed_by":       userID,
+		"claim_expires_at": expiresAt,
+	}
+
+	var claimed []League
+	_, err := r.client.From("leagues").
+		Update(updateData, "representation", "").
+		Eq("id", id).
+		Or("claimed_by.is.null,claimed_by.eq."+postgrestQuote(userID)+",claim_expires_at.lte."+postgrestTimestamp(now), "").
+		ExecuteToWithContext(ctx, &claimed)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to claim league: %w", err)
+	}
+
+	return len(claimed) > 0, nil
+}
+
+// GetAllWithPagination retrieves a page of leagues regardless of status
+// Returns up to page.Limit+1 rows (see pagination.Trim) and the total number of leagues
+func (r *Repository) GetAllWithPagination(ctx context.Context, page pagination.Params) ([]League, int64, error) {
+	return r.getLeaguesPage(ctx, page, func(query *postgrest.FilterBuilder) *postgrest.FilterBuilder {
+		return query
+	})
+}
+
+// getLeaguesPage runs a paged leagues query built by filter
+// With a cursor the page query only sees rows after it, so the total comes from a separate count query
+func (r *Repository) getLeaguesPage(ctx context.Context, page pagination.Params, filter func(*postgrest.FilterBuilder) *postgrest.FilterBuilder) ([]League, int64, error) {
 	var leagues []League
-	count, err := r.client.From("leagues").
-		Select("*", "exact", false).
-		Range(offset, offset+limit-1, "").
+	count, err := page.Apply(filter(r.client.From("leagues").Select("*", "exact", false))).
 		ExecuteToWithContext(ctx, &leagues)
 
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to query leagues: %w", err)
 	}
 
-	return leagues, int64(count), nil
+	if page.Cursor != nil {
+		_, count, err = filter(r.client.From("leagues").Select("id", "exact", true)).
+			ExecuteWithContext(ctx)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to count leagues: %w", err)
+		}
+	}
+
+	return leagues, count, nil
 }
 
 // UpdateStatus updates the status of a league
@@ -328,74 +638,385 @@ func (r *Repository) UpdateLeague(ctx context.Context, league *League) error {
 	return nil
 }
 
-// ApproveLeagueWithTransaction atomically updates sport_id, venue_id, status to approved, and creates game_occurrences
-// With PostgREST, we'll perform multiple operations with RLS enforcing consistency at the database level
-func (r *Repository) ApproveLeagueWithTransaction(ctx context.Context, id int, sportID *int64, venueID *int64) error {
-	// Fetch the league to get game_occurrences
+// UpdateFieldsByUUID updates the given columns of a league by UUID
+// Keys must be leagues column names; updated_at is always set
+func (r *Repository) UpdateFieldsByUUID(ctx context.Context, id string, data map[string]interface{}) error {
+	updateData := map[string]interface{}{
+		"updated_at": time.Now(),
+	}
+	for column, value := range data {
+		updateData[column] = value
+	}
+
+	_, err := r.client.From("leagues").
+		Update(updateData, "", "").
+		Eq("id", id).
+		ExecuteToWithContext(ctx, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to update league: %w", err)
+	}
+
+	return nil
+}
+
+// ApproveLeague finds or creates the sport and venue, replaces the game_occurrences rows and updates the league
+// PostgREST can't span requests with a transaction, so the steps stop at the first error and earlier ones stay,
+// and the league is re-checked without a lock; PgxRepository runs the same steps atomically
+func (r *Repository) ApproveLeague(ctx context.Context, id string, approval *LeagueApproval) error {
+	league, err := r.GetByUUID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkApprovable(league, approval.ReviewedBy, time.Now()); err != nil {
+		return err
+	}
+
+	if err := resolveApprovalEntities(ctx, approval, r.sports, r.venues); err != nil {
+		return err
+	}
+
+	_, _, err = r.client.From("game_occurrences").
+		Delete("", "").
+		Eq("league_id", id).
+		ExecuteWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to clear game occurrences: %w", err)
+	}
+
+	occurrences := occurrencesByDay(approval.GameOccurrences)
+	if len(occurrences) > 0 {
+		rows := make([]map[string]interface{}, len(occurrences))
+		for i, occurrence := range occurrences {
+			rows[i] = map[string]interface{}{
+				"league_id":  id,
+				"day":        occurrence.Day,
+				"start_time": occurrence.StartTime,
+				"end_time":   occurrence.EndTime,
+			}
+		}
+		_, _, err = r.client.From("game_occurrences").
+			Insert(rows, false, "", "", "").
+			ExecuteWithContext(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create game occurrences: %w", err)
+		}
+	}
+
+	return r.UpdateFieldsByUUID(ctx, id, approvalColumns(approval))
+}
+
+// GetForLifecycleUpdate retrieves leagues in one of the given lifecycle states whose date column is before cutoff
+// Used by the background jobs that move leagues along as their dates pass
+func (r *Repository) GetForLifecycleUpdate(ctx context.Context, states []LifecycleStatus, dateColumn string, cutoff time.Time) ([]League, error) {
+	values := make([]string, len(states))
+	for i, state := range states {
+		values[i] = state.String()
+	}
+
 	var leagues []League
 	_, err := r.client.From("leagues").
 		Select("*", "", false).
-		Eq("id", strconv.Itoa(id)).
+		In("lifecycle_status", values).
+		Lt(dateColumn, cutoff.Format("2006-01-02")).
 		ExecuteToWithContext(ctx, &leagues)
 
-	if err != nil || len(leagues) == 0 {
-		return fmt.Errorf("failed to fetch league: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query leagues: %w", err)
 	}
 
-	league := leagues[0]
+	return leagues, nil
+}
+
+// ============= REVISION METHODS =============
 
-	// Insert game_occurrences into the separate table
-	for _, occurrence := range league.GameOccurrences {
-		gameOccData := map[string]interface{}{
-			"league_id":  league.ID,
-			"day":        occurrence.Day,
-			"start_time": occurrence.StartTime,
-			"end_time":   occurrence.EndTime,
-		}
+// CreateRevision stores a league revision; the revision number is assigned by the database
+func (r *Repository) CreateRevision(ctx context.Context, revision *LeagueRevision) error {
+	insertData := map[string]interface{}{
+		"league_id":      revision.LeagueID,
+		"action":         revision.Action.String(),
+		"status":         revision.Status.String(),
+		"snapshot":       revision.Snapshot,
+		"changed_fields": revision.ChangedFields,
+		"note":           revision.Note,
+		"created_by":     revision.CreatedBy,
+	}
 
-		// Insert (ignoring errors for now - if it exists, that's fine)
-		_, _ = r.client.From("game_occurrences").
-			Insert(gameOccData, true, "", "", "").
-			ExecuteToWithContext(ctx, nil)
+	var result []LeagueRevision
+	_, err := r.client.From("league_revisions").
+		Insert(insertData, false, "", "representation", "").
+		ExecuteToWithContext(ctx, &result)
+
+	if err != nil {
+		return fmt.Errorf("failed to create league revision: %w", err)
+	}
+
+	if len(result) > 0 {
+		revision.ID = result[0].ID
+		revision.RevisionNumber = result[0].RevisionNumber
+		revision.CreatedAt = result[0].CreatedAt
+	}
+
+	return nil
+}
+
+// GetRevisionsByLeagueID retrieves all revisions of a league, oldest first
+func (r *Repository) GetRevisionsByLeagueID(ctx context.Context, leagueID string) ([]LeagueRevision, error) {
+	var revisions []LeagueRevision
+	_, err := r.client.From("league_revisions").
+		Select("*", "", false).
+		Eq("league_id", leagueID).
+		Order("revision_number", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteToWithContext(ctx, &revisions)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query league revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// GetReviewEvents retrieves the revisions with the given actions recorded since a time, oldest first
+// Snapshots are not loaded
+func (r *Repository) GetReviewEvents(ctx context.Context, actions []RevisionAction, since time.Time) ([]LeagueRevision, error) {
+	values := make([]string, len(actions))
+	for i, action := range actions {
+		values[i] = action.String()
+	}
+
+	var revisions []LeagueRevision
+	_, err := r.client.From("league_revisions").
+		Select("id,league_id,revision_number,action,status,created_by,created_at", "", false).
+		In("action", values).
+		Gte("created_at", postgrestTimestamp(since)).
+		Order("id", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteToWithContext(ctx, &revisions)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query league revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// ============= COMMENT METHODS =============
+
+// CreateComment stores a comment in a league's review thread, filling in its ID and creation time
+func (r *Repository) CreateComment(ctx context.Context, comment *LeagueComment) error {
+	insertData := map[string]interface{}{
+		"league_id":   comment.LeagueID,
+		"author_id":   comment.AuthorID,
+		"author_role": string(comment.AuthorRole),
+		"body":        comment.Body,
+		"fields":      comment.Fields,
+	}
+	if comment.Status != "" {
+		insertData["status"] = comment.Status.String()
+	}
+
+	var result []LeagueComment
+	_, err := r.client.From("league_comments").
+		Insert(insertData, false, "", "representation", "").
+		ExecuteToWithContext(ctx, &result)
+
+	if err != nil {
+		return fmt.Errorf("failed to create league comment: %w", err)
+	}
+
+	if len(result) > 0 {
+		comment.ID = result[0].ID
+		comment.CreatedAt = result[0].CreatedAt
 	}
 
-	// Update sport_id and venue_id if they changed
+	return nil
+}
+
+// GetCommentsByLeagueID retrieves a league's review thread, oldest first
+func (r *Repository) GetCommentsByLeagueID(ctx context.Context, leagueID string) ([]LeagueComment, error) {
+	var comments []LeagueComment
+	_, err := r.client.From("league_comments").
+		Select("*", "", false).
+		Eq("league_id", leagueID).
+		Order("id", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteToWithContext(ctx, &comments)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query league comments: %w", err)
+	}
+
+	return comments, nil
+}
+
+// ============= POLICY RULE METHODS =============
+
+// GetPolicyRules retrieves the auto-approval policy rules in the order they're tried
+func (r *Repository) GetPolicyRules(ctx context.Context) ([]PolicyRule, error) {
+	var rules []PolicyRule
+	_, err := r.client.From("league_policy_rules").
+		Select("*", "", false).
+		Order("priority", &postgrest.OrderOpts{Ascending: true}).
+		Order("id", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteToWithContext(ctx, &rules)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query policy rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// CreatePolicyRule stores a policy rule, filling in its ID and timestamps
+func (r *Repository) CreatePolicyRule(ctx context.Context, rule *PolicyRule) error {
+	insertData := map[string]interface{}{
+		"name":       rule.Name,
+		"priority":   rule.Priority,
+		"outcome":    string(rule.Outcome),
+		"conditions": rule.Conditions,
+		"enabled":    rule.Enabled,
+		"created_by": rule.CreatedBy,
+	}
+
+	var result []PolicyRule
+	_, err := r.client.From("league_policy_rules").
+		Insert(insertData, false, "", "representation", "").
+		ExecuteToWithContext(ctx, &result)
+
+	if err != nil {
+		return fmt.Errorf("failed to create policy rule: %w", err)
+	}
+
+	if len(result) > 0 {
+		rule.ID = result[0].ID
+		rule.CreatedAt = result[0].CreatedAt
+		rule.UpdatedAt = result[0].UpdatedAt
+	}
+
+	return nil
+}
+
+// UpdatePolicyRule replaces a policy rule's settings, filling in its stored fields
+func (r *Repository) UpdatePolicyRule(ctx context.Context, rule *PolicyRule) error {
 	updateData := map[string]interface{}{
-		"updated_at": time.Now(),
+		"name":       rule.Name,
+		"priority":   rule.Priority,
+		"outcome":    string(rule.Outcome),
+		"conditions": rule.Conditions,
+		"enabled":    rule.Enabled,
+		"updated_at": time.Now().UTC().Format("2006-01-02T15:04:05"),
 	}
 
-	if sportID != nil {
-		updateData["sport_id"] = *sportID
+	var updated []PolicyRule
+	_, err := r.client.From("league_policy_rules").
+		Update(updateData, "representation", "").
+		Eq("id", strconv.FormatInt(rule.ID, 10)).
+		ExecuteToWithContext(ctx, &updated)
+
+	if err != nil {
+		return fmt.Errorf("failed to update policy rule: %w", err)
 	}
-	if venueID != nil {
-		updateData["venue_id"] = *venueID
+
+	if len(updated) == 0 {
+		return shared.NotFound("policy rule not found")
 	}
 
-	if sportID != nil || venueID != nil {
-		_, err = r.client.From("leagues").
-			Update(updateData, "", "").
-			Eq("id", strconv.Itoa(id)).
-			ExecuteToWithContext(ctx, nil)
+	*rule = updated[0]
+	return nil
+}
 
-		if err != nil {
-			return fmt.Errorf("failed to update league IDs: %w", err)
-		}
+// DeletePolicyRule removes a policy rule
+func (r *Repository) DeletePolicyRule(ctx context.Context, id int64) error {
+	var deleted []PolicyRule
+	_, err := r.client.From("league_policy_rules").
+		Delete("representation", "").
+		Eq("id", strconv.FormatInt(id, 10)).
+		ExecuteToWithContext(ctx, &deleted)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete policy rule: %w", err)
 	}
 
-	// Update status to approved
-	statusData := map[string]interface{}{
-		"status":           "approved",
-		"rejection_reason": nil,
-		"updated_at":       time.Now(),
+	if len(deleted) == 0 {
+		return shared.NotFound("policy rule not found")
 	}
 
-	_, err = r.client.From("leagues").
-		Update(statusData, "", "").
-		Eq("id", strconv.Itoa(id)).
-		ExecuteToWithContext(ctx, nil)
+	return nil
+}
+
+// ============= SCHEDULE EXCEPTION METHODS =============
+
+// GetScheduleExceptions retrieves a league's blackout dates and game changes, by date
+func (r *Repository) GetScheduleExceptions(ctx context.Context, leagueID string) ([]ScheduleException, error) {
+	return r.GetScheduleExceptionsByLeagueIDs(ctx, []string{leagueID})
+}
+
+// GetScheduleExceptionsByLeagueIDs retrieves the schedule exceptions of several leagues at once, by date
+func (r *Repository) GetScheduleExceptionsByLeagueIDs(ctx context.Context, leagueIDs []string) ([]ScheduleException, error) {
+	if len(leagueIDs) == 0 {
+		return nil, nil
+	}
+
+	var exceptions []ScheduleException
+	_, err := r.client.From("league_schedule_exceptions").
+		Select("*", "", false).
+		In("league_id", leagueIDs).
+		Order("game_date", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteToWithContext(ctx, &exceptions)
 
 	if err != nil {
-		return fmt.Errorf("failed to update league status: %w", err)
+		return nil, fmt.Errorf("failed to query schedule exceptions: %w", err)
+	}
+
+	return exceptions, nil
+}
+
+// CreateScheduleException stores a schedule exception, filling in its ID and creation time
+func (r *Repository) CreateScheduleException(ctx context.Context, exception *ScheduleException) error {
+	insertData := map[string]interface{}{
+		"league_id":      exception.LeagueID,
+		"kind":           string(exception.Kind),
+		"game_date":      exception.GameDate.Format("2006-01-02"),
+		"start_time":     exception.StartTime,
+		"new_start_time": exception.NewStartTime,
+		"new_end_time":   exception.NewEndTime,
+		"reason":         exception.Reason,
+		"created_by":     exception.CreatedBy,
+	}
+	if exception.NewDate != nil {
+		insertData["new_date"] = exception.NewDate.Format("2006-01-02")
+	}
+
+	var result []ScheduleException
+	_, err := r.client.From("league_schedule_exceptions").
+		Insert(insertData, false, "", "representation", "").
+		ExecuteToWithContext(ctx, &result)
+
+	if err != nil {
+		return fmt.Errorf("failed to create schedule exception: %w", err)
+	}
+
+	if len(result) > 0 {
+		exception.ID = result[0].ID
+		exception.CreatedAt = result[0].CreatedAt
+	}
+
+	return nil
+}
+
+// DeleteScheduleException removes one of a league's schedule exceptions
+func (r *Repository) DeleteScheduleException(ctx context.Context, leagueID string, exceptionID int64) error {
+	var deleted []ScheduleException
+	_, err := r.client.From("league_schedule_exceptions").
+		Delete("representation", "").
+		Eq("id", strconv.FormatInt(exceptionID, 10)).
+		Eq("league_id", leagueID).
+		ExecuteToWithContext(ctx, &deleted)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule exception: %w", err)
+	}
+
+	if len(deleted) == 0 {
+		return shared.NotFound("schedule exception not found")
 	}
 
 	return nil
@@ -426,8 +1047,11 @@ func (r *Repository) GetDraftByID(ctx context.Context, draftID int) (*LeagueDraf
 		Eq("id", strconv.Itoa(draftID)).
 		ExecuteToWithContext(ctx, &drafts)
 
-	if err != nil || len(drafts) == 0 {
-		return nil, fmt.Errorf("draft not found")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query draft: %w", err)
+	}
+	if len(drafts) == 0 {
+		return nil, shared.NotFound("draft not found")
 	}
 
 	return &drafts[0], nil
@@ -505,6 +1129,23 @@ func (r *Repository) DeleteDraftByID(ctx context.Context, draftID int, orgID str
 	return nil
 }
 
+// DeleteStaleDrafts deletes drafts (not templates) last updated before cutoff
+// Returns the number of drafts deleted
+func (r *Repository) DeleteStaleDrafts(ctx context.Context, cutoff time.Time) (int, error) {
+	var deleted []LeagueDraft
+	_, err := r.client.From("leagues_drafts").
+		Delete("representation", "").
+		Eq("type", string(DraftTypeDraft)).
+		Lt("updated_at", cutoff.UTC().Format("2006-01-02T15:04:05")).
+		ExecuteToWithContext(ctx, &deleted)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale drafts: %w", err)
+	}
+
+	return len(deleted), nil
+}
+
 // GetAllDrafts retrieves all league drafts across all organizations (admin only)
 func (r *Repository) GetAllDrafts(ctx context.Context) ([]LeagueDraft, error) {
 	var drafts []LeagueDraft
@@ -574,32 +1215,43 @@ func (r *Repository) UpdateTemplate(ctx context.Context, template *LeagueDraft)
 		"updated_at": time.Now(),
 	}
 
+	var updated []LeagueDraft
 	_, err := r.client.From("leagues_drafts").
-		Update(updateData, "", "").
+		Update(updateData, "representation", "").
 		Eq("id", strconv.Itoa(template.ID)).
 		Eq("org_id", template.OrgID).
 		Eq("type", "template").
-		ExecuteToWithContext(ctx, nil)
+		ExecuteToWithContext(ctx, &updated)
 
 	if err != nil {
 		return fmt.Errorf("failed to update template: %w", err)
 	}
 
+	// The org_id filter hides other organizations' templates, so a missing row may also mean no access
+	if len(updated) == 0 {
+		return shared.NotFound("template not found or access denied")
+	}
+
 	return nil
 }
 
 // DeleteTemplate deletes a template (with org_id validation)
 func (r *Repository) DeleteTemplate(ctx context.Context, templateID int, orgID string) error {
+	var deleted []LeagueDraft
 	_, err := r.client.From("leagues_drafts").
-		Delete("", "").
+		Delete("representation", "").
 		Eq("id", strconv.Itoa(templateID)).
 		Eq("org_id", orgID).
 		Eq("type", "template").
-		ExecuteToWithContext(ctx, nil)
+		ExecuteToWithContext(ctx, &deleted)
 
 	if err != nil {
 		return fmt.Errorf("failed to delete template: %w", err)
 	}
 
+	if len(deleted) == 0 {
+		return shared.NotFound("template not found or access denied")
+	}
+
 	return nil
 }
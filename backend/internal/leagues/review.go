@@ -0,0 +1,232 @@
+package leagues
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// claimDuration is how long a claim on a review lasts; claiming again renews it
+const claimDuration = 30 * time.Minute
+
+// Limits of the days parameter of the review metrics
+const (
+	defaultMetricsDays = 30
+	maxMetricsDays     = 365
+)
+
+// backlogBuckets are the age ranges the review backlog is counted in, in hours
+var backlogBuckets = []struct {
+	label    string
+	minHours int
+	maxHours int // 0 for the open-ended last bucket
+}{
+	{"under_1d", 0, 24},
+	{"1d_to_3d", 24, 72},
+	{"3d_to_7d", 72, 168},
+	{"over_7d", 168, 0},
+}
+
+// submissionActions start the review clock; decisionActions stop it
+var (
+	submissionActions = []RevisionAction{RevisionCreated, RevisionResubmitted, RevisionEditSubmitted}
+	decisionActions   = []RevisionAction{RevisionApproved, RevisionRejected, RevisionEditApproved, RevisionEditRejected, RevisionChangesRequested}
+)
+
+// reviewEventActions lists the revision actions the review metrics read
+func reviewEventActions() []RevisionAction {
+	return append(append([]RevisionAction{}, submissionActions...), decisionActions...)
+}
+
+// parseReviewQueueFilter reads the moderation queue filters from the query string
+// assignee is an admin's user ID, "me" for the caller or "none" for unclaimed leagues; older_than is a duration such as 48h
+func parseReviewQueueFilter(query url.Values, userID string, now time.Time) (ReviewQueueFilter, error) {
+	filter := ReviewQueueFilter{Now: now}
+
+	switch assignee := strings.TrimSpace(query.Get("assignee")); assignee {
+	case "":
+	case "none":
+		filter.Unassigned = true
+	case "me":
+		filter.AssignedTo = &userID
+	default:
+		filter.AssignedTo = &assignee
+	}
+
+	if v := strings.TrimSpace(query.Get("older_than")); v != "" {
+		age, err := time.ParseDuration(v)
+		if err != nil || age <= 0 {
+			return filter, fmt.Errorf("invalid older_than: must be a positive duration such as 48h")
+		}
+		cutoff := now.Add(-age)
+		filter.SubmittedBefore = &cutoff
+	}
+
+	return filter, nil
+}
+
+// parseMetricsDays reads the days parameter of the review metrics
+func parseMetricsDays(query url.Values) (int, error) {
+	v := strings.TrimSpace(query.Get("days"))
+	if v == "" {
+		return defaultMetricsDays, nil
+	}
+	days, err := strconv.Atoi(v)
+	if err != nil || days < 1 || days > maxMetricsDays {
+		return 0, fmt.Errorf("invalid days: must be between 1 and %d", maxMetricsDays)
+	}
+	return days, nil
+}
+
+// awaitingReview matches pending submissions and approved leagues with an edit awaiting review
+func awaitingReview(league *League) bool {
+	return league.Status == LeagueStatusPending || league.PendingChanges != nil
+}
+
+// activeClaimant returns the admin holding an unexpired claim on a league, or ""
+func activeClaimant(league *League, now time.Time) string {
+	if league.ClaimedBy == nil || league.ClaimExpiresAt == nil || !league.ClaimExpiresAt.After(now) {
+		return ""
+	}
+	return *league.ClaimedBy
+}
+
+// checkReviewClaim returns a Conflict error if another admin holds an unexpired claim on the league
+func checkReviewClaim(league *League, userID string, now time.Time) error {
+	if claimant := activeClaimant(league, now); claimant != "" && claimant != userID {
+		return shared.Conflict("league is being reviewed by another admin until %s", league.ClaimExpiresAt.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// checkApprovable re-checks a league where the store locks it for approval, so concurrent decisions serialize:
+// it must still await review and no other admin may have claimed it since the service looked
+func checkApprovable(league *League, reviewer string, now time.Time) error {
+	if !awaitingReview(league) {
+		return shared.Conflict("league is not awaiting review")
+	}
+	return checkReviewClaim(league, reviewer, now)
+}
+
+// withClaimReleased adds the columns that clear a league's review claim to an update
+func withClaimReleased(columns map[string]interface{}) map[string]interface{} {
+	columns["claimed_by"] = nil
+	columns["claim_expires_at"] = nil
+	return columns
+}
+
+// requeuedColumns are the columns set when a league enters the review queue again
+func requeuedColumns(now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"submitted_at":        Timestamp{Time: now},
+		"review_escalated_at": nil,
+	}
+}
+
+// queuedAt returns when a league entered the review queue
+// Leagues that were never resubmitted have no submitted_at and have waited since they were created
+func queuedAt(league *League) time.Time {
+	if league.SubmittedAt != nil {
+		return league.SubmittedAt.Time
+	}
+	return league.CreatedAt.Time
+}
+
+// matchesReviewQueueFilter reports whether a league awaiting review passes the filter
+func matchesReviewQueueFilter(league *League, filter ReviewQueueFilter) bool {
+	claimant := activeClaimant(league, filter.Now)
+	if filter.AssignedTo != nil && claimant != *filter.AssignedTo {
+		return false
+	}
+	if filter.Unassigned && claimant != "" {
+		return false
+	}
+	if filter.SubmittedBefore != nil && !queuedAt(league).Before(*filter.SubmittedBefore) {
+		return false
+	}
+	return true
+}
+
+// reviewDurations measures how long each decision took from the submission it answered
+// events must be ordered oldest first. The clock starts at the first submission after the previous decision,
+// so edits to a league already in the queue don't restart it. Admin-created leagues, approved on creation,
+// never enter the queue.
+func reviewDurations(events []LeagueRevision) []time.Duration {
+	submitted := make(map[string]time.Time)
+	var durations []time.Duration
+	for _, event := range events {
+		switch {
+		case containsAction(submissionActions, event.Action):
+			if event.Action != RevisionEditSubmitted && event.Status != LeagueStatusPending {
+				continue
+			}
+			if _, waiting := submitted[event.LeagueID]; !waiting {
+				submitted[event.LeagueID] = event.CreatedAt.Time
+			}
+		case containsAction(decisionActions, event.Action):
+			start, waiting := submitted[event.LeagueID]
+			if !waiting {
+				continue
+			}
+			durations = append(durations, event.CreatedAt.Sub(start))
+			delete(submitted, event.LeagueID)
+		}
+	}
+	return durations
+}
+
+// containsAction reports whether actions includes action
+func containsAction(actions []RevisionAction, action RevisionAction) bool {
+	for _, candidate := range actions {
+		if candidate == action {
+			return true
+		}
+	}
+	return false
+}
+
+// percentileHours returns the p-th percentile (0-1) of the durations in hours, by the nearest-rank method
+// Returns nil when there are no durations
+func percentileHours(durations []time.Duration, p float64) *float64 {
+	if len(durations) == 0 {
+		return nil
+	}
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	hours := math.Round(sorted[rank].Hours()*100) / 100
+	return &hours
+}
+
+// countBacklog counts the leagues awaiting review in each age bucket
+func countBacklog(pending []League, now time.Time) []BacklogBucket {
+	buckets := make([]BacklogBucket, len(backlogBuckets))
+	for i, bucket := range backlogBuckets {
+		buckets[i] = BacklogBucket{Label: bucket.label, MinHours: bucket.minHours}
+		if bucket.maxHours > 0 {
+			maxHours := bucket.maxHours
+			buckets[i].MaxHours = &maxHours
+		}
+	}
+
+	for i := range pending {
+		age := now.Sub(queuedAt(&pending[i])).Hours()
+		for j, bucket := range backlogBuckets {
+			if bucket.maxHours == 0 || age < float64(bucket.maxHours) {
+				buckets[j].Count++
+				break
+			}
+		}
+	}
+	return buckets
+}
@@ -0,0 +1,283 @@
+package leagues
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+func TestClaimLeague(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	league := env.submitTestLeague(t)
+
+	if _, err := env.service.ClaimLeague(ctx, "organizer", *league.ID); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected forbidden for an organizer, got %v", err)
+	}
+
+	claim, err := env.service.ClaimLeague(ctx, "admin", *league.ID)
+	if err != nil {
+		t.Fatalf("claim league: %v", err)
+	}
+	if claim.ClaimedBy != "admin" || !claim.ExpiresAt.After(time.Now()) {
+		t.Errorf("unexpected claim %+v", claim)
+	}
+	// Claiming again renews the claim
+	if _, err := env.service.ClaimLeague(ctx, "admin", *league.ID); err != nil {
+		t.Errorf("expected the claimant to renew the claim, got %v", err)
+	}
+
+	if err := env.service.ReleaseLeagueClaim(ctx, "admin", *league.ID); err != nil {
+		t.Fatalf("release claim: %v", err)
+	}
+	stored, _ := env.repo.GetByUUID(ctx, *league.ID)
+	if stored.ClaimedBy != nil || stored.ClaimExpiresAt != nil {
+		t.Errorf("expected the claim to be cleared, got %v until %v", stored.ClaimedBy, stored.ClaimExpiresAt)
+	}
+}
+
+func TestClaimLeagueHeldByAnotherAdmin(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	league := env.submitTestLeague(t)
+	now := time.Now()
+
+	if claimed, err := env.repo.ClaimLeague(ctx, *league.ID, "other-admin", now, now.Add(claimDuration)); err != nil || !claimed {
+		t.Fatalf("claim league: %v, %v", claimed, err)
+	}
+
+	if _, err := env.service.ClaimLeague(ctx, "admin", *league.ID); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict claiming a held league, got %v", err)
+	}
+	if err := env.service.ReleaseLeagueClaim(ctx, "admin", *league.ID); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict releasing another admin's claim, got %v", err)
+	}
+	if err := env.service.ApproveLeagueByUUID(ctx, "admin", *league.ID); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict approving a league claimed by another admin, got %v", err)
+	}
+	if err := env.service.RejectLeagueByUUID(ctx, "admin", *league.ID, "Spam"); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict rejecting a league claimed by another admin, got %v", err)
+	}
+
+	// Once the claim lapses anyone can take the league, and deciding it releases the claim
+	if claimed, _ := env.repo.ClaimLeague(ctx, *league.ID, "other-admin", now, now.Add(-time.Minute)); !claimed {
+		t.Fatal("expected the claimant to shorten their own claim")
+	}
+	if _, err := env.service.ClaimLeague(ctx, "admin", *league.ID); err != nil {
+		t.Fatalf("expected an expired claim to be taken over, got %v", err)
+	}
+	if err := env.service.ApproveLeagueByUUID(ctx, "admin", *league.ID); err != nil {
+		t.Fatalf("approve league: %v", err)
+	}
+	stored, _ := env.repo.GetByUUID(ctx, *league.ID)
+	if stored.ClaimedBy != nil {
+		t.Errorf("expected approval to release the claim, got %s", *stored.ClaimedBy)
+	}
+}
+
+func TestCheckApprovable(t *testing.T) {
+	now := time.Now()
+	other := "other-admin"
+	claimed := &Timestamp{Time: now.Add(time.Hour)}
+
+	tests := []struct {
+		name   string
+		league League
+		ok     bool
+	}{
+		{"pending", League{Status: LeagueStatusPending}, true},
+		{"approved with an edit", League{Status: LeagueStatusApproved, PendingChanges: &League{}}, true},
+		{"already approved", League{Status: LeagueStatusApproved}, false},
+		{"rejected", League{Status: LeagueStatusRejected}, false},
+		{"waiting on the organizer", League{Status: LeagueStatusChangesRequested}, false},
+		{"claimed by another admin", League{Status: LeagueStatusPending, ClaimedBy: &other, ClaimExpiresAt: claimed}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkApprovable(&tt.league, "admin", now)
+			if tt.ok != (err == nil) || (err != nil && !errors.Is(err, shared.ErrConflict)) {
+				t.Errorf("checkApprovable() = %v, want ok=%v", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestParseReviewQueueFilter(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	filter, err := parseReviewQueueFilter(url.Values{"assignee": {"me"}, "older_than": {"48h"}}, "admin", now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if filter.AssignedTo == nil || *filter.AssignedTo != "admin" || filter.Unassigned {
+		t.Errorf("expected the caller as assignee, got %+v", filter)
+	}
+	if filter.SubmittedBefore == nil || !filter.SubmittedBefore.Equal(now.Add(-48*time.Hour)) {
+		t.Errorf("expected a cutoff 48h ago, got %v", filter.SubmittedBefore)
+	}
+
+	if filter, _ := parseReviewQueueFilter(url.Values{"assignee": {"none"}}, "admin", now); !filter.Unassigned || filter.AssignedTo != nil {
+		t.Errorf("expected the unassigned filter, got %+v", filter)
+	}
+	for _, v := range []string{"soon", "-1h", "0s"} {
+		if _, err := parseReviewQueueFilter(url.Values{"older_than": {v}}, "admin", now); err == nil {
+			t.Errorf("expected older_than=%s to be rejected", v)
+		}
+	}
+}
+
+func TestReviewQueueFilterQuotesAssignee(t *testing.T) {
+	assignee := `user_1",claimed_by.is.null)\`
+	filter := reviewQueueFilter(ReviewQueueFilter{AssignedTo: &assignee, Now: time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)})
+
+	if want := `claimed_by.eq."user_1\",claimed_by.is.null)\\"`; !strings.Contains(filter, want) {
+		t.Errorf("expected the assignee quoted as %s, got %s", want, filter)
+	}
+}
+
+func TestIsLeagueID(t *testing.T) {
+	for id, want := range map[string]bool{
+		"6f1c2b9e-3d4a-4e5f-8a7b-1c2d3e4f5a6b": true,
+		"7":                                    false,
+		"":                                     false,
+		"6f1c2b9e-3d4a-4e5f-8a7b":              false,
+	} {
+		if got := isLeagueID(id); got != want {
+			t.Errorf("isLeagueID(%q) = %v, want %v", id, got, want)
+		}
+	}
+}
+
+func TestGetPendingLeaguesFiltered(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	claimed := env.submitTestLeague(t)
+	old := env.submitTestLeague(t)
+	now := time.Now()
+
+	if _, err := env.service.ClaimLeague(ctx, "admin", *claimed.ID); err != nil {
+		t.Fatalf("claim league: %v", err)
+	}
+	if err := env.repo.UpdateFieldsByUUID(ctx, *old.ID, map[string]interface{}{"submitted_at": Timestamp{Time: now.Add(-72 * time.Hour)}}); err != nil {
+		t.Fatalf("age league: %v", err)
+	}
+
+	admin := "admin"
+	cutoff := now.Add(-48 * time.Hour)
+	tests := []struct {
+		name   string
+		filter ReviewQueueFilter
+		want   string
+	}{
+		{"assigned to admin", ReviewQueueFilter{AssignedTo: &admin, Now: now}, *claimed.ID},
+		{"unassigned", ReviewQueueFilter{Unassigned: true, Now: now}, *old.ID},
+		{"older than 48h", ReviewQueueFilter{SubmittedBefore: &cutoff, Now: now}, *old.ID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leagues, total, _, err := env.service.GetPendingLeaguesWithPagination(ctx, tt.filter, pagination.Params{Limit: 10})
+			if err != nil {
+				t.Fatalf("get pending: %v", err)
+			}
+			if total != 1 || len(leagues) != 1 || *leagues[0].ID != tt.want {
+				t.Errorf("expected only %s, got %d leagues of %d", tt.want, len(leagues), total)
+			}
+		})
+	}
+
+	// Once the claim expires the league counts as unassigned again
+	leagues, _, _, _ := env.service.GetPendingLeaguesWithPagination(ctx, ReviewQueueFilter{Unassigned: true, Now: now.Add(time.Hour)}, pagination.Params{Limit: 10})
+	if len(leagues) != 2 {
+		t.Errorf("expected both leagues unassigned after the claim expires, got %d", len(leagues))
+	}
+}
+
+func TestReviewDurations(t *testing.T) {
+	start := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	event := func(leagueID string, action RevisionAction, status LeagueStatus, hours int) LeagueRevision {
+		return LeagueRevision{LeagueID: leagueID, Action: action, Status: status, CreatedAt: Timestamp{Time: start.Add(time.Duration(hours) * time.Hour)}}
+	}
+
+	events := []LeagueRevision{
+		event("a", RevisionCreated, LeagueStatusPending, 0),
+		event("a", RevisionResubmitted, LeagueStatusPending, 2), // Still waiting: the clock keeps running
+		event("a", RevisionChangesRequested, LeagueStatusChangesRequested, 10),
+		event("a", RevisionResubmitted, LeagueStatusPending, 20),
+		event("a", RevisionApproved, LeagueStatusApproved, 24),
+		event("b", RevisionCreated, LeagueStatusApproved, 0), // Created by an admin, never queued
+		event("b", RevisionEditSubmitted, LeagueStatusApproved, 30),
+		event("b", RevisionEditRejected, LeagueStatusApproved, 36),
+		event("c", RevisionApproved, LeagueStatusApproved, 5), // Submitted before the window
+	}
+
+	got := reviewDurations(events)
+	want := []time.Duration{10 * time.Hour, 4 * time.Hour, 6 * time.Hour}
+	if !slices.Equal(got, want) {
+		t.Errorf("reviewDurations() = %v, want %v", got, want)
+	}
+
+	if median := percentileHours(got, 0.5); median == nil || *median != 6 {
+		t.Errorf("expected a median of 6h, got %v", median)
+	}
+	if p90 := percentileHours(got, 0.9); p90 == nil || *p90 != 10 {
+		t.Errorf("expected a p90 of 10h, got %v", p90)
+	}
+	if percentileHours(nil, 0.5) != nil {
+		t.Error("expected no percentile without decisions")
+	}
+}
+
+func TestCountBacklog(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	queued := func(hours int) League {
+		return League{SubmittedAt: &Timestamp{Time: now.Add(-time.Duration(hours) * time.Hour)}}
+	}
+
+	buckets := countBacklog([]League{queued(1), queued(23), queued(24), queued(100), queued(400), queued(168)}, now)
+	counts := make([]int, len(buckets))
+	for i, bucket := range buckets {
+		counts[i] = bucket.Count
+	}
+	if !slices.Equal(counts, []int{2, 1, 1, 2}) {
+		t.Errorf("expected backlog counts [2 1 1 2], got %v", counts)
+	}
+	if buckets[len(buckets)-1].MaxHours != nil {
+		t.Error("expected the last bucket to be open-ended")
+	}
+}
+
+func TestEscalateOverdueReviews(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	league := env.submitTestLeague(t)
+	env.submitTestLeague(t)
+	now := time.Now()
+
+	if err := env.repo.UpdateFieldsByUUID(ctx, *league.ID, map[string]interface{}{"submitted_at": Timestamp{Time: now.Add(-72 * time.Hour)}}); err != nil {
+		t.Fatalf("age league: %v", err)
+	}
+
+	result, err := escalateOverdueReviews(ctx, env.repo, env.notifications, 48*time.Hour, now)
+	if err != nil {
+		t.Fatalf("escalate: %v", err)
+	}
+	if result.Affected != 1 {
+		t.Errorf("expected one league escalated, got %d", result.Affected)
+	}
+	if types := env.notificationTypes(t, "admin"); !slices.Contains(types, "league_review_overdue") {
+		t.Errorf("expected the admins to be notified, got %v", types)
+	}
+
+	// The same wait is only escalated once
+	result, err = escalateOverdueReviews(ctx, env.repo, env.notifications, 48*time.Hour, now)
+	if err != nil || result.Affected != 0 {
+		t.Errorf("expected nothing to escalate on the second run, got %d (%v)", result.Affected, err)
+	}
+}
@@ -0,0 +1,121 @@
+package leagues
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// revisionSnapshot copies a league for storage in a revision, without fields that are not part of its state
+func revisionSnapshot(league *League) *League {
+	snapshot := *league
+	snapshot.PendingChanges = nil
+	snapshot.PendingChangesAt = nil
+	snapshot.PendingChangesBy = nil
+	snapshot.DuplicateCandidates = nil
+	snapshot.ClaimedBy = nil
+	snapshot.ClaimExpiresAt = nil
+	snapshot.ReviewEscalatedAt = nil
+	snapshot.PolicyDecision = nil
+	snapshot.DistanceKm = nil
+	snapshot.Relevance = nil
+	return &snapshot
+}
+
+// diffLeagues compares two versions of a league field by field
+// Status and lifecycle status come first, then fields in the order of changedLeagueFields
+func diffLeagues(from, to *League) []FieldChange {
+	changes := []FieldChange{}
+	if from.Status != to.Status {
+		changes = append(changes, FieldChange{Field: "status", From: from.Status, To: to.Status})
+	}
+	// Snapshots taken before lifecycle tracking have no lifecycle status
+	if from.LifecycleStatus != "" && to.LifecycleStatus != "" && from.LifecycleStatus != to.LifecycleStatus {
+		changes = append(changes, FieldChange{Field: "lifecycle_status", From: from.LifecycleStatus, To: to.LifecycleStatus})
+	}
+
+	fromColumns := leagueColumns(from)
+	toColumns := leagueColumns(to)
+
+	for _, field := range changedLeagueFields(from, to) {
+		if field == "game_occurrences" {
+			changes = append(changes, diffGameOccurrences(occurrencesOf(from), occurrencesOf(to)))
+			continue
+		}
+
+		change := FieldChange{Field: field}
+		if _, isColumn := toColumns[field]; isColumn {
+			change.From = normalizeJSON(fromColumns[field])
+			change.To = normalizeJSON(toColumns[field])
+		} else {
+			change.From = normalizeJSON(from.FormData[field])
+			change.To = normalizeJSON(to.FormData[field])
+		}
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// diffGameOccurrences reports the full before/after schedule plus the individual slots added and removed
+func diffGameOccurrences(from, to GameOccurrences) FieldChange {
+	return FieldChange{
+		Field:   "game_occurrences",
+		From:    from,
+		To:      to,
+		Added:   missingOccurrences(to, from),
+		Removed: missingOccurrences(from, to),
+	}
+}
+
+// missingOccurrences returns the occurrences in a that are not in b
+func missingOccurrences(a, b GameOccurrences) []GameOccurrence {
+	present := make(map[string]bool, len(b))
+	for _, occurrence := range b {
+		present[occurrenceKey(occurrence)] = true
+	}
+
+	var missing []GameOccurrence
+	for _, occurrence := range a {
+		if !present[occurrenceKey(occurrence)] {
+			missing = append(missing, occurrence)
+		}
+	}
+	return missing
+}
+
+// occurrenceKey identifies a weekly slot independent of weekday capitalization
+func occurrenceKey(occurrence GameOccurrence) string {
+	day, ok := NormalizeWeekday(occurrence.Day)
+	if !ok {
+		day = strings.TrimSpace(occurrence.Day)
+	}
+	return day + "|" + strings.TrimSpace(occurrence.StartTime) + "|" + strings.TrimSpace(occurrence.EndTime)
+}
+
+// occurrencesOf reads the game occurrences of a league from form_data, where they are stored
+func occurrencesOf(league *League) GameOccurrences {
+	value, ok := league.FormData["game_occurrences"]
+	if !ok || value == nil {
+		return GameOccurrences{}
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return GameOccurrences{}
+	}
+	var occurrences GameOccurrences
+	if err := json.Unmarshal(data, &occurrences); err != nil || occurrences == nil {
+		return GameOccurrences{}
+	}
+	return occurrences
+}
+
+// findRevision returns the revision with the given number, or nil
+func findRevision(revisions []LeagueRevision, number int) *LeagueRevision {
+	for i := range revisions {
+		if revisions[i].RevisionNumber == number {
+			return &revisions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package leagues
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDiffLeagues(t *testing.T) {
+	// A snapshot as backfilled by the migration (to_jsonb of the row) and one written by the API
+	var rejected League
+	backfilled := `{
+		"id": "league-1",
+		"status": "rejected",
+		"league_name": "Tuesday Kickball",
+		"division": "Rec",
+		"sport_id": 3,
+		"registration_deadline": "2025-03-01",
+		"season_start_date": "2025-03-15",
+		"pricing_strategy": "per_team",
+		"pricing_amount": 500,
+		"minimum_team_players": 10,
+		"registration_url": "https://example.com/register",
+		"season_details": "Eight weeks",
+		"form_data": {
+			"sport_id": 3,
+			"sport_name": "Kickball",
+			"registration_url": "https://example.com/register",
+			"season_details": "Eight weeks",
+			"game_occurrences": [{"day": "tuesday", "startTime": "19:00", "endTime": "21:00"}]
+		}
+	}`
+	if err := json.Unmarshal([]byte(backfilled), &rejected); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	resubmitted := storedLeague(t, editTestLeague())
+	resubmitted.Status = LeagueStatusPending
+	amount := 550.0
+	resubmitted.PricingAmount = &amount
+	resubmitted.FormData["game_occurrences"] = GameOccurrences{
+		{Day: "Tuesday", StartTime: "19:00", EndTime: "21:00"},
+		{Day: "Thursday", StartTime: "18:30", EndTime: "20:30"},
+	}
+
+	changes := diffLeagues(&rejected, resubmitted)
+
+	var fields []string
+	for _, change := range changes {
+		fields = append(fields, change.Field)
+	}
+	want := []string{"status", "pricing_amount", "game_occurrences"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Fatalf("diffLeagues() fields = %v, want %v", fields, want)
+	}
+
+	if changes[0].From != LeagueStatusRejected || changes[0].To != LeagueStatusPending {
+		t.Errorf("status change = %v -> %v", changes[0].From, changes[0].To)
+	}
+	if changes[1].From != 500.0 || changes[1].To != 550.0 {
+		t.Errorf("pricing_amount change = %v -> %v, want 500 -> 550", changes[1].From, changes[1].To)
+	}
+
+	occurrences := changes[2]
+	if len(occurrences.Removed) != 0 {
+		t.Errorf("removed = %v, want none (weekday case differs only)", occurrences.Removed)
+	}
+	if len(occurrences.Added) != 1 || occurrences.Added[0].Day != "Thursday" {
+		t.Errorf("added = %v, want the Thursday slot", occurrences.Added)
+	}
+}
+
+func TestDiffLeaguesIdentical(t *testing.T) {
+	league := editTestLeague()
+	if changes := diffLeagues(storedLeague(t, league), league); len(changes) != 0 {
+		t.Errorf("diffLeagues() of identical leagues = %v, want none", changes)
+	}
+}
+
+func TestMissingOccurrences(t *testing.T) {
+	a := GameOccurrences{
+		{Day: "Monday", StartTime: "18:00", EndTime: "19:00"},
+		{Day: "Monday", StartTime: "19:00", EndTime: "20:00"},
+	}
+	b := GameOccurrences{
+		{Day: "MONDAY", StartTime: "18:00", EndTime: "19:00"},
+	}
+
+	missing := missingOccurrences(a, b)
+	if len(missing) != 1 || missing[0].StartTime != "19:00" {
+		t.Errorf("missingOccurrences() = %v, want the 19:00 slot", missing)
+	}
+	if missing := missingOccurrences(b, a); len(missing) != 0 {
+		t.Errorf("missingOccurrences() = %v, want none", missing)
+	}
+}
+
+func TestFindRevision(t *testing.T) {
+	revisions := []LeagueRevision{{RevisionNumber: 1}, {RevisionNumber: 2}}
+	if revision := findRevision(revisions, 2); revision == nil || revision.RevisionNumber != 2 {
+		t.Errorf("findRevision(2) = %v", revision)
+	}
+	if revision := findRevision(revisions, 3); revision != nil {
+		t.Errorf("findRevision(3) = %v, want nil", revision)
+	}
+}
@@ -0,0 +1,239 @@
+package leagues
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/organizations"
+	"github.com/leaguefindr/backend/internal/schedule"
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// leagueSeason turns a league's weekly game pattern, season dates and duration into a schedule.Season
+func leagueSeason(league *League) (schedule.Season, error) {
+	if league.SeasonStartDate == nil {
+		return schedule.Season{}, fmt.Errorf("league has no season start date")
+	}
+	season := schedule.Season{Start: calendarDay(league.SeasonStartDate.Time)}
+	if league.SeasonEndDate != nil {
+		end := calendarDay(league.SeasonEndDate.Time)
+		season.End = &end
+	}
+	if league.Duration != nil && *league.Duration > 0 {
+		season.Weeks = *league.Duration
+	}
+
+	// Game occurrences are only stored in form_data
+	occurrences := league.GameOccurrences
+	if len(occurrences) == 0 {
+		occurrences = occurrencesOf(league)
+	}
+	for _, occurrence := range occurrences {
+		slot, err := schedule.ParseSlot(occurrence.Day, occurrence.StartTime, occurrence.EndTime)
+		if err != nil {
+			return schedule.Season{}, err
+		}
+		season.Slots = append(season.Slots, slot)
+	}
+	return season, nil
+}
+
+// scheduleRules splits stored exceptions and organization holidays into the closed days and game changes of a schedule
+func scheduleRules(exceptions []ScheduleException, holidays []organizations.Holiday) ([]schedule.Closure, []schedule.Exception, error) {
+	closures := make([]schedule.Closure, 0, len(holidays))
+	changes := make([]schedule.Exception, 0, len(exceptions))
+	for _, exception := range exceptions {
+		rule, isClosure, err := scheduleRule(exception)
+		if err != nil {
+			return nil, nil, err
+		}
+		if isClosure {
+			closures = append(closures, schedule.Closure{Date: exception.GameDate.Time, Reason: derefString(exception.Reason)})
+		} else {
+			changes = append(changes, rule)
+		}
+	}
+	// League blackouts come first so their reasons win over a holiday on the same day
+	for _, holiday := range holidays {
+		closures = append(closures, schedule.Closure{Date: holiday.HolidayDate.Time, Reason: holiday.Name})
+	}
+	return closures, changes, nil
+}
+
+// scheduleRule converts a stored exception; the second return value is true for blackouts, which close the whole day
+func scheduleRule(exception ScheduleException) (schedule.Exception, bool, error) {
+	if exception.Kind == ScheduleExceptionBlackout {
+		return schedule.Exception{}, true, nil
+	}
+
+	rule := schedule.Exception{
+		Date:   calendarDay(exception.GameDate.Time),
+		Reason: derefString(exception.Reason),
+	}
+	if exception.StartTime != nil {
+		start, err := schedule.ParseClock(*exception.StartTime)
+		if err != nil {
+			return schedule.Exception{}, false, err
+		}
+		rule.Start = &start
+	}
+
+	switch exception.Kind {
+	case ScheduleExceptionCancelled:
+		rule.Kind = schedule.ExceptionCancel
+	case ScheduleExceptionRescheduled:
+		if exception.NewDate == nil || exception.NewStartTime == nil || exception.NewEndTime == nil {
+			return schedule.Exception{}, false, fmt.Errorf("rescheduled game on %s has no new time", exception.GameDate.Format("2006-01-02"))
+		}
+		start, err := schedule.ParseClock(*exception.NewStartTime)
+		if err != nil {
+			return schedule.Exception{}, false, err
+		}
+		end, err := schedule.ParseClock(*exception.NewEndTime)
+		if err != nil {
+			return schedule.Exception{}, false, err
+		}
+		newDate := calendarDay(exception.NewDate.Time)
+		rule.Kind = schedule.ExceptionReschedule
+		rule.NewStart = start.On(newDate)
+		rule.NewEnd = end.On(newDate)
+		if !rule.NewEnd.After(rule.NewStart) {
+			rule.NewEnd = rule.NewEnd.AddDate(0, 0, 1)
+		}
+	default:
+		return schedule.Exception{}, false, fmt.Errorf("unknown schedule exception kind %q", exception.Kind)
+	}
+	return rule, false, nil
+}
+
+// expandLeagueSchedule lists a league's dated games with its blackouts, organization holidays and game changes applied
+func expandLeagueSchedule(league *League, exceptions []ScheduleException, holidays []organizations.Holiday) (*schedule.Schedule, error) {
+	season, err := leagueSeason(league)
+	if err != nil {
+		return nil, err
+	}
+	closures, changes, err := scheduleRules(exceptions, holidays)
+	if err != nil {
+		return nil, err
+	}
+	return schedule.Expand(season, closures, changes)
+}
+
+// newScheduleException checks a request against the league's current schedule and builds the exception to store
+// Cancelling or rescheduling needs a game on the date (at StartTime, when given); a blackout covers the whole day
+func newScheduleException(league *League, current *schedule.Schedule, userID string, request *CreateScheduleExceptionRequest) (*ScheduleException, error) {
+	date, err := time.Parse("2006-01-02", request.Date)
+	if err != nil {
+		return nil, shared.Validation(shared.FieldError{Field: "date", Message: "must be a date in YYYY-MM-DD format"})
+	}
+
+	exception := &ScheduleException{
+		LeagueID:  *league.ID,
+		Kind:      request.Kind,
+		GameDate:  Date{Time: date},
+		StartTime: request.StartTime,
+		Reason:    request.Reason,
+		CreatedBy: userID,
+	}
+
+	if request.Kind == ScheduleExceptionBlackout {
+		if request.StartTime != nil {
+			return nil, shared.Validation(shared.FieldError{Field: "start_time", Message: "cannot be set; a blackout covers the whole day"})
+		}
+		return exception, nil
+	}
+
+	probe := schedule.Exception{Date: date}
+	if request.StartTime != nil {
+		start, err := schedule.ParseClock(*request.StartTime)
+		if err != nil {
+			return nil, shared.Validation(shared.FieldError{Field: "start_time", Message: "must be a time in HH:MM format"})
+		}
+		probe.Start = &start
+	}
+	var matched []schedule.Game
+	for _, game := range current.Games {
+		if probe.Matches(game) {
+			matched = append(matched, game)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, shared.Validation(shared.FieldError{Field: "date", Message: "has no scheduled game"})
+	}
+
+	if request.Kind == ScheduleExceptionRescheduled {
+		if len(matched) > 1 {
+			return nil, shared.Validation(shared.FieldError{Field: "start_time", Message: fmt.Sprintf("is required to reschedule one of the %d games on %s", len(matched), request.Date)})
+		}
+		if request.NewDate == nil || request.NewStartTime == nil {
+			return nil, shared.BadRequest("new_date and new_start_time are required to reschedule a game")
+		}
+		newDate, err := time.Parse("2006-01-02", *request.NewDate)
+		if err != nil {
+			return nil, shared.Validation(shared.FieldError{Field: "new_date", Message: "must be a date in YYYY-MM-DD format"})
+		}
+		newStart, err := schedule.ParseClock(*request.NewStartTime)
+		if err != nil {
+			return nil, shared.Validation(shared.FieldError{Field: "new_start_time", Message: "must be a time in HH:MM format"})
+		}
+		newEnd := request.NewEndTime
+		if newEnd == nil {
+			// Keep the game's length
+			end := newStart.On(newDate).Add(matched[0].End.Sub(matched[0].Planned))
+			value := end.Format("15:04")
+			newEnd = &value
+		}
+		startTime := matched[0].Planned.Format("15:04")
+		exception.StartTime = &startTime
+		exception.NewDate = &Date{Time: newDate}
+		exception.NewStartTime = request.NewStartTime
+		exception.NewEndTime = newEnd
+	}
+	return exception, nil
+}
+
+// newLeagueScheduleResponse formats an expanded schedule for the API
+func newLeagueScheduleResponse(league *League, expanded *schedule.Schedule, exceptions []ScheduleException) *LeagueScheduleResponse {
+	season, _ := leagueSeason(league)
+	response := &LeagueScheduleResponse{
+		LeagueID:   derefString(league.ID),
+		Duration:   league.Duration,
+		Weeks:      expanded.Weeks,
+		Incomplete: expanded.Incomplete,
+		Games:      make([]ScheduledGame, len(expanded.Games)),
+		Skipped:    make([]SkippedGameDate, len(expanded.Skipped)),
+		Exceptions: exceptions,
+	}
+	if response.Exceptions == nil {
+		response.Exceptions = []ScheduleException{}
+	}
+
+	for i, game := range expanded.Games {
+		scheduled := ScheduledGame{
+			Week:      game.Week,
+			Date:      game.Start.Format("2006-01-02"),
+			Day:       game.Start.Weekday().String(),
+			StartTime: game.Start.Format("15:04"),
+			EndTime:   game.End.Format("15:04"),
+			Status:    string(game.Status),
+			Reason:    game.Reason,
+		}
+		if game.Status == schedule.StatusRescheduled {
+			scheduled.OriginalDate = game.Planned.Format("2006-01-02")
+			scheduled.OriginalStartTime = game.Planned.Format("15:04")
+		}
+		response.Games[i] = scheduled
+	}
+
+	for i, skipped := range expanded.Skipped {
+		response.Skipped[i] = SkippedGameDate{
+			Date:   skipped.Date.Format("2006-01-02"),
+			Day:    skipped.Date.Weekday().String(),
+			Reason: skipped.Reason,
+		}
+		if skipped.Slot < len(season.Slots) {
+			response.Skipped[i].StartTime = season.Slots[skipped.Slot].Start.String()
+		}
+	}
+	return response
+}
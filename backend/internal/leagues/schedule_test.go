@@ -0,0 +1,118 @@
+package leagues
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/organizations"
+	"github.com/leaguefindr/backend/internal/schedule"
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+func scheduleTestLeague() *League {
+	league := cloneTestLeague()
+	league.SeasonEndDate = nil
+	duration := 3
+	league.Duration = &duration
+	league.GameOccurrences = GameOccurrences{
+		{Day: "Tuesday", StartTime: "18:00", EndTime: "20:00"},
+		{Day: "Tuesday", StartTime: "20:00", EndTime: "21:30"},
+	}
+	return league
+}
+
+func TestExpandLeagueSchedule(t *testing.T) {
+	league := scheduleTestLeague()
+	exceptions := []ScheduleException{
+		{Kind: ScheduleExceptionBlackout, GameDate: Date{Time: time.Date(2025, 3, 18, 0, 0, 0, 0, time.UTC)}, Reason: stringPtr("Field maintenance")},
+	}
+	holidays := []organizations.Holiday{
+		{HolidayDate: Date{Time: time.Date(2025, 3, 18, 0, 0, 0, 0, time.UTC)}, Name: "Staff day"},
+		{HolidayDate: Date{Time: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)}, Name: "Holiday"},
+	}
+
+	expanded, err := expandLeagueSchedule(league, exceptions, holidays)
+	if err != nil {
+		t.Fatalf("expandLeagueSchedule: %v", err)
+	}
+	response := newLeagueScheduleResponse(league, expanded, exceptions)
+
+	if response.Weeks != 3 || len(response.Games) != 6 {
+		t.Fatalf("expected 3 weeks of 2 games, got %d weeks %+v", response.Weeks, response.Games)
+	}
+	if first := response.Games[0]; first.Date != "2025-03-25" || first.Day != "Tuesday" || first.StartTime != "18:00" || first.Week != 1 {
+		t.Errorf("unexpected first game %+v", first)
+	}
+	if last := response.Games[5]; last.Date != "2025-04-15" || last.EndTime != "21:30" || last.Week != 3 {
+		t.Errorf("unexpected last game %+v", last)
+	}
+	if len(response.Skipped) != 4 {
+		t.Fatalf("expected both games on both closed days to be skipped, got %+v", response.Skipped)
+	}
+	if response.Skipped[0].Reason != "Field maintenance" || response.Skipped[1].StartTime != "20:00" || response.Skipped[2].Reason != "Holiday" {
+		t.Errorf("unexpected skipped dates %+v", response.Skipped)
+	}
+}
+
+func TestNewScheduleException(t *testing.T) {
+	league := scheduleTestLeague()
+	current, err := expandLeagueSchedule(league, nil, nil)
+	if err != nil {
+		t.Fatalf("expandLeagueSchedule: %v", err)
+	}
+
+	t.Run("cancel every game of a day", func(t *testing.T) {
+		exception, err := newScheduleException(league, current, "user-1", &CreateScheduleExceptionRequest{Kind: ScheduleExceptionCancelled, Date: "2025-03-25"})
+		if err != nil {
+			t.Fatalf("newScheduleException: %v", err)
+		}
+		if exception.LeagueID != "league-1" || exception.StartTime != nil || exception.CreatedBy != "user-1" {
+			t.Errorf("unexpected exception %+v", exception)
+		}
+	})
+
+	t.Run("no game that day", func(t *testing.T) {
+		_, err := newScheduleException(league, current, "user-1", &CreateScheduleExceptionRequest{Kind: ScheduleExceptionCancelled, Date: "2025-03-26"})
+		if !errors.Is(err, shared.ErrValidation) || !strings.Contains(err.Error(), "no scheduled game") {
+			t.Errorf("expected a no game error, got %v", err)
+		}
+	})
+
+	t.Run("reschedule needs a single game", func(t *testing.T) {
+		request := &CreateScheduleExceptionRequest{Kind: ScheduleExceptionRescheduled, Date: "2025-03-25", NewDate: stringPtr("2025-03-27"), NewStartTime: stringPtr("19:00")}
+		if _, err := newScheduleException(league, current, "user-1", request); err == nil {
+			t.Errorf("expected an error when several games share the date")
+		}
+
+		request.StartTime = stringPtr("20:00")
+		exception, err := newScheduleException(league, current, "user-1", request)
+		if err != nil {
+			t.Fatalf("newScheduleException: %v", err)
+		}
+		if *exception.NewEndTime != "20:30" || exception.NewDate.Format("2006-01-02") != "2025-03-27" {
+			t.Errorf("expected the game to keep its 90 minutes, got %+v", exception)
+		}
+
+		expanded, err := expandLeagueSchedule(league, []ScheduleException{*exception}, nil)
+		if err != nil {
+			t.Fatalf("expandLeagueSchedule: %v", err)
+		}
+		moved := expanded.Games[3]
+		if moved.Status != schedule.StatusRescheduled || moved.Start.Format("2006-01-02 15:04") != "2025-03-27 19:00" {
+			t.Errorf("expected the rescheduled game to move, got %+v", moved)
+		}
+	})
+
+	t.Run("blackout covers the whole day", func(t *testing.T) {
+		request := &CreateScheduleExceptionRequest{Kind: ScheduleExceptionBlackout, Date: "2025-07-04", StartTime: stringPtr("18:00")}
+		if _, err := newScheduleException(league, current, "user-1", request); err == nil {
+			t.Errorf("expected an error for a blackout with a start time")
+		}
+		request.StartTime = nil
+		if _, err := newScheduleException(league, current, "user-1", request); err != nil {
+			t.Errorf("expected a blackout on any date to be accepted, got %v", err)
+		}
+	})
+}
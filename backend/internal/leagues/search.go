@@ -0,0 +1,279 @@
+package leagues
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/leaguefindr/backend/internal/shared"
+	"github.com/supabase-community/postgrest-go"
+)
+
+// maxSearchResults caps how many leagues a text search returns
+// The matching IDs are sent back to PostgREST as an id=in.(...) filter, so this keeps the URL short
+const maxSearchResults = 100
+
+// Field weights used to rank search matches
+// Keep in sync with search_leagues() in the add_league_search migration
+const (
+	searchWeightLeagueName   = 3.0
+	searchWeightSport        = 2.0
+	searchWeightOrganization = 2.0
+	searchWeightDivision     = 1.5
+	searchWeightVenue        = 1.5
+	searchWeightDetails      = 1.0
+)
+
+// SearchHit is an approved league matched by a text search with its relevance score
+type SearchHit struct {
+	LeagueID string  `json:"league_id"`
+	Score    float64 `json:"score"`
+}
+
+// Searcher finds approved leagues matching a free-text query, most relevant first
+// Every query token has to match one of the searchable fields, allowing for typos
+type Searcher interface {
+	Search(ctx context.Context, query string, limit int) ([]SearchHit, error)
+}
+
+// SearchDocument holds the searchable text of a single league
+type SearchDocument struct {
+	LeagueID         string
+	LeagueName       string
+	Division         string
+	SeasonDetails    string
+	OrganizationName string
+	SportName        string
+	VenueName        string
+	VenueAddress     string
+}
+
+// NewSearchDocument builds the searchable text of a league
+// Sport and venue names come from form_data since leagues can reference sports/venues that don't exist yet
+func NewSearchDocument(league League, organizationName string) SearchDocument {
+	doc := SearchDocument{OrganizationName: organizationName}
+	if league.ID != nil {
+		doc.LeagueID = *league.ID
+	}
+	if league.LeagueName != nil {
+		doc.LeagueName = *league.LeagueName
+	}
+	if league.Division != nil {
+		doc.Division = *league.Division
+	}
+	if league.SeasonDetails != nil {
+		doc.SeasonDetails = *league.SeasonDetails
+	}
+	if v, ok := league.FormData["sport_name"].(string); ok {
+		doc.SportName = v
+	}
+	if v, ok := league.FormData["venue_name"].(string); ok {
+		doc.VenueName = v
+	}
+	if v, ok := league.FormData["venue_address"].(string); ok {
+		doc.VenueAddress = v
+	}
+	return doc
+}
+
+// score returns the relevance of the document for the query tokens, or 0 if any token doesn't match
+// Each token counts its best weighted match across the fields
+func (d SearchDocument) score(tokens []string) float64 {
+	fields := []struct {
+		words  []string
+		weight float64
+	}{
+		{shared.Tokenize(d.LeagueName), searchWeightLeagueName},
+		{shared.Tokenize(d.SportName), searchWeightSport},
+		{shared.Tokenize(d.OrganizationName), searchWeightOrganization},
+		{shared.Tokenize(d.Division), searchWeightDivision},
+		{shared.Tokenize(d.VenueName + " " + d.VenueAddress), searchWeightVenue},
+		{shared.Tokenize(d.SeasonDetails), searchWeightDetails},
+	}
+
+	total := 0.0
+	for _, token := range tokens {
+		best := 0.0
+		for _, field := range fields {
+			best = max(best, field.weight*shared.TokenMatchScore(token, field.words))
+		}
+		if best == 0 {
+			return 0
+		}
+		total += best
+	}
+
+	return total
+}
+
+// PostgresSearcher searches leagues through the search_leagues database function
+type PostgresSearcher struct {
+	clientFn func(ctx context.Context) *postgrest.Client
+}
+
+// NewPostgresSearcher creates a searcher that gets a PostgREST client for each request from clientFn
+func NewPostgresSearcher(clientFn func(ctx context.Context) *postgrest.Client) *PostgresSearcher {
+	return &PostgresSearcher{clientFn: clientFn}
+}
+
+// Search calls search_leagues and returns the ranked hits
+func (p *PostgresSearcher) Search(ctx context.Context, query string, limit int) ([]SearchHit, error) {
+	if len(shared.Tokenize(query)) == 0 {
+		return []SearchHit{}, nil
+	}
+
+	body, err := p.clientFn(ctx).RpcWithError("search_leagues", "", map[string]interface{}{
+		"search_query": query,
+		"max_results":  limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search leagues: %w", err)
+	}
+
+	var hits []SearchHit
+	if err := json.Unmarshal([]byte(body), &hits); err != nil {
+		// PostgREST returns an error object instead of an array when the call fails
+		var rpcErr struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal([]byte(body), &rpcErr) == nil && rpcErr.Message != "" {
+			return nil, fmt.Errorf("failed to search leagues: %s", rpcErr.Message)
+		}
+		return nil, fmt.Errorf("failed to decode search results: %w", err)
+	}
+
+	if hits == nil {
+		hits = []SearchHit{}
+	}
+
+	return hits, nil
+}
+
+// MemorySearcher is an in-memory Searcher used in tests and local development
+// It uses the same tokenization, typo tolerance and field weights as search_leagues
+type MemorySearcher struct {
+	mu   sync.RWMutex
+	docs map[string]SearchDocument
+}
+
+// NewMemorySearcher creates an empty in-memory searcher
+func NewMemorySearcher() *MemorySearcher {
+	return &MemorySearcher{docs: make(map[string]SearchDocument)}
+}
+
+// Index adds or replaces a document
+func (m *MemorySearcher) Index(doc SearchDocument) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.docs[doc.LeagueID] = doc
+}
+
+// Remove deletes the document for a league
+func (m *MemorySearcher) Remove(leagueID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.docs, leagueID)
+}
+
+// Search scores every indexed document and returns the best matches
+func (m *MemorySearcher) Search(ctx context.Context, query string, limit int) ([]SearchHit, error) {
+	tokens := uniqueTokens(shared.Tokenize(query))
+	hits := []SearchHit{}
+	if len(tokens) == 0 {
+		return hits, nil
+	}
+
+	m.mu.RLock()
+	for id, doc := range m.docs {
+		if score := doc.score(tokens); score > 0 {
+			hits = append(hits, SearchHit{LeagueID: id, Score: score})
+		}
+	}
+	m.mu.RUnlock()
+
+	sortSearchHits(hits)
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	return hits, nil
+}
+
+// uniqueTokens drops repeated query tokens so "soccer soccer" scores like "soccer"
+func uniqueTokens(tokens []string) []string {
+	seen := make(map[string]bool, len(tokens))
+	var unique []string
+	for _, token := range tokens {
+		if !seen[token] {
+			seen[token] = true
+			unique = append(unique, token)
+		}
+	}
+	return unique
+}
+
+// sortSearchHits orders hits by score descending, then by league ID for a stable order
+func sortSearchHits(hits []SearchHit) {
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return strings.Compare(hits[i].LeagueID, hits[j].LeagueID) < 0
+	})
+}
+
+// sortByRelevance sets Relevance on each league from its search score and orders them best first
+func sortByRelevance(leagues []League, scores map[string]float64) {
+	for i := range leagues {
+		if leagues[i].ID == nil {
+			continue
+		}
+		if score, ok := scores[*leagues[i].ID]; ok {
+			leagues[i].Relevance = &score
+		}
+	}
+
+	sort.SliceStable(leagues, func(i, j int) bool {
+		a, b := leagues[i].Relevance, leagues[j].Relevance
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return *a > *b
+	})
+}
+
+// repositorySearcher scores the approved leagues of the service's repository in memory
+// Used with repositories that have no search_leagues function, such as MemoryRepository
+type repositorySearcher struct {
+	service *Service
+}
+
+// Search indexes every approved league with its organization name and returns the best matches
+func (r *repositorySearcher) Search(ctx context.Context, query string, limit int) ([]SearchHit, error) {
+	leagues, err := r.service.repository(ctx).GetAllApproved(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search leagues: %w", err)
+	}
+
+	searcher := NewMemorySearcher()
+	orgNames := make(map[string]string)
+	for _, league := range leagues {
+		orgName := ""
+		if league.OrgID != nil && r.service.orgService != nil {
+			name, ok := orgNames[*league.OrgID]
+			if !ok {
+				if org, err := r.service.orgService.GetOrganizationByID(ctx, *league.OrgID); err == nil && org != nil {
+					name = org.OrgName
+				}
+				orgNames[*league.OrgID] = name
+			}
+			orgName = name
+		}
+		searcher.Index(NewSearchDocument(league, orgName))
+	}
+
+	return searcher.Search(ctx, query, limit)
+}
@@ -0,0 +1,151 @@
+package leagues
+
+import (
+	"context"
+	"testing"
+)
+
+func newTestSearcher() *MemorySearcher {
+	searcher := NewMemorySearcher()
+	searcher.Index(SearchDocument{
+		LeagueID:         "soccer-rec",
+		LeagueName:       "Monday Night Soccer",
+		Division:         "Recreational",
+		OrganizationName: "Seattle Sports Club",
+		SportName:        "Soccer",
+		VenueName:        "Green Lake Park",
+		VenueAddress:     "7201 E Green Lake Dr N",
+	})
+	searcher.Index(SearchDocument{
+		LeagueID:         "hockey-a",
+		LeagueName:       "Winter Hockey",
+		Division:         "A",
+		SeasonDetails:    "Bring your own soccer shin guards",
+		OrganizationName: "Rink Rats",
+		SportName:        "Ice Hockey",
+		VenueName:        "Kraken Community Iceplex",
+	})
+	searcher.Index(SearchDocument{
+		LeagueID:   "volleyball",
+		LeagueName: "Beach Volleyball",
+		SportName:  "Volleyball",
+	})
+	return searcher
+}
+
+func TestMemorySearcherRanksByField(t *testing.T) {
+	hits, err := newTestSearcher().Search(context.Background(), "soccer", 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(hits) != 2 {
+		t.Fatalf("expected 2 hits, got %+v", hits)
+	}
+	// A match in the league name outranks a match in the season details
+	if hits[0].LeagueID != "soccer-rec" || hits[1].LeagueID != "hockey-a" {
+		t.Errorf("expected soccer-rec before hockey-a, got %+v", hits)
+	}
+	if hits[0].Score <= hits[1].Score {
+		t.Errorf("expected descending scores, got %+v", hits)
+	}
+}
+
+func TestMemorySearcherTypoTolerance(t *testing.T) {
+	searcher := newTestSearcher()
+
+	tests := []struct {
+		query  string
+		wantID string
+	}{
+		{"socer", "soccer-rec"},
+		{"grean lake", "soccer-rec"},
+		{"hocky", "hockey-a"},
+		{"volleybal", "volleyball"},
+		{"rink", "hockey-a"},
+		{"7201", "soccer-rec"},
+		{"recreation", "soccer-rec"},
+	}
+
+	for _, tt := range tests {
+		hits, err := searcher.Search(context.Background(), tt.query, 10)
+		if err != nil {
+			t.Fatalf("%q: expected no error, got %v", tt.query, err)
+		}
+		if len(hits) == 0 || hits[0].LeagueID != tt.wantID {
+			t.Errorf("%q: expected top hit %s, got %+v", tt.query, tt.wantID, hits)
+		}
+	}
+}
+
+func TestMemorySearcherRequiresEveryToken(t *testing.T) {
+	hits, _ := newTestSearcher().Search(context.Background(), "soccer tennis", 10)
+	if len(hits) != 0 {
+		t.Errorf("expected no hits when a token doesn't match, got %+v", hits)
+	}
+
+	// Two-letter tokens don't get typo tolerance
+	hits, _ = newTestSearcher().Search(context.Background(), "xa", 10)
+	if len(hits) != 0 {
+		t.Errorf("expected no hits for short unmatched token, got %+v", hits)
+	}
+}
+
+func TestMemorySearcherLimitAndRemove(t *testing.T) {
+	searcher := newTestSearcher()
+
+	hits, _ := searcher.Search(context.Background(), "soccer", 1)
+	if len(hits) != 1 {
+		t.Errorf("expected limit to cap hits at 1, got %d", len(hits))
+	}
+
+	searcher.Remove("soccer-rec")
+	hits, _ = searcher.Search(context.Background(), "monday", 10)
+	if len(hits) != 0 {
+		t.Errorf("expected removed league not to match, got %+v", hits)
+	}
+
+	hits, err := searcher.Search(context.Background(), "  !! ", 10)
+	if err != nil || hits == nil || len(hits) != 0 {
+		t.Errorf("expected empty result for query without words, got %+v (err %v)", hits, err)
+	}
+}
+
+func TestNewSearchDocument(t *testing.T) {
+	id := "league-1"
+	name := "Summer Kickball"
+	league := League{
+		ID:         &id,
+		LeagueName: &name,
+		FormData: FormData{
+			"sport_name":    "Kickball",
+			"venue_name":    "Cal Anderson Park",
+			"venue_address": "1635 11th Ave",
+		},
+	}
+
+	doc := NewSearchDocument(league, "Capitol Hill Sports")
+	if doc.LeagueID != id || doc.LeagueName != name || doc.SportName != "Kickball" || doc.VenueName != "Cal Anderson Park" || doc.VenueAddress != "1635 11th Ave" || doc.OrganizationName != "Capitol Hill Sports" {
+		t.Errorf("unexpected document: %+v", doc)
+	}
+}
+
+func TestSortByRelevance(t *testing.T) {
+	leagues := []League{
+		{ID: stringPtr("low")},
+		{ID: stringPtr("unscored")},
+		{ID: stringPtr("high")},
+	}
+
+	sortByRelevance(leagues, map[string]float64{"low": 1.5, "high": 4})
+
+	want := []string{"high", "low", "unscored"}
+	for i, id := range want {
+		if *leagues[i].ID != id {
+			t.Errorf("position %d: expected %s, got %s", i, id, *leagues[i].ID)
+		}
+	}
+	if leagues[0].Relevance == nil || *leagues[0].Relevance != 4 {
+		t.Errorf("expected relevance 4, got %v", leagues[0].Relevance)
+	}
+}
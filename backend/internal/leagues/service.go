@@ -2,15 +2,23 @@ package leagues
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/supabase-community/postgrest-go"
 	"github.com/leaguefindr/backend/internal/auth"
+	"github.com/leaguefindr/backend/internal/ical"
 	"github.com/leaguefindr/backend/internal/notifications"
 	"github.com/leaguefindr/backend/internal/organizations"
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/schedule"
+	"github.com/leaguefindr/backend/internal/shared"
 	"github.com/leaguefindr/backend/internal/sports"
 	"github.com/leaguefindr/backend/internal/venues"
 )
@@ -24,10 +32,13 @@ type Service struct {
 	sportsService         *sports.Service
 	venuesService         *venues.Service
 	notificationsService  *notifications.Service
+	searcher              Searcher
+	repository            func(ctx context.Context) RepositoryInterface // Repository for the caller of a request
+	serviceRepository     RepositoryInterface                           // Repository that bypasses RLS once the service has authorized the caller
 }
 
-func NewService(baseClient *postgrest.Client, baseURL string, apiKey string, orgService *organizations.Service, authService *auth.Service, sportsService *sports.Service, venuesService *venues.Service, notificationsService *notifications.Service) *Service {
-	return &Service{
+func NewService(baseClient *postgrest.Client, serviceClient *postgrest.Client, baseURL string, apiKey string, orgService *organizations.Service, authService *auth.Service, sportsService *sports.Service, venuesService *venues.Service, notificationsService *notifications.Service) *Service {
+	s := &Service{
 		baseClient:            baseClient,
 		baseURL:               baseURL,
 		apiKey:                apiKey,
@@ -36,7 +47,31 @@ func NewService(baseClient *postgrest.Client, baseURL string, apiKey string, org
 		sportsService:         sportsService,
 		venuesService:         venuesService,
 		notificationsService:  notificationsService,
+		serviceRepository:     NewRepository(serviceClient),
 	}
+	s.repository = func(ctx context.Context) RepositoryInterface {
+		return NewRepository(s.getClientWithAuth(ctx))
+	}
+	s.searcher = NewPostgresSearcher(s.getClientWithAuth)
+	return s
+}
+
+// NewServiceWithRepository creates a service that keeps its leagues in repo instead of Supabase
+// Text search scans the approved leagues in repo, see repositorySearcher
+func NewServiceWithRepository(repo RepositoryInterface, orgService *organizations.Service, authService *auth.Service, sportsService *sports.Service, venuesService *venues.Service, notificationsService *notifications.Service) *Service {
+	s := &Service{
+		orgService:           orgService,
+		authService:          authService,
+		sportsService:        sportsService,
+		venuesService:        venuesService,
+		notificationsService: notificationsService,
+		repository: func(ctx context.Context) RepositoryInterface {
+			return repo
+		},
+		serviceRepository: repo,
+	}
+	s.searcher = &repositorySearcher{service: s}
+	return s
 }
 
 // getClientWithAuth creates a new PostgREST client with JWT from context
@@ -68,86 +103,271 @@ func (s *Service) getClientWithAuth(ctx context.Context) *postgrest.Client {
 
 // GetAllLeagues retrieves all leagues (admin only)
 func (s *Service) GetAllLeagues(ctx context.Context) ([]League, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetAll(ctx)
 }
 
-// GetLeaguesByOrgID retrieves all leagues for a specific organization
-func (s *Service) GetLeaguesByOrgID(ctx context.Context, orgID string) ([]League, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+// GetLeaguesByOrgID retrieves all leagues for a specific organization (members and admins only)
+func (s *Service) GetLeaguesByOrgID(ctx context.Context, userID string, orgID string, appRole string) ([]League, error) {
+	if err := s.verifyOrgAccess(ctx, userID, orgID, appRole); err != nil {
+		return nil, err
+	}
+	repo := s.repository(ctx)
 	return repo.GetByOrgID(ctx, orgID)
 }
 
-// GetLeaguesByOrgIDAndStatus retrieves leagues filtered by organization and status
-func (s *Service) GetLeaguesByOrgIDAndStatus(ctx context.Context, orgID string, status LeagueStatus) ([]League, error) {
+// GetLeaguesByOrgIDAndStatus retrieves leagues filtered by organization and status (members and admins only)
+func (s *Service) GetLeaguesByOrgIDAndStatus(ctx context.Context, userID string, orgID string, appRole string, status LeagueStatus) ([]League, error) {
 	if !status.IsValid() {
-		return nil, fmt.Errorf("invalid league status: %s", status)
+		return nil, shared.BadRequest("invalid league status: %s", status)
 	}
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	if err := s.verifyOrgAccess(ctx, userID, orgID, appRole); err != nil {
+		return nil, err
+	}
+	repo := s.repository(ctx)
 	return repo.GetByOrgIDAndStatus(ctx, orgID, status)
 }
 
 // GetApprovedLeagues retrieves all approved leagues (public)
 func (s *Service) GetApprovedLeagues(ctx context.Context) ([]League, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetAllApproved(ctx)
 }
 
-// GetApprovedLeaguesWithPagination retrieves approved leagues with pagination
-func (s *Service) GetApprovedLeaguesWithPagination(ctx context.Context, limit, offset int) ([]League, int64, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
-	return repo.GetAllApprovedWithPagination(ctx, limit, offset)
+// GetApprovedLeaguesWithPagination retrieves a page of approved leagues matching the filter
+// Returns the leagues, the total number of matches and the cursor of the next page ("" on the last page)
+// Without an explicit sort, filter.Near orders the leagues by distance (setting DistanceKm) and
+// filter.Query orders them by relevance (setting Relevance)
+func (s *Service) GetApprovedLeaguesWithPagination(ctx context.Context, filter LeagueFilter, page pagination.Params) ([]League, int64, string, error) {
+	repo := s.repository(ctx)
+
+	resolved, err := s.resolveFilter(ctx, filter)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if resolved.noMatches {
+		return []League{}, 0, "", nil
+	}
+
+	if page.SortExplicit || (resolved.filter.Near == nil && resolved.filter.Query == "") {
+		leagues, count, err := repo.GetAllApprovedWithPagination(ctx, resolved.filter, page)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		leagues, hasMore := pagination.Trim(leagues, page.Limit)
+		return leagues, count, nextLeagueCursor(page, leagues, hasMore), nil
+	}
+
+	// Distance and relevance ordering can't be expressed through PostgREST, so sort the matches here
+	leagues, err := repo.GetAllApprovedFiltered(ctx, resolved.filter)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	sortByRelevance(leagues, resolved.scores)
+	sortByDistance(leagues, resolved.distances)
+
+	start := page.StartOffset()
+	nextCursor := ""
+	if start+page.Limit < len(leagues) {
+		nextCursor = page.OffsetCursor(start + page.Limit)
+	}
+
+	return paginateLeagues(leagues, page.Limit, start), int64(len(leagues)), nextCursor, nil
+}
+
+// GetApprovedLeagueFacets counts approved leagues per sport, gender and game day for the filter
+func (s *Service) GetApprovedLeagueFacets(ctx context.Context, filter LeagueFilter) (*LeagueFacets, error) {
+	repo := s.repository(ctx)
+
+	resolved, err := s.resolveFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if resolved.noMatches {
+		return buildFacets(nil, resolved.filter), nil
+	}
+
+	rows, err := repo.GetApprovedFacetRows(ctx, facetBaseFilter(resolved.filter))
+	if err != nil {
+		return nil, err
+	}
+
+	return buildFacets(rows, resolved.filter), nil
+}
+
+// SetSearcher replaces the Searcher used for text queries (e.g. with a MemorySearcher)
+func (s *Service) SetSearcher(searcher Searcher) {
+	s.searcher = searcher
+}
+
+// resolvedFilter is a LeagueFilter with its location and text criteria turned into venue and league IDs
+type resolvedFilter struct {
+	filter    LeagueFilter
+	distances map[int64]float64  // Venue ID -> km from filter.Near
+	scores    map[string]float64 // League ID -> search relevance
+	noMatches bool               // The location or text criteria matched nothing
+}
+
+// resolveFilter looks up the venues and leagues matching the filter's location and text criteria
+func (s *Service) resolveFilter(ctx context.Context, filter LeagueFilter) (*resolvedFilter, error) {
+	resolved := &resolvedFilter{filter: filter}
+
+	if filter.HasLocation() {
+		var err error
+		resolved.filter, resolved.distances, err = s.resolveLocationFilter(ctx, resolved.filter)
+		if err != nil {
+			return nil, err
+		}
+		if len(resolved.filter.VenueIDs) == 0 {
+			resolved.noMatches = true
+			return resolved, nil
+		}
+		if len(resolved.filter.VenueIDs) > maxFilterVenues {
+			return nil, shared.BadRequest("the search area contains more than %d venues; use a smaller radius or area", maxFilterVenues)
+		}
+	}
+
+	if filter.Query != "" {
+		hits, err := s.searcher.Search(ctx, filter.Query, maxSearchResults)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved.scores = make(map[string]float64, len(hits))
+		resolved.filter.LeagueIDs = []string{}
+		for _, hit := range hits {
+			resolved.scores[hit.LeagueID] = hit.Score
+			resolved.filter.LeagueIDs = append(resolved.filter.LeagueIDs, hit.LeagueID)
+		}
+		if len(hits) == 0 {
+			resolved.noMatches = true
+		}
+	}
+
+	return resolved, nil
+}
+
+// resolveLocationFilter looks up the venues matching the filter's Near/BBox criteria and sets filter.VenueIDs
+// Also returns each venue's distance from filter.Near when it is set
+func (s *Service) resolveLocationFilter(ctx context.Context, filter LeagueFilter) (LeagueFilter, map[int64]float64, error) {
+	var matches []venues.Venue
+	var err error
+
+	if filter.Near != nil {
+		matches, err = s.venuesService.GetVenuesNearby(ctx, *filter.Near, filter.RadiusKm)
+	} else {
+		matches, err = s.venuesService.GetVenuesInBounds(ctx, *filter.BBox)
+	}
+	if err != nil {
+		return filter, nil, fmt.Errorf("failed to resolve venues for location filter: %w", err)
+	}
+
+	distances := make(map[int64]float64)
+	filter.VenueIDs = []int64{}
+	for _, venue := range matches {
+		if filter.Near != nil && filter.BBox != nil && !filter.BBox.Contains(shared.GeoPoint{Lat: venue.Lat, Lng: venue.Lng}) {
+			continue
+		}
+		filter.VenueIDs = append(filter.VenueIDs, venue.ID)
+		if venue.DistanceKm != nil {
+			distances[venue.ID] = *venue.DistanceKm
+		}
+	}
+
+	return filter, distances, nil
+}
+
+// sortByDistance sets DistanceKm on each league from its venue and orders them nearest first
+func sortByDistance(leagues []League, distances map[int64]float64) {
+	for i := range leagues {
+		if leagues[i].VenueID == nil {
+			continue
+		}
+		if distance, ok := distances[*leagues[i].VenueID]; ok {
+			leagues[i].DistanceKm = &distance
+		}
+	}
+
+	sort.SliceStable(leagues, func(i, j int) bool {
+		a, b := leagues[i].DistanceKm, leagues[j].DistanceKm
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return *a < *b
+	})
+}
+
+// paginateLeagues returns the limit/offset window of an already sorted slice
+func paginateLeagues(leagues []League, limit, offset int) []League {
+	if offset >= len(leagues) {
+		return []League{}
+	}
+	end := offset + limit
+	if end > len(leagues) {
+		end = len(leagues)
+	}
+	return leagues[offset:end]
 }
 
 // GetLeagueByID retrieves a league by ID (admin only - any status)
 // Deprecated: Use GetLeagueByUUID instead
 func (s *Service) GetLeagueByID(ctx context.Context, id int) (*League, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetByID(ctx, id)
 }
 
 // GetLeagueByUUID retrieves a league by UUID
 func (s *Service) GetLeagueByUUID(ctx context.Context, id string) (*League, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetByUUID(ctx, id)
 }
 
 // GetPendingLeagues retrieves all pending league submissions (admin only)
 func (s *Service) GetPendingLeagues(ctx context.Context) ([]League, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetPending(ctx)
 }
 
-// GetPendingLeaguesWithPagination retrieves pending leagues with pagination support
-func (s *Service) GetPendingLeaguesWithPagination(ctx context.Context, limit, offset int) ([]League, int64, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
-	return repo.GetPendingWithPagination(ctx, limit, offset)
+// GetPendingLeaguesWithPagination retrieves a page of the pending leagues that pass the queue filter
+// Returns the leagues, the total number matching and the cursor of the next page ("" on the last page)
+func (s *Service) GetPendingLeaguesWithPagination(ctx context.Context, filter ReviewQueueFilter, page pagination.Params) ([]League, int64, string, error) {
+	repo := s.repository(ctx)
+
+	leagues, count, err := repo.GetPendingWithPagination(ctx, filter, page)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	leagues, hasMore := pagination.Trim(leagues, page.Limit)
+	return leagues, count, nextLeagueCursor(page, leagues, hasMore), nil
 }
 
-// GetAllLeaguesWithPagination retrieves all leagues regardless of status with pagination support
-func (s *Service) GetAllLeaguesWithPagination(ctx context.Context, limit, offset int) ([]League, int64, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
-	return repo.GetAllWithPagination(ctx, limit, offset)
+// GetAllLeaguesWithPagination retrieves a page of leagues regardless of status
+// Returns the leagues, the total number and the cursor of the next page ("" on the last page)
+func (s *Service) GetAllLeaguesWithPagination(ctx context.Context, page pagination.Params) ([]League, int64, string, error) {
+	repo := s.repository(ctx)
+
+	leagues, count, err := repo.GetAllWithPagination(ctx, page)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	leagues, hasMore := pagination.Trim(leagues, page.Limit)
+	return leagues, count, nextLeagueCursor(page, leagues, hasMore), nil
 }
 
 // CreateLeague creates a new league with validation and pricing calculation
 func (s *Service) CreateLeague(ctx context.Context, userID string, orgID string, appRole string, request *CreateLeagueRequest) (*League, error) {
 	if request == nil {
-		return nil, fmt.Errorf("create league request cannot be nil")
+		return nil, shared.BadRequest("create league request cannot be nil")
 	}
 
 	if orgID == "" {
-		return nil, fmt.Errorf("organization ID is required")
+		return nil, shared.BadRequest("organization ID is required")
+	}
+
+	if err := ValidateLeagueRequest(request); err != nil {
+		return nil, err
 	}
 
 	// Admins can create leagues on behalf of any organization
@@ -159,477 +379,1995 @@ func (s *Service) CreateLeague(ctx context.Context, userID string, orgID string,
 		}
 	}
 
-	// Validate pricing strategy
-	if !request.PricingStrategy.IsValid() {
-		return nil, fmt.Errorf("invalid pricing strategy: %s", request.PricingStrategy)
+	league, err := s.buildLeague(ctx, orgID, request)
+	if err != nil {
+		return nil, err
 	}
 
-	// Parse dates
-	regDeadlineParsed, err := time.Parse("2006-01-02", *request.RegistrationDeadline)
+	// Determine status based on who is creating the league
+	// Admins can directly create approved leagues, regular users must go through review
+	league.Status = LeagueStatusPending
+	if appRole == "admin" {
+		league.Status = LeagueStatusApproved
+	}
+	createdByValue := userID
+	league.CreatedBy = &createdByValue
+	league.LifecycleStatus = LifecycleRegistrationOpen
+
+	if league.Status == LeagueStatusPending {
+		s.screenSubmission(ctx, orgID, league)
+	}
+
+	// Save league
+	repo := s.repository(ctx)
+	if err := repo.Create(ctx, league); err != nil {
+		return nil, fmt.Errorf("failed to create league: %w", err)
+	}
+	s.recordRevision(ctx, repo, league, RevisionCreated, userID, nil, nil)
+
+	// Only send "pending approval" notification if league was created by regular user
+	// Admin-created leagues and leagues the policy approved don't need review notification
+	if appRole == "admin" {
+		slog.Info("Admin-created league automatically approved", "leagueID", league.ID, "adminID", userID)
+		return league, nil
+	}
+
+	s.settleSubmission(ctx, league, "New League Submitted",
+		fmt.Sprintf("A new league '%s' has been submitted for approval", leagueDisplayName(league)))
+	return league, nil
+}
+
+// CloneLeague copies a league into a new season with its dates moved forward
+// The clone keeps the schedule, pricing and venue, links back to the source via previous_season_id
+// and is submitted like a new league, even when the source was approved
+func (s *Service) CloneLeague(ctx context.Context, userID string, id string, appRole string, request *CloneLeagueRequest) (*League, error) {
+	repo := s.repository(ctx)
+	source, err := repo.GetByUUID(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid registration deadline format: %w", err)
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if source.OrgID == nil {
+		return nil, shared.Conflict("league has no organization to clone it into")
 	}
-	regDeadline := &Date{regDeadlineParsed}
 
-	seasonStartParsed, err := time.Parse("2006-01-02", *request.SeasonStartDate)
+	if appRole != "admin" {
+		err := s.orgService.VerifyUserOrgAccess(ctx, userID, *source.OrgID)
+		if err != nil {
+			return nil, fmt.Errorf("user does not have access to this organization: %w", err)
+		}
+	}
+
+	offsetDays, err := cloneOffsetDays(source, request)
 	if err != nil {
-		return nil, fmt.Errorf("invalid season start date format: %w", err)
+		return nil, err
+	}
+	league, err := cloneLeague(source, offsetDays, userID)
+	if err != nil {
+		return nil, err
 	}
-	seasonStart := &Date{seasonStartParsed}
 
-	var seasonEnd *Date
-	if request.SeasonEndDate != nil {
-		parsedEnd, err := time.Parse("2006-01-02", *request.SeasonEndDate)
+	// The new season is a submission like any other: it must still be valid once its dates moved,
+	// and it is checked for duplicates and decided by the policy
+	submission, err := draftLeagueRequest(league.FormData)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateLeagueRequest(submission); err != nil {
+		return nil, err
+	}
+	s.screenSubmission(ctx, *source.OrgID, league)
+
+	if err := repo.Create(ctx, league); err != nil {
+		return nil, fmt.Errorf("failed to create league: %w", err)
+	}
+	note := fmt.Sprintf("Cloned from %s", id)
+	s.recordRevision(ctx, repo, league, RevisionCreated, userID, nil, &note)
+
+	s.settleSubmission(ctx, league, "New Season Submitted",
+		fmt.Sprintf("A new season of '%s' has been submitted for approval", leagueDisplayName(league)))
+
+	slog.Info("league cloned into new season", "sourceID", id, "leagueID", league.ID, "offsetDays", offsetDays, "userID", userID)
+	return league, nil
+}
+
+// ImportLeagues creates leagues for an organization from a CSV or XLSX spreadsheet, one league per row
+// Each row gets the same checks as CreateLeague, including duplicate detection and the submission policy for
+// organizers; sport and venue names are matched against the existing catalog.
+// Rows that fail are reported and skipped, the others are imported. With dryRun nothing is inserted
+func (s *Service) ImportLeagues(ctx context.Context, userID string, orgID string, appRole string, data []byte, format ImportFormat, dryRun bool) (*ImportLeaguesResponse, error) {
+	if orgID == "" {
+		return nil, shared.BadRequest("organization ID is required")
+	}
+	if appRole != "admin" {
+		err := s.orgService.VerifyUserOrgAccess(ctx, userID, orgID)
 		if err != nil {
-			return nil, fmt.Errorf("invalid season end date format: %w", err)
+			return nil, fmt.Errorf("user does not have access to this organization: %w", err)
 		}
-		seasonEnd = &Date{parsedEnd}
 	}
 
-	// Calculate per-player pricing
-	pricingPerPlayer := s.calculatePricingPerPlayer(request.PricingStrategy, request.PricingAmount, request.MinimumTeamPlayers)
+	// Problems with the file itself are the caller's to fix
+	records, err := readSpreadsheet(data, format)
+	if err != nil {
+		return nil, shared.BadRequest("%v", err)
+	}
+	rows, fileWarnings, err := parseImportRows(records)
+	if err != nil {
+		return nil, shared.BadRequest("%v", err)
+	}
 
-	// Build supplemental requests for sport/venue if IDs are not provided
-	var supplementalRequests *SupplementalRequests
-	if request.SportID == nil {
-		// Sport doesn't exist, add to supplemental requests
-		if supplementalRequests == nil {
-			supplementalRequests = &SupplementalRequests{}
+	sportList, err := s.sportsService.GetAllSports(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch sports: %w", err)
+	}
+	venueList, err := s.venuesService.GetAllVenues(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch venues: %w", err)
+	}
+
+	// Look the organization name up once rather than once per row in buildLeague
+	orgName := ""
+	if org, err := s.orgService.GetOrganizationByID(ctx, orgID); err == nil && org != nil {
+		orgName = org.OrgName
+	}
+
+	repo := s.repository(ctx)
+	note := "Imported from spreadsheet"
+	queued := 0
+	var flagged []string
+
+	report := &ImportLeaguesResponse{
+		DryRun:   dryRun,
+		Total:    len(rows),
+		Warnings: fileWarnings,
+		Rows:     make([]ImportRowResult, 0, len(rows)),
+	}
+	for _, row := range rows {
+		request := row.Request
+		if request.OrganizationName == nil && orgName != "" {
+			request.OrganizationName = &orgName
 		}
-		supplementalRequests.Sport = &SupplementalSport{
-			Name: request.SportName,
+
+		result := ImportRowResult{Row: row.Row, LeagueName: request.LeagueName, Errors: row.Errors}
+		warnings, errs := resolveImportReferences(&request, sportList, venueList)
+		result.Warnings = append(result.Warnings, warnings...)
+		result.Errors = append(result.Errors, errs...)
+		if err := ValidateLeagueRequest(&request); err != nil {
+			// Skip what resolving the references already reported, such as a new venue without a name
+			for _, message := range validationMessages(err) {
+				if !slices.Contains(result.Errors, message) {
+					result.Errors = append(result.Errors, message)
+				}
+			}
+		}
+		result.SportID = request.SportID
+		result.VenueID = request.VenueID
+
+		var league *League
+		if len(result.Errors) == 0 {
+			league, err = s.buildLeague(ctx, orgID, &request)
+			if err != nil {
+				result.Errors = append(result.Errors, err.Error())
+			}
+		}
+		// Organizers' rows are checked for duplicates, including earlier rows of this import, and decided by the policy
+		if league != nil && appRole != "admin" {
+			s.screenSubmission(ctx, orgID, league)
+			result.DuplicateCandidates = league.DuplicateCandidates
+			result.PolicyDecision = league.PolicyDecision
+			for _, candidate := range league.DuplicateCandidates {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("likely duplicate of '%s' (%s)", candidate.LeagueName, candidate.LeagueID))
+			}
+			if decision := league.PolicyDecision; decision.Outcome == PolicyFlag {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("flagged by policy rule '%s'", decision.RuleName))
+			}
+		}
+
+		switch {
+		case len(result.Errors) > 0:
+			result.Status = ImportRowFailed
+		case dryRun:
+			result.Status = ImportRowValid
+		default:
+			league.Status = LeagueStatusPending
+			if appRole == "admin" {
+				league.Status = LeagueStatusApproved
+			}
+			createdByValue := userID
+			league.CreatedBy = &createdByValue
+			league.LifecycleStatus = LifecycleRegistrationOpen
+
+			if err := repo.Create(ctx, league); err != nil {
+				result.Status = ImportRowFailed
+				result.Errors = append(result.Errors, fmt.Sprintf("failed to create league: %v", err))
+				break
+			}
+			s.recordRevision(ctx, repo, league, RevisionCreated, userID, nil, &note)
+			result.Status = ImportRowImported
+			result.LeagueID = league.ID
+
+			if appRole != "admin" {
+				switch league.PolicyDecision.Outcome {
+				case PolicyAutoApprove:
+					if !s.autoApproveLeague(ctx, league) {
+						result.Warnings = append(result.Warnings, "could not be approved automatically; it awaits review")
+						queued++
+					}
+				case PolicyFlag:
+					flagged = append(flagged, leagueDisplayName(league))
+				default:
+					queued++
+				}
+			}
+			result.LeagueStatus = league.Status
+		}
+
+		switch result.Status {
+		case ImportRowFailed:
+			report.Failed++
+		case ImportRowImported:
+			report.Valid++
+			report.Imported++
+		default:
+			report.Valid++
 		}
+		report.Rows = append(report.Rows, result)
 	}
 
-	if request.VenueID == nil && request.VenueAddress != nil {
-		// Venue doesn't exist, add to supplemental requests
-		if supplementalRequests == nil {
-			supplementalRequests = &SupplementalRequests{}
+	// One notification for the whole import rather than one per league
+	if queued > 0 {
+		notificationErr := s.notificationsService.CreateNotificationForAllAdmins(
+			context.Background(),
+			notifications.NotificationLeagueSubmitted.String(),
+			"Leagues Imported",
+			fmt.Sprintf("%d leagues from '%s' have been imported and are awaiting approval", queued, orgName),
+			nil,
+			&orgID,
+		)
+		if notificationErr != nil {
+			slog.Warn("failed to send league import notification to admins", "orgID", orgID, "err", notificationErr)
 		}
-		supplementalRequests.Venue = &SupplementalVenue{
-			Name:    *request.VenueName,
-			Address: *request.VenueAddress,
-			Lat:     request.VenueLat,
-			Lng:     request.VenueLng,
+	}
+	if len(flagged) > 0 {
+		notificationErr := s.notificationsService.CreateNotificationForAllAdmins(
+			context.Background(),
+			notifications.NotificationLeagueFlagged.String(),
+			"Imported Leagues Flagged for Review",
+			fmt.Sprintf("%d leagues imported from '%s' were flagged by the submission policy: %s", len(flagged), orgName, strings.Join(flagged, ", ")),
+			nil,
+			&orgID,
+		)
+		if notificationErr != nil {
+			slog.Warn("failed to send league import notification to admins", "orgID", orgID, "err", notificationErr)
 		}
 	}
 
-	// Create league struct - store orgID in a variable before taking its address
-	orgIDValue := orgID
-	createdByValue := userID
+	slog.Info("league import finished", "orgID", orgID, "userID", userID, "dryRun", dryRun, "total", report.Total, "imported", report.Imported, "failed", report.Failed)
+	return report, nil
+}
 
-	// Get organization name from request or fetch from database
-	orgName := ""
-	if request.OrganizationName != nil && *request.OrganizationName != "" {
-		orgName = *request.OrganizationName
-	} else {
-		// Fallback: query organization table if name not provided
-		org, err := s.orgService.GetOrganizationByID(ctx, orgID)
-		if err == nil && org != nil {
-			orgName = org.OrgName
+// ExportApprovedLeagues streams every approved league matching the public listing filter to emit, in ID order
+// Leagues are fetched in batches so the export never holds more than one batch in memory
+func (s *Service) ExportApprovedLeagues(ctx context.Context, filter LeagueFilter, emit func(ExportRow) error) error {
+	resolved, err := s.resolveFilter(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if resolved.noMatches {
+		return nil
+	}
+
+	approved := LeagueStatusApproved
+	resolved.filter.Status = &approved
+	return s.exportLeagues(ctx, resolved.filter, emit)
+}
+
+// ExportOrgLeagues streams an organization's leagues matching the filter to emit, in ID order
+// Unlike the public export it includes every moderation status (or only status when given) and every lifecycle state
+func (s *Service) ExportOrgLeagues(ctx context.Context, userID string, orgID string, appRole string, filter LeagueFilter, status *LeagueStatus, emit func(ExportRow) error) error {
+	if appRole != "admin" {
+		err := s.orgService.VerifyUserOrgAccess(ctx, userID, orgID)
+		if err != nil {
+			return fmt.Errorf("user does not have access to this organization: %w", err)
 		}
 	}
 
-	// Convert request to map for form_data
-	formDataMap := map[string]interface{}{
-		"sport_id":              request.SportID,
-		"sport_name":            request.SportName,
-		"organization_name":     orgName,
-		"league_name":           request.LeagueName,
-		"division":              request.Division,
-		"registration_deadline": *request.RegistrationDeadline,
-		"season_start_date":     *request.SeasonStartDate,
-		"season_end_date":       request.SeasonEndDate,
-		"game_occurrences":      request.GameOccurrences,
-		"pricing_strategy":      request.PricingStrategy,
-		"pricing_amount":        request.PricingAmount,
-		"venue_id":              request.VenueID,
-		"venue_name":            request.VenueName,
-		"venue_address":         request.VenueAddress,
-		"venue_lat":             request.VenueLat,
-		"venue_lng":             request.VenueLng,
-		"gender":                request.Gender,
-		"season_details":        request.SeasonDetails,
-		"registration_url":      request.RegistrationURL,
-		"duration":              request.Duration,
-		"minimum_team_players":  request.MinimumTeamPlayers,
-		"per_game_fee":          request.PerGameFee,
-		"org_id":                orgID,
+	if len(filter.Lifecycle) == 0 {
+		filter.Lifecycle = LifecycleStatuses
+	}
+	resolved, err := s.resolveFilter(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if resolved.noMatches {
+		return nil
+	}
+
+	resolved.filter.OrgID = orgID
+	resolved.filter.Status = status
+	return s.exportLeagues(ctx, resolved.filter, emit)
+}
+
+// exportLeagues walks the leagues matching filter batch by batch and emits each one flattened
+func (s *Service) exportLeagues(ctx context.Context, filter LeagueFilter, emit func(ExportRow) error) error {
+	names, err := s.loadExportNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	repo := s.repository(ctx)
+	afterID := ""
+	for {
+		batch, err := repo.GetBatchAfterID(ctx, afterID, exportBatchSize, filter)
+		if err != nil {
+			return err
+		}
+		for i := range batch {
+			if err := emit(names.row(ctx, &batch[i])); err != nil {
+				return err
+			}
+		}
+		if len(batch) < exportBatchSize || batch[len(batch)-1].ID == nil {
+			return nil
+		}
+		afterID = *batch[len(batch)-1].ID
+	}
+}
+
+// loadExportNames loads the sports and venues used to name exported leagues
+func (s *Service) loadExportNames(ctx context.Context) (*exportNames, error) {
+	sportList, err := s.sportsService.GetAllSports(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch sports: %w", err)
+	}
+	venueList, err := s.venuesService.GetAllVenues(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch venues: %w", err)
+	}
+	return newExportNames(sportList, venueList, func(ctx context.Context, orgID string) (string, error) {
+		org, err := s.orgService.GetOrganizationByID(ctx, orgID)
+		if err != nil || org == nil {
+			return "", err
+		}
+		return org.OrgName, nil
+	}), nil
+}
+
+// GetLeagueCalendar returns the iCalendar feed of an approved league: its scheduled games and registration deadline
+func (s *Service) GetLeagueCalendar(ctx context.Context, id string) (*ical.Calendar, error) {
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if league.Status != LeagueStatusApproved {
+		return nil, shared.NotFound("league not found")
+	}
+
+	expanded, _, err := s.loadLeagueSchedule(ctx, repo, league)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := s.loadExportNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row := names.row(ctx, league)
+	events, err := leagueCalendarEvents(league, calendarDetailsOf(row), expanded)
+	if err != nil {
+		return nil, err
+	}
+
+	description := row.SportName
+	if row.OrganizationName != "" {
+		description = strings.TrimSpace(description + " league by " + row.OrganizationName)
+	}
+	return &ical.Calendar{
+		Name:        row.LeagueName,
+		Description: description,
+		Events:      events,
+	}, nil
+}
+
+// GetOrgCalendar returns one iCalendar feed with the games and registration deadlines of all of an organization's approved leagues
+// Leagues whose schedule can't be turned into events are left out rather than failing the whole feed
+func (s *Service) GetOrgCalendar(ctx context.Context, orgID string) (*ical.Calendar, error) {
+	org, err := s.orgService.GetOrganizationByID(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organization: %w", err)
+	}
+	if org == nil {
+		return nil, shared.NotFound("organization not found")
+	}
+
+	repo := s.repository(ctx)
+	leagues, err := repo.GetByOrgIDAndStatus(ctx, orgID, LeagueStatusApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays, err := s.orgService.GetHolidays(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	leagueIDs := make([]string, 0, len(leagues))
+	for _, league := range leagues {
+		if league.ID != nil {
+			leagueIDs = append(leagueIDs, *league.ID)
+		}
+	}
+	allExceptions, err := repo.GetScheduleExceptionsByLeagueIDs(ctx, leagueIDs)
+	if err != nil {
+		return nil, err
+	}
+	exceptionsByLeague := make(map[string][]ScheduleException)
+	for _, exception := range allExceptions {
+		exceptionsByLeague[exception.LeagueID] = append(exceptionsByLeague[exception.LeagueID], exception)
+	}
+
+	names, err := s.loadExportNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := &ical.Calendar{
+		Name:        org.OrgName,
+		Description: "Leagues by " + org.OrgName,
+	}
+	for i := range leagues {
+		league := &leagues[i]
+		expanded, err := expandLeagueSchedule(league, exceptionsByLeague[derefString(league.ID)], holidays)
+		if err != nil {
+			slog.Warn("skipping league in organization calendar", "leagueID", derefString(league.ID), "err", err)
+			continue
+		}
+		events, err := leagueCalendarEvents(league, calendarDetailsOf(names.row(ctx, league)), expanded)
+		if err != nil {
+			slog.Warn("skipping league in organization calendar", "leagueID", derefString(league.ID), "err", err)
+			continue
+		}
+		calendar.Events = append(calendar.Events, events...)
+	}
+	return calendar, nil
+}
+
+// loadLeagueSchedule expands a league's schedule with its stored exceptions and its organization's holidays
+func (s *Service) loadLeagueSchedule(ctx context.Context, repo RepositoryInterface, league *League) (*schedule.Schedule, []ScheduleException, error) {
+	if league.ID == nil {
+		return nil, nil, fmt.Errorf("league has no ID")
+	}
+	exceptions, err := repo.GetScheduleExceptions(ctx, *league.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	var holidays []organizations.Holiday
+	if league.OrgID != nil {
+		holidays, err = s.orgService.GetHolidays(ctx, *league.OrgID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	expanded, err := expandLeagueSchedule(league, exceptions, holidays)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to expand schedule: %w", err)
+	}
+	return expanded, exceptions, nil
+}
+
+// GetLeagueSchedule returns the dated games of an approved league, with blackouts, holidays and game changes applied
+func (s *Service) GetLeagueSchedule(ctx context.Context, id string) (*LeagueScheduleResponse, error) {
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if league.Status != LeagueStatusApproved {
+		return nil, shared.NotFound("league not found")
+	}
+
+	expanded, exceptions, err := s.loadLeagueSchedule(ctx, repo, league)
+	if err != nil {
+		return nil, err
+	}
+	return newLeagueScheduleResponse(league, expanded, exceptions), nil
+}
+
+// AddScheduleException blacks out a date of a league or cancels or reschedules one of its games
+// Returns the updated schedule
+func (s *Service) AddScheduleException(ctx context.Context, userID string, id string, appRole string, request *CreateScheduleExceptionRequest) (*LeagueScheduleResponse, error) {
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if league.OrgID == nil {
+		return nil, fmt.Errorf("league has no organization")
+	}
+
+	if appRole != "admin" {
+		err := s.orgService.VerifyUserOrgAccess(ctx, userID, *league.OrgID)
+		if err != nil {
+			return nil, fmt.Errorf("user does not have access to this organization: %w", err)
+		}
+	}
+
+	current, exceptions, err := s.loadLeagueSchedule(ctx, repo, league)
+	if err != nil {
+		return nil, err
+	}
+	exception, err := newScheduleException(league, current, userID, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := repo.CreateScheduleException(ctx, exception); err != nil {
+		return nil, err
+	}
+
+	exceptions = append(exceptions, *exception)
+	holidays, err := s.orgService.GetHolidays(ctx, *league.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	expanded, err := expandLeagueSchedule(league, exceptions, holidays)
+	if err != nil {
+		return nil, err
+	}
+	return newLeagueScheduleResponse(league, expanded, exceptions), nil
+}
+
+// RemoveScheduleException undoes a blackout or game change of a league
+func (s *Service) RemoveScheduleException(ctx context.Context, userID string, id string, appRole string, exceptionID int64) error {
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if league.OrgID == nil {
+		return fmt.Errorf("league has no organization")
+	}
+
+	if appRole != "admin" {
+		err := s.orgService.VerifyUserOrgAccess(ctx, userID, *league.OrgID)
+		if err != nil {
+			return fmt.Errorf("user does not have access to this organization: %w", err)
+		}
+	}
+	return repo.DeleteScheduleException(ctx, id, exceptionID)
+}
+
+// FindVenueConflicts returns the approved leagues playing at the league's venue at an overlapping time in the same weeks
+// Leagues whose venue hasn't been created yet (a supplemental venue) can't conflict
+func (s *Service) FindVenueConflicts(ctx context.Context, league *League) ([]VenueConflict, error) {
+	if league.VenueID == nil {
+		return nil, nil
+	}
+
+	repo := s.repository(ctx)
+	others, err := repo.GetApprovedByVenueID(ctx, *league.VenueID)
+	if err != nil {
+		return nil, err
+	}
+	return findVenueConflicts(league, others), nil
+}
+
+// GetLeagueVenueConflicts checks a league of any status for venue conflicts, so admins can see them before approving
+// For an approved league with an edit awaiting review, the proposed version is checked
+func (s *Service) GetLeagueVenueConflicts(ctx context.Context, id string) ([]VenueConflict, error) {
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+
+	if league.PendingChanges != nil {
+		proposed := *league.PendingChanges
+		proposed.ID = league.ID
+		league = &proposed
+	}
+	return s.FindVenueConflicts(ctx, league)
+}
+
+// FindDuplicateCandidates scores a league against the existing leagues sharing its organization, sport or venue
+// and returns the likely duplicates, best match first. Only the leagues visible to the caller are compared
+func (s *Service) FindDuplicateCandidates(ctx context.Context, league *League) ([]DuplicateCandidate, error) {
+	if league.OrgID == nil {
+		return nil, nil
+	}
+
+	repo := s.repository(ctx)
+	others, err := repo.GetDuplicateCandidates(ctx, *league.OrgID, league.SportID, league.VenueID)
+	if err != nil {
+		return nil, err
+	}
+	return findDuplicateCandidates(league, others), nil
+}
+
+// GetLeagueDuplicates checks a league of any status for duplicates against the current leagues
+// Unlike the candidates stored at submission, this sees leagues created since and, for admins, every organization
+func (s *Service) GetLeagueDuplicates(ctx context.Context, id string) ([]DuplicateCandidate, error) {
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+
+	if league.PendingChanges != nil {
+		proposed := *league.PendingChanges
+		proposed.ID = league.ID
+		proposed.OrgID = league.OrgID
+		league = &proposed
+	}
+	return s.FindDuplicateCandidates(ctx, league)
+}
+
+// GetVenueOccupancy lists the weekly slots booked at a venue by approved leagues still playing on or after from
+// It implements venues.OccupancyProvider
+func (s *Service) GetVenueOccupancy(ctx context.Context, venueID int64, from time.Time) ([]venues.OccupiedSlot, error) {
+	repo := s.repository(ctx)
+	leagues, err := repo.GetApprovedByVenueID(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+	return venueOccupancy(leagues, from), nil
+}
+
+// GetLeagueSeasons returns every approved season in the same series as the given approved league, newest first
+func (s *Service) GetLeagueSeasons(ctx context.Context, id string) ([]League, error) {
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if league.Status != LeagueStatusApproved {
+		return nil, shared.NotFound("league not found")
+	}
+
+	seriesID := league.SeriesID
+	if seriesID == nil {
+		seriesID = league.ID
+	}
+	seasons, err := repo.GetApprovedBySeriesID(ctx, *seriesID)
+	if err != nil {
+		return nil, err
+	}
+	stripPendingChanges(seasons)
+	return seasons, nil
+}
+
+// buildLeague validates a create/update request and converts it into a league with its form_data
+// Status and CreatedBy are left for the caller to set
+func (s *Service) buildLeague(ctx context.Context, orgID string, request *CreateLeagueRequest) (*League, error) {
+	// Validate pricing strategy
+	if !request.PricingStrategy.IsValid() {
+		return nil, shared.BadRequest("invalid pricing strategy: %s", request.PricingStrategy)
+	}
+
+	// Parse dates
+	regDeadlineParsed, err := time.Parse("2006-01-02", *request.RegistrationDeadline)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registration deadline format: %w", err)
+	}
+	regDeadline := &Date{Time: regDeadlineParsed}
+
+	seasonStartParsed, err := time.Parse("2006-01-02", *request.SeasonStartDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid season start date format: %w", err)
+	}
+	seasonStart := &Date{Time: seasonStartParsed}
+
+	var seasonEnd *Date
+	if request.SeasonEndDate != nil {
+		parsedEnd, err := time.Parse("2006-01-02", *request.SeasonEndDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid season end date format: %w", err)
+		}
+		seasonEnd = &Date{Time: parsedEnd}
+	}
+
+	// Calculate per-player pricing
+	pricingPerPlayer := s.calculatePricingPerPlayer(request.PricingStrategy, request.PricingAmount, request.MinimumTeamPlayers)
+
+	// Build supplemental requests for sport/venue if IDs are not provided
+	var supplementalRequests *SupplementalRequests
+	if request.SportID == nil {
+		// Sport doesn't exist, add to supplemental requests
+		if supplementalRequests == nil {
+			supplementalRequests = &SupplementalRequests{}
+		}
+		supplementalRequests.Sport = &SupplementalSport{
+			Name: request.SportName,
+		}
+	}
+
+	if request.VenueID == nil && request.VenueAddress != nil {
+		// Venue doesn't exist, add to supplemental requests
+		if supplementalRequests == nil {
+			supplementalRequests = &SupplementalRequests{}
+		}
+		supplementalRequests.Venue = &SupplementalVenue{
+			Name:    *request.VenueName,
+			Address: *request.VenueAddress,
+			Lat:     request.VenueLat,
+			Lng:     request.VenueLng,
+		}
+	}
+
+	// Create league struct - store orgID in a variable before taking its address
+	orgIDValue := orgID
+
+	// Get organization name from request or fetch from database
+	orgName := ""
+	if request.OrganizationName != nil && *request.OrganizationName != "" {
+		orgName = *request.OrganizationName
+	} else {
+		// Fallback: query organization table if name not provided
+		org, err := s.orgService.GetOrganizationByID(ctx, orgID)
+		if err == nil && org != nil {
+			orgName = org.OrgName
+		}
+	}
+
+	// Convert request to map for form_data
+	formDataMap := map[string]interface{}{
+		"sport_id":              request.SportID,
+		"sport_name":            request.SportName,
+		"organization_name":     orgName,
+		"league_name":           request.LeagueName,
+		"division":              request.Division,
+		"registration_deadline": *request.RegistrationDeadline,
+		"season_start_date":     *request.SeasonStartDate,
+		"season_end_date":       request.SeasonEndDate,
+		"game_occurrences":      request.GameOccurrences,
+		"pricing_strategy":      request.PricingStrategy,
+		"pricing_amount":        request.PricingAmount,
+		"venue_id":              request.VenueID,
+		"venue_name":            request.VenueName,
+		"venue_address":         request.VenueAddress,
+		"venue_lat":             request.VenueLat,
+		"venue_lng":             request.VenueLng,
+		"gender":                request.Gender,
+		"season_details":        request.SeasonDetails,
+		"registration_url":      request.RegistrationURL,
+		"duration":              request.Duration,
+		"minimum_team_players":  request.MinimumTeamPlayers,
+		"per_game_fee":          request.PerGameFee,
+		"org_id":                orgID,
+	}
+
+	league := &League{
+		OrgID:                &orgIDValue,
+		SportID:              request.SportID,
+		LeagueName:           request.LeagueName,
+		Division:             request.Division,
+		RegistrationDeadline: regDeadline,
+		SeasonStartDate:      seasonStart,
+		SeasonEndDate:        seasonEnd,
+		GameOccurrences:      request.GameOccurrences,
+		PricingStrategy:      request.PricingStrategy,
+		PricingAmount:        request.PricingAmount,
+		PricingPerPlayer:     pricingPerPlayer,
+		VenueID:              request.VenueID,
+		Gender:               request.Gender,
+		SeasonDetails:        request.SeasonDetails,
+		RegistrationURL:      request.RegistrationURL,
+		Duration:             request.Duration,
+		MinimumTeamPlayers:   request.MinimumTeamPlayers,
+		PerGameFee:           request.PerGameFee,
+		SupplementalRequests: supplementalRequests,
+		FormData:             FormData(formDataMap),
+	}
+
+	return league, nil
+}
+
+// UpdateLeague applies an edit to an existing league
+// Pending, rejected and sent back (changes_requested) leagues are updated and go back to the review queue. On approved leagues the
+// whitelisted fields (immediateEditFields) apply immediately and any other change is stored as a pending
+// revision, so the public listing keeps the approved version until an admin approves it.
+// Admin edits always apply immediately.
+func (s *Service) UpdateLeague(ctx context.Context, userID string, id string, appRole string, request *CreateLeagueRequest) (*UpdateLeagueResponse, error) {
+	if request == nil {
+		return nil, shared.BadRequest("update league request cannot be nil")
+	}
+
+	if err := ValidateLeagueRequest(request); err != nil {
+		return nil, err
+	}
+
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if league.OrgID == nil {
+		return nil, fmt.Errorf("league has no organization")
+	}
+
+	if appRole != "admin" {
+		err := s.orgService.VerifyUserOrgAccess(ctx, userID, *league.OrgID)
+		if err != nil {
+			return nil, fmt.Errorf("user does not have access to this organization: %w", err)
+		}
+	}
+
+	updated, err := s.buildLeague(ctx, *league.OrgID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := changedLeagueFields(league, updated)
+	outcome := EditOutcomeUnchanged
+	var appliedFields []string
+
+	switch {
+	case appRole == "admin":
+		if len(changed) > 0 {
+			if err := repo.UpdateFieldsByUUID(ctx, id, leagueColumns(updated)); err != nil {
+				return nil, err
+			}
+			outcome = EditOutcomeApplied
+			appliedFields = changed
+		}
+
+	case league.Status == LeagueStatusPending || awaitingOrganizer(league):
+		// A rejected or sent back league is resubmitted even if nothing changed
+		if len(changed) > 0 || awaitingOrganizer(league) {
+			updateData := leagueColumns(updated)
+			updateData["status"] = LeagueStatusPending.String()
+			updateData["rejection_reason"] = nil
+			if awaitingOrganizer(league) {
+				for column, value := range requeuedColumns(time.Now()) {
+					updateData[column] = value
+				}
+			}
+			if err := repo.UpdateFieldsByUUID(ctx, id, updateData); err != nil {
+				return nil, err
+			}
+			outcome = EditOutcomeResubmitted
+		}
+
+		if awaitingOrganizer(league) {
+			previous := "rejected"
+			if league.Status == LeagueStatusChangesRequested {
+				previous = "sent back"
+			}
+			notificationErr := s.notificationsService.CreateNotificationForAllAdmins(
+				context.Background(),
+				notifications.NotificationLeagueSubmitted.String(),
+				"League Resubmitted",
+				fmt.Sprintf("The %s league '%s' has been edited and resubmitted for approval", previous, leagueDisplayName(updated)),
+				nil,
+				league.OrgID,
+			)
+			if notificationErr != nil {
+				slog.Warn("failed to send league resubmitted notification to admins", "leagueID", id, "err", notificationErr)
+			}
+		}
+
+	default:
+		// Approved league: low-risk fields go live now, the rest waits for review
+		if updateData := immediateUpdateData(league, updated, changed); len(updateData) > 0 {
+			if err := repo.UpdateFieldsByUUID(ctx, id, updateData); err != nil {
+				return nil, err
+			}
+			outcome = EditOutcomeApplied
+			for _, field := range changed {
+				if immediateEditFields[field] {
+					appliedFields = append(appliedFields, field)
+				}
+			}
+		}
+
+		if requiresReview(changed) {
+			now := Timestamp{Time: time.Now()}
+			editedBy := userID
+			updateData := map[string]interface{}{
+				"pending_changes":    leagueColumns(updated),
+				"pending_changes_at": now,
+				"pending_changes_by": editedBy,
+			}
+			// A new edit joins the back of the queue; revising one already waiting keeps its place
+			if league.PendingChanges == nil {
+				for column, value := range requeuedColumns(now.Time) {
+					updateData[column] = value
+				}
+			}
+			if err := repo.UpdateFieldsByUUID(ctx, id, updateData); err != nil {
+				return nil, err
+			}
+			outcome = EditOutcomePendingReview
+
+			notificationErr := s.notificationsService.CreateNotificationForAllAdmins(
+				context.Background(),
+				notifications.NotificationLeagueUpdateSubmitted.String(),
+				"League Update Submitted",
+				fmt.Sprintf("Changes to the approved league '%s' have been submitted for approval", leagueDisplayName(league)),
+				nil,
+				league.OrgID,
+			)
+			if notificationErr != nil {
+				slog.Warn("failed to send league update notification to admins", "leagueID", id, "err", notificationErr)
+			}
+		} else if league.PendingChanges != nil {
+			// The edit matches the live version again, so the earlier revision is obsolete
+			updateData := map[string]interface{}{
+				"pending_changes":    nil,
+				"pending_changes_at": nil,
+				"pending_changes_by": nil,
+			}
+			if err := repo.UpdateFieldsByUUID(ctx, id, updateData); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	saved, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch updated league: %w", err)
+	}
+
+	if len(appliedFields) > 0 {
+		s.recordRevision(ctx, repo, saved, RevisionEdited, userID, appliedFields, nil)
+	}
+	switch outcome {
+	case EditOutcomeResubmitted:
+		s.recordRevision(ctx, repo, saved, RevisionResubmitted, userID, changed, nil)
+	case EditOutcomePendingReview:
+		proposal := *updated
+		proposal.ID = saved.ID
+		proposal.Status = saved.Status
+		proposal.CreatedBy = saved.CreatedBy
+		proposal.CreatedAt = saved.CreatedAt
+		proposal.UpdatedAt = saved.UpdatedAt
+		s.recordRevision(ctx, repo, &proposal, RevisionEditSubmitted, userID, changed, nil)
+	}
+
+	return &UpdateLeagueResponse{League: *saved, Outcome: outcome, ChangedFields: changed}, nil
+}
+
+// SetLeagueLifecycle moves a league to a new lifecycle state (organization members and admins)
+// The transition must be allowed by the lifecycle state machine; reason is stored when cancelling
+func (s *Service) SetLeagueLifecycle(ctx context.Context, userID string, id string, appRole string, to LifecycleStatus, reason *string) (*League, error) {
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if league.OrgID == nil {
+		return nil, fmt.Errorf("league has no organization")
+	}
+
+	if appRole != "admin" {
+		err := s.orgService.VerifyUserOrgAccess(ctx, userID, *league.OrgID)
+		if err != nil {
+			return nil, fmt.Errorf("user does not have access to this organization: %w", err)
+		}
+	}
+
+	from := league.LifecycleStatus
+	if from == "" {
+		from = LifecycleRegistrationOpen
+	}
+	if err := ValidateTransition(from, to); err != nil {
+		return nil, err
+	}
+
+	updateData := map[string]interface{}{
+		"lifecycle_status":     to.String(),
+		"lifecycle_updated_at": time.Now(),
+	}
+	if to == LifecycleCancelled {
+		updateData["cancellation_reason"] = reason
+	}
+	if err := repo.UpdateFieldsByUUID(ctx, id, updateData); err != nil {
+		return nil, err
+	}
+
+	saved, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch updated league: %w", err)
+	}
+	s.recordRevision(ctx, repo, saved, RevisionLifecycle, userID, []string{"lifecycle_status"}, reason)
+
+	slog.Info("league lifecycle changed", "leagueID", id, "from", from, "to", to, "userID", userID)
+	return saved, nil
+}
+
+// ApproveLeague approves a pending league submission by integer ID (admin only)
+// It resolves the league's UUID and approves it like ApproveLeagueByUUID, so the review claim, status checks,
+// revision and notification are the same
+func (s *Service) ApproveLeague(ctx context.Context, userID string, id int) error {
+	league, err := s.repository(ctx).GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch league: %w", err)
+	}
+	return s.ApproveLeagueByUUID(ctx, userID, *league.ID)
+}
+
+// RejectLeague rejects a pending league submission with a reason by integer ID (admin only)
+// It resolves the league's UUID and rejects it like RejectLeagueByUUID
+func (s *Service) RejectLeague(ctx context.Context, userID string, id int, rejectionReason string) error {
+	league, err := s.repository(ctx).GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch league: %w", err)
+	}
+	return s.RejectLeagueByUUID(ctx, userID, *league.ID, rejectionReason)
+}
+
+// ApproveLeagueByUUID approves a pending league submission by UUID (admin only)
+func (s *Service) ApproveLeagueByUUID(ctx context.Context, userID string, id string) error {
+	_, err := s.ApproveLeagueWithChanges(ctx, userID, id, nil)
+	return err
+}
+
+// ApproveLeagueWithChanges approves a league submission after applying the admin's corrections (admin only)
+// The corrections are validated like an organizer's edit, recorded on the approval revision and listed in the
+// organizer's notification. Returns the fields that changed.
+func (s *Service) ApproveLeagueWithChanges(ctx context.Context, userID string, id string, request *ApproveLeagueRequest) ([]string, error) {
+	// Verify user is admin
+	isAdmin, err := s.authService.IsUserAdmin(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify admin status: %w", err)
+	}
+	if !isAdmin {
+		return nil, shared.Forbidden("only admins can approve leagues")
+	}
+
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+	changed, notice, err := s.approveLeague(ctx, repo, userID, league, request)
+	if err != nil {
+		return nil, err
+	}
+	s.sendReviewNotice(notice)
+	return changed, nil
+}
+
+// approveLeague approves a league submission or pending edit once the caller is known to be an admin
+// Returns the corrected fields and the notice for the organizer, which the caller sends
+func (s *Service) approveLeague(ctx context.Context, repo RepositoryInterface, userID string, league *League, request *ApproveLeagueRequest) ([]string, *reviewNotice, error) {
+	if err := checkReviewClaim(league, userID, time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	// An approved league can only be approved again if it has an edit awaiting review
+	if league.Status == LeagueStatusApproved {
+		if league.PendingChanges == nil {
+			return nil, nil, shared.Conflict("league is already approved")
+		}
+		if request.HasCorrections() {
+			return nil, nil, shared.Conflict("corrections can only be made to new submissions")
+		}
+		notice, err := s.approvePendingChanges(ctx, repo, userID, league)
+		return nil, notice, err
+	}
+
+	approved := league
+	var changed []string
+	if request.HasCorrections() {
+		corrected, err := s.correctSubmission(ctx, league, request)
+		if err != nil {
+			return nil, nil, err
+		}
+		corrected.ID = league.ID
+		corrected.Status = league.Status
+		corrected.CreatedBy = league.CreatedBy
+		changed = changedLeagueFields(league, corrected)
+		approved = corrected
+	}
+
+	// Create any submitted sport/venue, store the game times and approve in one step
+	fields := approvedStatusColumns()
+	if len(changed) > 0 {
+		for column, value := range leagueColumns(approved) {
+			fields[column] = value
+		}
+	}
+	approval := newLeagueApproval(approved, fields)
+	approval.ReviewedBy = userID
+	if err := repo.ApproveLeague(ctx, *league.ID, approval); err != nil {
+		return nil, nil, err
+	}
+	approved.SportID = approval.SportID
+	approved.VenueID = approval.VenueID
+	approved.Status = LeagueStatusApproved
+	approved.RejectionReason = nil
+
+	var note *string
+	if len(changed) > 0 {
+		corrections := "Corrected on approval: " + strings.Join(changed, ", ")
+		note = &corrections
+	}
+	s.recordRevision(ctx, repo, approved, RevisionApproved, userID, changed, note)
+
+	// Tell the league creator their league was approved
+	message := fmt.Sprintf("Your league '%s' has been approved!", leagueDisplayName(approved))
+	if len(changed) > 0 {
+		message = fmt.Sprintf("Your league '%s' has been approved with corrections by an admin to: %s", leagueDisplayName(approved), strings.Join(changed, ", "))
+	}
+	notice := editorNotice(approved, notifications.NotificationLeagueApproved, "League Approved", message)
+
+	return changed, notice, nil
+}
+
+// correctSubmission builds the corrected version of a league submission from an admin's approval request
+// The sport and venue overrides must name existing rows
+func (s *Service) correctSubmission(ctx context.Context, league *League, request *ApproveLeagueRequest) (*League, error) {
+	if league.OrgID == nil {
+		return nil, fmt.Errorf("league has no organization")
+	}
+
+	var sport *sports.Sport
+	if request.SportID != nil {
+		found, err := s.sportsService.GetSportByID(ctx, int(*request.SportID))
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, shared.Validation(shared.FieldError{Field: "sport_id", Message: "does not exist"})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch sport: %w", err)
+		}
+		sport = found
+	}
+
+	var venue *venues.Venue
+	if request.VenueID != nil {
+		found, err := s.venuesService.GetVenueByID(ctx, int(*request.VenueID))
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, shared.Validation(shared.FieldError{Field: "venue_id", Message: "does not exist"})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch venue: %w", err)
+		}
+		venue = found
+	}
+
+	corrected, err := correctedLeagueRequest(league, request, sport, venue)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildLeague(ctx, *league.OrgID, corrected)
+}
+
+// RejectLeagueByUUID rejects a pending league submission with a reason by UUID (admin only)
+func (s *Service) RejectLeagueByUUID(ctx context.Context, userID string, id string, rejectionReason string) error {
+	// Verify user is admin
+	isAdmin, err := s.authService.IsUserAdmin(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to verify admin status: %w", err)
+	}
+	if !isAdmin {
+		return shared.Forbidden("only admins can reject leagues")
+	}
+
+	if rejectionReason == "" {
+		return shared.Validation(shared.FieldError{Field: "rejection_reason", Message: "cannot be empty"})
+	}
+
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch league: %w", err)
+	}
+	notice, err := s.rejectLeague(ctx, repo, userID, league, rejectionReason)
+	if err != nil {
+		return err
+	}
+	s.sendReviewNotice(notice)
+	return nil
+}
+
+// rejectLeague rejects a league submission or pending edit once the caller is known to be an admin
+// Returns the notice for the organizer, which the caller sends
+func (s *Service) rejectLeague(ctx context.Context, repo RepositoryInterface, userID string, league *League, rejectionReason string) (*reviewNotice, error) {
+	if err := checkReviewClaim(league, userID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// Check if league is already rejected
+	if league.Status == LeagueStatusRejected {
+		return nil, shared.Conflict("league is already rejected")
+	}
+	if !awaitingReview(league) {
+		return nil, shared.Conflict("league is not awaiting review")
+	}
+
+	// Rejecting an edit of an approved league discards the edit; the league stays approved
+	if league.Status == LeagueStatusApproved && league.PendingChanges != nil {
+		return s.rejectPendingChanges(ctx, repo, userID, league, rejectionReason)
+	}
+
+	// Update the league status
+	updateData := withClaimReleased(map[string]interface{}{
+		"status":           LeagueStatusRejected.String(),
+		"rejection_reason": rejectionReason,
+	})
+	if err := repo.UpdateFieldsByUUID(ctx, *league.ID, updateData); err != nil {
+		return nil, err
+	}
+	league.Status = LeagueStatusRejected
+	league.RejectionReason = &rejectionReason
+	s.recordRevision(ctx, repo, league, RevisionRejected, userID, nil, &rejectionReason)
+
+	// Tell the league creator their league was rejected
+	notice := editorNotice(league, notifications.NotificationLeagueRejected, "League Rejected",
+		fmt.Sprintf("Your league '%s' was rejected. Reason: %s", leagueDisplayName(league), rejectionReason))
+	return notice, nil
+}
+
+// MarkLeagueDuplicate rejects a pending league as a duplicate of another league with the standard reason (admin only)
+func (s *Service) MarkLeagueDuplicate(ctx context.Context, userID string, id string, duplicateOfID string) error {
+	isAdmin, err := s.authService.IsUserAdmin(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to verify admin status: %w", err)
+	}
+	if !isAdmin {
+		return shared.Forbidden("only admins can mark leagues as duplicates")
+	}
+
+	if id == duplicateOfID {
+		return shared.Validation(shared.FieldError{Field: "duplicate_of_id", Message: "cannot be the league itself"})
+	}
+
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if league.Status != LeagueStatusPending {
+		return shared.Conflict("only pending leagues can be marked as duplicates")
+	}
+	if err := checkReviewClaim(league, userID, time.Now()); err != nil {
+		return err
+	}
+
+	original, err := repo.GetByUUID(ctx, duplicateOfID)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return shared.Validation(shared.FieldError{Field: "duplicate_of_id", Message: "league not found"})
+		}
+		return fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if original.DuplicateOfID != nil {
+		return shared.Validation(shared.FieldError{Field: "duplicate_of_id", Message: "is itself a duplicate of " + *original.DuplicateOfID})
+	}
+
+	reason := DuplicateRejectionReason
+	updateData := withClaimReleased(map[string]interface{}{
+		"status":           LeagueStatusRejected.String(),
+		"rejection_reason": reason,
+		"duplicate_of_id":  duplicateOfID,
+	})
+	if err := repo.UpdateFieldsByUUID(ctx, id, updateData); err != nil {
+		return err
+	}
+	league.Status = LeagueStatusRejected
+	league.RejectionReason = &reason
+	league.DuplicateOfID = &duplicateOfID
+	note := fmt.Sprintf("Duplicate of %s", duplicateOfID)
+	s.recordRevision(ctx, repo, league, RevisionRejected, userID, nil, &note)
+
+	s.notifyLeagueEditor(league, notifications.NotificationLeagueRejected, "League Rejected",
+		fmt.Sprintf("Your league '%s' was rejected. Reason: %s", leagueDisplayName(league), reason))
+
+	return nil
+}
+
+// BulkReviewLeagues approves or rejects many leagues at once (admin only)
+// Each league goes through the same checks as a single decision and gets its own result; one failing doesn't
+// stop the rest. Organizers get one notification per organization and kind of decision rather than one per league.
+func (s *Service) BulkReviewLeagues(ctx context.Context, userID string, request *BulkReviewRequest) (*BulkReviewResponse, error) {
+	isAdmin, err := s.authService.IsUserAdmin(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify admin status: %w", err)
+	}
+	if !isAdmin {
+		return nil, shared.Forbidden("only admins can review leagues")
+	}
+
+	switch request.Action {
+	case BulkReviewApprove:
+	case BulkReviewReject:
+		if strings.TrimSpace(request.RejectionReason) == "" {
+			return nil, shared.Validation(shared.FieldError{Field: "rejection_reason", Message: "is required to reject"})
+		}
+	default:
+		return nil, shared.Validation(shared.FieldError{Field: "action", Message: "must be approve or reject"})
+	}
+
+	repo := s.repository(ctx)
+	report := &BulkReviewResponse{
+		Action:  request.Action,
+		Total:   len(request.IDs),
+		Results: make([]BulkReviewResult, 0, len(request.IDs)),
+	}
+	var notices []*reviewNotice
+	for _, id := range request.IDs {
+		notice, err := s.bulkReviewLeague(ctx, repo, userID, id, request)
+		if err != nil {
+			slog.Warn("bulk review league failed", "leagueID", id, "action", request.Action, "err", err)
+			report.Failed++
+		} else {
+			report.Succeeded++
+			notices = append(notices, notice)
+		}
+		report.Results = append(report.Results, bulkReviewResult(id, request.Action, err))
+	}
+
+	for _, notice := range groupReviewNotices(notices, request.RejectionReason) {
+		s.sendReviewNotice(notice)
+	}
+
+	return report, nil
+}
+
+// bulkReviewLeague approves or rejects one league of a bulk review
+// Unlike a single decision it only touches leagues awaiting review, so a stale ID can't unpublish an
+// approved league or decide one that waits on its organizer
+func (s *Service) bulkReviewLeague(ctx context.Context, repo RepositoryInterface, userID string, id string, request *BulkReviewRequest) (*reviewNotice, error) {
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if !awaitingReview(league) {
+		return nil, shared.Conflict("league is not awaiting review")
+	}
+
+	if request.Action == BulkReviewApprove {
+		_, notice, err := s.approveLeague(ctx, repo, userID, league, nil)
+		return notice, err
+	}
+	return s.rejectLeague(ctx, repo, userID, league, request.RejectionReason)
+}
+
+// approvedStatusColumns are the league columns set when a submission is approved
+func approvedStatusColumns() map[string]interface{} {
+	return withClaimReleased(map[string]interface{}{
+		"status":           LeagueStatusApproved.String(),
+		"rejection_reason": nil,
+	})
+}
+
+// approvePendingChanges applies the pending edit of an approved league to its live columns
+// Returns the notice for the organizer who made the edit
+func (s *Service) approvePendingChanges(ctx context.Context, repo RepositoryInterface, userID string, league *League) (*reviewNotice, error) {
+	revision := league.PendingChanges
+	updateData := withClaimReleased(leagueColumns(revision))
+	updateData["pending_changes"] = nil
+	updateData["pending_changes_at"] = nil
+	updateData["pending_changes_by"] = nil
+	approval := newLeagueApproval(revision, updateData)
+	approval.ReviewedBy = userID
+	if err := repo.ApproveLeague(ctx, *league.ID, approval); err != nil {
+		return nil, err
+	}
+
+	if approved, err := repo.GetByUUID(ctx, *league.ID); err != nil {
+		slog.Error("failed to fetch league for revision history", "leagueID", *league.ID, "err", err)
+	} else {
+		s.recordRevision(ctx, repo, approved, RevisionEditApproved, userID, changedLeagueFields(league, approved), nil)
+	}
+
+	notice := editorNotice(league, notifications.NotificationLeagueUpdateApproved, "League Update Approved",
+		fmt.Sprintf("Your changes to '%s' have been approved and are now live", leagueDisplayName(league)))
+	return notice, nil
+}
+
+// rejectPendingChanges discards the pending edit of an approved league
+// Returns the notice for the organizer who made the edit
+func (s *Service) rejectPendingChanges(ctx context.Context, repo RepositoryInterface, userID string, league *League, rejectionReason string) (*reviewNotice, error) {
+	updateData := withClaimReleased(map[string]interface{}{
+		"pending_changes":    nil,
+		"pending_changes_at": nil,
+		"pending_changes_by": nil,
+	})
+	if err := repo.UpdateFieldsByUUID(ctx, *league.ID, updateData); err != nil {
+		return nil, err
+	}
+
+	// The history keeps the discarded proposal so admins can see what was turned down
+	proposal := *league.PendingChanges
+	proposal.ID = league.ID
+	proposal.OrgID = league.OrgID
+	proposal.Status = league.Status
+	proposal.CreatedBy = league.CreatedBy
+	s.recordRevision(ctx, repo, &proposal, RevisionEditRejected, userID, changedLeagueFields(league, &proposal), &rejectionReason)
+
+	notice := editorNotice(league, notifications.NotificationLeagueUpdateRejected, "League Update Rejected",
+		fmt.Sprintf("Your changes to '%s' were rejected. Reason: %s", leagueDisplayName(league), rejectionReason))
+	return notice, nil
+}
+
+// recordRevision appends a snapshot of the league to its revision history
+// Failures are logged rather than returned because the change itself has already been saved
+func (s *Service) recordRevision(ctx context.Context, repo RepositoryInterface, league *League, action RevisionAction, userID string, changedFields []string, note *string) {
+	if league.ID == nil {
+		return
+	}
+	if changedFields == nil {
+		changedFields = []string{}
+	}
+
+	revision := &LeagueRevision{
+		LeagueID:      *league.ID,
+		Action:        action,
+		Status:        league.Status,
+		Snapshot:      revisionSnapshot(league),
+		ChangedFields: changedFields,
+		Note:          note,
+	}
+	// Decisions the policy makes on its own have no author
+	if userID != "" {
+		createdBy := userID
+		revision.CreatedBy = &createdBy
 	}
+	if err := repo.CreateRevision(ctx, revision); err != nil {
+		slog.Error("failed to record league revision", "leagueID", *league.ID, "action", action, "err", err)
+	}
+}
 
-	// Determine status based on who is creating the league
-	// Admins can directly create approved leagues, regular users must go through review
-	status := LeagueStatusPending
-	if appRole == "admin" {
-		status = LeagueStatusApproved
+// notifyLeagueEditor notifies the organizer who submitted a league's pending edit (or its creator)
+func (s *Service) notifyLeagueEditor(league *League, notificationType notifications.NotificationType, title string, message string) {
+	s.sendReviewNotice(editorNotice(league, notificationType, title, message))
+}
+
+// reviewNotice is a notification telling an organizer about a review decision
+// Review methods return it rather than sending it so a bulk review can send one per organization
+type reviewNotice struct {
+	recipient        string
+	orgID            *string
+	leagueID         string
+	leagueName       string
+	notificationType notifications.NotificationType
+	title            string
+	message          string
+}
+
+// editorNotice addresses a review notice to the organizer who submitted a league's pending edit (or its creator)
+// Returns nil when neither is known
+func editorNotice(league *League, notificationType notifications.NotificationType, title string, message string) *reviewNotice {
+	recipient := league.PendingChangesBy
+	if recipient == nil {
+		recipient = league.CreatedBy
+	}
+	if recipient == nil {
+		return nil
 	}
 
-	league := &League{
-		OrgID:                &orgIDValue,
-		SportID:              request.SportID,
-		LeagueName:           request.LeagueName,
-		Division:             request.Division,
-		RegistrationDeadline: regDeadline,
-		SeasonStartDate:      seasonStart,
-		SeasonEndDate:        seasonEnd,
-		GameOccurrences:      request.GameOccurrences,
-		PricingStrategy:      request.PricingStrategy,
-		PricingAmount:        request.PricingAmount,
-		PricingPerPlayer:     pricingPerPlayer,
-		VenueID:              request.VenueID,
-		Gender:               request.Gender,
-		SeasonDetails:        request.SeasonDetails,
-		RegistrationURL:      request.RegistrationURL,
-		Duration:             request.Duration,
-		MinimumTeamPlayers:   request.MinimumTeamPlayers,
-		PerGameFee:           request.PerGameFee,
-		SupplementalRequests: supplementalRequests,
-		FormData:             FormData(formDataMap),
-		Status:               status,
-		CreatedBy:            &createdByValue,
+	notice := &reviewNotice{
+		recipient:        *recipient,
+		orgID:            league.OrgID,
+		leagueName:       leagueDisplayName(league),
+		notificationType: notificationType,
+		title:            title,
+		message:          message,
 	}
+	if league.ID != nil {
+		notice.leagueID = *league.ID
+	}
+	return notice
+}
 
-	// Save league
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
-	if err := repo.Create(ctx, league); err != nil {
-		return nil, fmt.Errorf("failed to create league: %w", err)
+// sendReviewNotice delivers a review notice; nil is a no-op
+func (s *Service) sendReviewNotice(notice *reviewNotice) {
+	if notice == nil {
+		return
+	}
+
+	notificationErr := s.notificationsService.CreateNotification(
+		context.Background(),
+		notice.recipient,
+		notice.notificationType.String(),
+		notice.title,
+		notice.message,
+		nil,
+		notice.orgID,
+	)
+	if notificationErr != nil {
+		slog.Error("failed to send league review notification", "leagueID", notice.leagueID, "userID", notice.recipient, "type", notice.notificationType, "err", notificationErr)
+		// Don't return error - the review decision was already saved
 	}
+}
 
-	// Only send "pending approval" notification if league was created by regular user
-	// Admin-created leagues are automatically approved and don't need review notification
-	if appRole != "admin" {
-		leagueName := ""
-		if league.LeagueName != nil {
-			leagueName = *league.LeagueName
-		}
-		notificationErr := s.notificationsService.CreateNotificationForAllAdmins(
-			context.Background(),
-			notifications.NotificationLeagueSubmitted.String(),
-			"New League Submitted",
-			fmt.Sprintf("A new league '%s' has been submitted for approval", leagueName),
-			nil,
-			league.OrgID,
-		)
-		if notificationErr != nil {
-			slog.Warn("failed to send league submitted notification to admins", "leagueID", league.ID, "err", notificationErr)
-			// Don't return error - league was already created, notification failure isn't critical
-		}
-	} else {
-		slog.Info("Admin-created league automatically approved", "leagueID", league.ID, "adminID", userID)
+// leagueDisplayName returns the league name or an empty string
+func leagueDisplayName(league *League) string {
+	if league.LeagueName != nil {
+		return *league.LeagueName
 	}
+	return ""
+}
 
-	return league, nil
+// ============= REVISION METHODS =============
+
+// GetLeagueHistory retrieves every revision of a league, oldest first
+func (s *Service) GetLeagueHistory(ctx context.Context, id string) ([]LeagueRevision, error) {
+	repo := s.repository(ctx)
+	return repo.GetRevisionsByLeagueID(ctx, id)
 }
 
-// ApproveLeague approves a pending league submission (admin only)
-// If sport_id is nil and form_data has sport_name, creates the sport
-// If venue_id is nil and form_data has venue_name, creates the venue
-func (s *Service) ApproveLeague(ctx context.Context, userID string, id int) error {
-	// Verify user is admin
+// DiffLeagueRevisions compares two revisions of a league by revision number
+// A zero "to" means the latest revision and a zero "from" the one before "to"
+func (s *Service) DiffLeagueRevisions(ctx context.Context, id string, from int, to int) (*RevisionDiff, error) {
+	revisions, err := s.GetLeagueHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, shared.NotFound("league has no revisions")
+	}
+
+	if to == 0 {
+		to = revisions[len(revisions)-1].RevisionNumber
+	}
+	if from == 0 {
+		from = to - 1
+	}
+
+	fromRevision := findRevision(revisions, from)
+	if fromRevision == nil {
+		return nil, shared.NotFound("revision %d not found", from)
+	}
+	toRevision := findRevision(revisions, to)
+	if toRevision == nil {
+		return nil, shared.NotFound("revision %d not found", to)
+	}
+	if fromRevision.Snapshot == nil || toRevision.Snapshot == nil {
+		return nil, fmt.Errorf("revision snapshot is missing")
+	}
+
+	return &RevisionDiff{
+		LeagueID: id,
+		From:     from,
+		To:       to,
+		Changes:  diffLeagues(fromRevision.Snapshot, toRevision.Snapshot),
+	}, nil
+}
+
+// ============= COMMENT METHODS =============
+
+// RequestLeagueChanges sends a pending league submission back to its organizer with a message (admin only)
+// The message starts or continues the league's review thread; the league leaves the review queue
+// until the organizer edits and resubmits it
+func (s *Service) RequestLeagueChanges(ctx context.Context, userID string, id string, request *RequestChangesRequest) (*LeagueComment, error) {
 	isAdmin, err := s.authService.IsUserAdmin(ctx, userID)
 	if err != nil {
-		return fmt.Errorf("failed to verify admin status: %w", err)
+		return nil, fmt.Errorf("failed to verify admin status: %w", err)
 	}
 	if !isAdmin {
-		return fmt.Errorf("only admins can approve leagues")
+		return nil, shared.Forbidden("only admins can request changes to leagues")
 	}
 
-	// Get the league to check form_data
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
-	league, err := repo.GetByID(ctx, id)
+	message := strings.TrimSpace(request.Message)
+	if message == "" {
+		return nil, shared.Validation(shared.FieldError{Field: "message", Message: "cannot be empty"})
+	}
+	fields, err := normalizeCommentFields(request.Fields)
 	if err != nil {
-		return fmt.Errorf("failed to fetch league: %w", err)
+		return nil, err
 	}
 
-	// If sport_id is nil and form_data has sport_name, create it
-	if league.SportID == nil && league.FormData != nil {
-		if sportName, ok := league.FormData["sport_name"].(string); ok && sportName != "" {
-			newSport, err := s.sportsService.CreateSport(ctx, &sports.CreateSportRequest{
-				Name: sportName,
-			})
-			if err != nil {
-				return fmt.Errorf("failed to create sport: %w", err)
-			}
-			league.SportID = &newSport.ID
-		}
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if league.Status != LeagueStatusPending {
+		return nil, shared.Conflict("only pending leagues can be sent back for changes")
+	}
+	if err := checkReviewClaim(league, userID, time.Now()); err != nil {
+		return nil, err
 	}
 
-	// If venue_id is nil and form_data has venue_name, create it
-	if league.VenueID == nil && league.FormData != nil {
-		venueName, hasName := league.FormData["venue_name"].(string)
-		venueAddress, hasAddress := league.FormData["venue_address"].(string)
-		if (hasName && venueName != "") || (hasAddress && venueAddress != "") {
-			lat := 0.0
-			lng := 0.0
-			if venueLatVal, ok := league.FormData["venue_lat"].(float64); ok {
-				lat = venueLatVal
-			}
-			if venueLngVal, ok := league.FormData["venue_lng"].(float64); ok {
-				lng = venueLngVal
-			}
+	// Store the message first so a league is never sent back without saying why
+	comment := &LeagueComment{
+		LeagueID:   id,
+		AuthorID:   userID,
+		AuthorRole: CommentAuthorAdmin,
+		Body:       message,
+		Fields:     fields,
+		Status:     LeagueStatusChangesRequested,
+	}
+	if err := repo.CreateComment(ctx, comment); err != nil {
+		return nil, err
+	}
 
-			venueReq := &venues.CreateVenueRequest{
-				Name:    venueName,
-				Address: venueAddress,
-				Lat:     lat,
-				Lng:     lng,
-			}
-			newVenue, err := s.venuesService.CreateVenue(ctx, venueReq)
-			if err != nil {
-				return fmt.Errorf("failed to create venue: %w", err)
-			}
-			league.VenueID = &newVenue.ID
-		}
+	updateData := withClaimReleased(map[string]interface{}{
+		"status":           LeagueStatusChangesRequested.String(),
+		"rejection_reason": nil,
+	})
+	if err := repo.UpdateFieldsByUUID(ctx, id, updateData); err != nil {
+		return nil, err
 	}
+	league.Status = LeagueStatusChangesRequested
+	league.RejectionReason = nil
+	s.recordRevision(ctx, repo, league, RevisionChangesRequested, userID, fields, &message)
+
+	s.notifyLeagueEditor(league, notifications.NotificationLeagueChangesRequested, "Changes Requested",
+		fmt.Sprintf("An admin asked for changes to your league '%s': %s", leagueDisplayName(league), message))
+
+	return comment, nil
+}
 
-	// Atomically update league IDs and status to approved in a single transaction
-	// This ensures both updates succeed or both fail together
-	err = repo.ApproveLeagueWithTransaction(ctx, id, league.SportID, league.VenueID)
+// AddLeagueComment adds a message to a league's review thread (organization members and admins)
+// Admin comments notify the league's organizer and organizer comments notify every admin
+func (s *Service) AddLeagueComment(ctx context.Context, userID string, id string, appRole string, request *CreateLeagueCommentRequest) (*LeagueComment, error) {
+	body := strings.TrimSpace(request.Body)
+	if body == "" {
+		return nil, shared.Validation(shared.FieldError{Field: "body", Message: "cannot be empty"})
+	}
+	fields, err := normalizeCommentFields(request.Fields)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Send notification to league creator that their league was approved
-	if league.CreatedBy != nil {
-		ctx := context.Background()
-		notificationErr := s.notificationsService.CreateNotification(
-			ctx,
-			*league.CreatedBy,
-			notifications.NotificationLeagueApproved.String(),
-			"League Approved",
-			fmt.Sprintf("Your league '%s' has been approved!", league.LeagueName),
-			&id,
-			nil,
-		)
-		if notificationErr != nil {
-			slog.Error("failed to send league approval notification", "leagueID", id, "userID", league.CreatedBy, "err", notificationErr)
-			// Don't return error - league was already approved, notification failure isn't critical
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if league.OrgID == nil {
+		return nil, fmt.Errorf("league has no organization")
+	}
+
+	if appRole != "admin" {
+		err := s.orgService.VerifyUserOrgAccess(ctx, userID, *league.OrgID)
+		if err != nil {
+			return nil, fmt.Errorf("user does not have access to this organization: %w", err)
 		}
 	}
 
-	return nil
+	comment := &LeagueComment{
+		LeagueID:   id,
+		AuthorID:   userID,
+		AuthorRole: commentAuthorRole(appRole),
+		Body:       body,
+		Fields:     fields,
+	}
+	if err := repo.CreateComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	if comment.AuthorRole == CommentAuthorAdmin {
+		s.notifyLeagueEditor(league, notifications.NotificationLeagueComment, "New Comment on Your League",
+			fmt.Sprintf("An admin commented on your league '%s': %s", leagueDisplayName(league), body))
+		return comment, nil
+	}
+
+	notificationErr := s.notificationsService.CreateNotificationForAllAdmins(
+		context.Background(),
+		notifications.NotificationLeagueComment.String(),
+		"New League Comment",
+		fmt.Sprintf("The organizer of '%s' replied: %s", leagueDisplayName(league), body),
+		nil,
+		league.OrgID,
+	)
+	if notificationErr != nil {
+		slog.Warn("failed to send league comment notification to admins", "leagueID", id, "err", notificationErr)
+	}
+
+	return comment, nil
 }
 
-// RejectLeague rejects a pending league submission with a reason (admin only)
-func (s *Service) RejectLeague(ctx context.Context, userID string, id int, rejectionReason string) error {
-	// Verify user is admin
-	isAdmin, err := s.authService.IsUserAdmin(ctx, userID)
+// GetLeagueComments retrieves a league's review thread, oldest first (organization members and admins)
+func (s *Service) GetLeagueComments(ctx context.Context, userID string, id string, appRole string) ([]LeagueComment, error) {
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("failed to verify admin status: %w", err)
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
 	}
-	if !isAdmin {
-		return fmt.Errorf("only admins can reject leagues")
+	if league.OrgID == nil {
+		return nil, fmt.Errorf("league has no organization")
 	}
 
-	if rejectionReason == "" {
-		return fmt.Errorf("rejection reason cannot be empty")
+	if appRole != "admin" {
+		err := s.orgService.VerifyUserOrgAccess(ctx, userID, *league.OrgID)
+		if err != nil {
+			return nil, fmt.Errorf("user does not have access to this organization: %w", err)
+		}
 	}
 
-	// Get the league to retrieve creator info
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
-	league, err := repo.GetByID(ctx, id)
+	return repo.GetCommentsByLeagueID(ctx, id)
+}
+
+// ============= REVIEW QUEUE METHODS =============
+
+// ClaimLeague locks a league awaiting review to the admin for claimDuration (admin only)
+// Claiming a league the admin already holds renews the claim. Returns a Conflict error while another admin holds it.
+func (s *Service) ClaimLeague(ctx context.Context, userID string, id string) (*LeagueClaim, error) {
+	isAdmin, err := s.authService.IsUserAdmin(ctx, userID)
 	if err != nil {
-		return fmt.Errorf("failed to fetch league: %w", err)
+		return nil, fmt.Errorf("failed to verify admin status: %w", err)
+	}
+	if !isAdmin {
+		return nil, shared.Forbidden("only admins can claim leagues for review")
 	}
 
-	// Update the league status
-	err = repo.UpdateStatus(ctx, id, LeagueStatusRejected, &rejectionReason)
+	repo := s.repository(ctx)
+	league, err := repo.GetByUUID(ctx, id)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed to fetch league: %w", err)
+	}
+	if !awaitingReview(league) {
+		return nil, shared.Conflict("league is not awaiting review")
 	}
 
-	// Send notification to league creator that their league was rejected
-	if league.CreatedBy != nil {
-		ctx := context.Background()
-		notificationErr := s.notificationsService.CreateNotification(
-			ctx,
-			*league.CreatedBy,
-			notifications.NotificationLeagueRejected.String(),
-			"League Rejected",
-			fmt.Sprintf("Your league '%s' was rejected. Reason: %s", league.LeagueName, rejectionReason),
-			&id,
-			nil,
-		)
-		if notificationErr != nil {
-			slog.Error("failed to send league rejection notification", "leagueID", id, "userID", league.CreatedBy, "err", notificationErr)
-			// Don't return error - league was already rejected, notification failure isn't critical
+	now := time.Now()
+	expiresAt := now.Add(claimDuration)
+	claimed, err := repo.ClaimLeague(ctx, id, userID, now, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		// Re-read the league so the error names the current claim
+		if current, err := repo.GetByUUID(ctx, id); err == nil {
+			if err := checkReviewClaim(current, userID, now); err != nil {
+				return nil, err
+			}
 		}
+		return nil, shared.Conflict("league is being reviewed by another admin")
 	}
 
-	return nil
+	return &LeagueClaim{LeagueID: id, ClaimedBy: userID, ExpiresAt: Timestamp{Time: expiresAt}}, nil
 }
 
-// ApproveLeagueByUUID approves a pending league submission by UUID (admin only)
-func (s *Service) ApproveLeagueByUUID(ctx context.Context, userID string, id string) error {
-	// Verify user is admin
+// ReleaseLeagueClaim gives up an admin's claim on a league (admin only)
+// Releasing a league nobody holds is a no-op; another admin's active claim is a Conflict
+func (s *Service) ReleaseLeagueClaim(ctx context.Context, userID string, id string) error {
 	isAdmin, err := s.authService.IsUserAdmin(ctx, userID)
 	if err != nil {
 		return fmt.Errorf("failed to verify admin status: %w", err)
 	}
 	if !isAdmin {
-		return fmt.Errorf("only admins can approve leagues")
+		return shared.Forbidden("only admins can release leagues")
 	}
 
-	// Get the league to check form_data
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	league, err := repo.GetByUUID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to fetch league: %w", err)
 	}
+	if err := checkReviewClaim(league, userID, time.Now()); err != nil {
+		return err
+	}
+	if league.ClaimedBy == nil {
+		return nil
+	}
 
-	// Check if league is already approved
-	if league.Status == LeagueStatusApproved {
-		return fmt.Errorf("league is already approved")
+	return repo.UpdateFieldsByUUID(ctx, id, withClaimReleased(map[string]interface{}{}))
+}
+
+// GetReviewMetrics reports the time from submission to decision over the last days and the current backlog by age
+func (s *Service) GetReviewMetrics(ctx context.Context, days int) (*ReviewMetrics, error) {
+	repo := s.repository(ctx)
+	now := time.Now()
+	since := now.AddDate(0, 0, -days)
+
+	events, err := repo.GetReviewEvents(ctx, reviewEventActions(), since)
+	if err != nil {
+		return nil, err
 	}
+	pending, err := repo.GetPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	durations := reviewDurations(events)
+	return &ReviewMetrics{
+		Since:       Timestamp{Time: since},
+		Decisions:   len(durations),
+		MedianHours: percentileHours(durations, 0.5),
+		P90Hours:    percentileHours(durations, 0.9),
+		Pending:     len(pending),
+		Backlog:     countBacklog(pending, now),
+	}, nil
+}
 
-	// If sport_id is nil and form_data has sport_name, create it
-	if league.SportID == nil && league.FormData != nil {
-		if sportName, ok := league.FormData["sport_name"].(string); ok && sportName != "" {
-			newSport, err := s.sportsService.CreateSport(ctx, &sports.CreateSportRequest{
-				Name: sportName,
-			})
-			if err != nil {
-				return fmt.Errorf("failed to create sport: %w", err)
-			}
-			league.SportID = &newSport.ID
+// ============= POLICY METHODS =============
+
+// screenSubmission flags likely duplicates of a pending league for the admin reviewing it,
+// and lets the policy decide whether it needs reviewing at all
+func (s *Service) screenSubmission(ctx context.Context, orgID string, league *League) {
+	candidates, err := s.FindDuplicateCandidates(ctx, league)
+	if err != nil {
+		slog.Warn("failed to check league for duplicates", "orgID", orgID, "err", err)
+		// Don't return error - the check only helps the review
+	}
+	league.DuplicateCandidates = candidates
+	league.PolicyDecision = s.decideSubmission(ctx, orgID, league)
+}
+
+// settleSubmission carries out the policy decision on a stored pending league
+// The league is approved straight away, or the admins are told with title and message that it awaits review,
+// or warned instead when the policy flagged it
+func (s *Service) settleSubmission(ctx context.Context, league *League, title string, message string) {
+	decision := league.PolicyDecision
+	slog.Info("league submission policy decision", "leagueID", league.ID, "outcome", decision.Outcome, "rule", decision.RuleName)
+	if decision.Outcome == PolicyAutoApprove && s.autoApproveLeague(ctx, league) {
+		return
+	}
+
+	notificationType := notifications.NotificationLeagueSubmitted
+	if decision.Outcome == PolicyFlag {
+		notificationType = notifications.NotificationLeagueFlagged
+		title = "League Flagged for Review"
+		message = fmt.Sprintf("A new league '%s' was flagged by policy rule '%s'", leagueDisplayName(league), decision.RuleName)
+		if len(decision.Signals.Reasons) > 0 {
+			message += ": " + strings.Join(decision.Signals.Reasons, "; ")
 		}
 	}
+	notificationErr := s.notificationsService.CreateNotificationForAllAdmins(
+		context.Background(),
+		notificationType.String(),
+		title,
+		message,
+		nil,
+		league.OrgID,
+	)
+	if notificationErr != nil {
+		slog.Warn("failed to send league submitted notification to admins", "leagueID", league.ID, "err", notificationErr)
+		// Don't return error - league was already created, notification failure isn't critical
+	}
+}
 
-	// If venue_id is nil and form_data has venue_name, create it
-	if league.VenueID == nil && league.FormData != nil {
-		venueName, hasName := league.FormData["venue_name"].(string)
-		venueAddress, hasAddress := league.FormData["venue_address"].(string)
-		if (hasName && venueName != "") || (hasAddress && venueAddress != "") {
-			lat := 0.0
-			lng := 0.0
-			if venueLatVal, ok := league.FormData["venue_lat"].(float64); ok {
-				lat = venueLatVal
-			}
-			if venueLngVal, ok := league.FormData["venue_lng"].(float64); ok {
-				lng = venueLngVal
-			}
+// decideSubmission runs a new submission through the auto-approval policy
+// When the facts or rules can't be loaded the submission is queued, as it would be without a policy
+func (s *Service) decideSubmission(ctx context.Context, orgID string, league *League) *PolicyDecision {
+	now := time.Now()
+	signals, err := s.policySignals(ctx, orgID, league)
+	if err != nil {
+		slog.Warn("failed to gather policy signals, queueing league", "orgID", orgID, "err", err)
+		return &PolicyDecision{Outcome: PolicyQueue, RuleName: unavailablePolicyRuleName, DecidedAt: Timestamp{Time: now}}
+	}
 
-			venueReq := &venues.CreateVenueRequest{
-				Name:    venueName,
-				Address: venueAddress,
-				Lat:     lat,
-				Lng:     lng,
-			}
-			newVenue, err := s.venuesService.CreateVenue(ctx, venueReq)
-			if err != nil {
-				return fmt.Errorf("failed to create venue: %w", err)
-			}
-			league.VenueID = &newVenue.ID
-		}
+	// Organizers can't read the rules, so they are loaded without RLS
+	rules, err := s.serviceRepository.GetPolicyRules(ctx)
+	if err != nil {
+		slog.Warn("failed to load policy rules, queueing league", "orgID", orgID, "err", err)
+		return &PolicyDecision{Outcome: PolicyQueue, RuleName: unavailablePolicyRuleName, Signals: *signals, DecidedAt: Timestamp{Time: now}}
 	}
+	return evaluatePolicy(rules, *signals, now)
+}
 
-	// Update league status to approved and persist any newly created sport/venue IDs
-	err = repo.UpdateStatusByUUID(ctx, id, LeagueStatusApproved, nil, league.SportID, league.VenueID)
+// policySignals gathers what the policy rules check about a submission
+func (s *Service) policySignals(ctx context.Context, orgID string, league *League) (*PolicySignals, error) {
+	org, err := s.orgService.GetOrganizationByID(ctx, orgID)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed to fetch organization: %w", err)
 	}
 
-	// Send notification to league creator that their league was approved
-	if league.CreatedBy != nil {
-		leagueName := ""
-		if league.LeagueName != nil {
-			leagueName = *league.LeagueName
+	repo := s.repository(ctx)
+	history, err := repo.GetByOrgID(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organization leagues: %w", err)
+	}
+
+	signals := &PolicySignals{
+		OrgVerified:             org != nil && org.VerifiedAt != nil,
+		HasSupplementalRequests: hasSupplementalRequests(league),
+	}
+	signals.ApprovedLeagues, signals.RejectedLeagues = approvalHistory(history)
+
+	if league.SportID != nil && league.PricingPerPlayer != nil {
+		peers, err := repo.GetAllApprovedFiltered(ctx, LeagueFilter{SportID: league.SportID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch leagues for price comparison: %w", err)
 		}
-		ctx := context.Background()
-		notificationErr := s.notificationsService.CreateNotification(
-			ctx,
-			*league.CreatedBy,
-			notifications.NotificationLeagueApproved.String(),
-			"League Approved",
-			fmt.Sprintf("Your league '%s' has been approved!", leagueName),
-			nil,
-			league.OrgID,
-		)
-		if notificationErr != nil {
-			slog.Error("failed to send league approval notification", "leagueID", id, "userID", league.CreatedBy, "err", notificationErr)
-			// Don't return error - league was already approved, notification failure isn't critical
+		if reason := priceOutlierReason(league.PricingPerPlayer, peers); reason != "" {
+			signals.PriceOutlier = true
+			signals.Reasons = append(signals.Reasons, reason)
 		}
 	}
 
-	return nil
+	if reasons := suspiciousURLReasons(league.RegistrationURL); len(reasons) > 0 {
+		signals.SuspiciousURL = true
+		signals.Reasons = append(signals.Reasons, reasons...)
+	}
+	return signals, nil
 }
 
-// RejectLeagueByUUID rejects a pending league submission with a reason by UUID (admin only)
-func (s *Service) RejectLeagueByUUID(ctx context.Context, userID string, id string, rejectionReason string) error {
-	// Verify user is admin
-	isAdmin, err := s.authService.IsUserAdmin(ctx, userID)
-	if err != nil {
-		return fmt.Errorf("failed to verify admin status: %w", err)
+// autoApproveLeague approves a submission the policy trusts and tells the organizer it's live
+// Returns false, leaving the league in the review queue, if the approval fails
+func (s *Service) autoApproveLeague(ctx context.Context, league *League) bool {
+	// The organizer's own client may not be allowed to approve or to create the sport and venue
+	approval := newLeagueApproval(league, approvedStatusColumns())
+	if err := s.serviceRepository.ApproveLeague(ctx, *league.ID, approval); err != nil {
+		slog.Error("failed to auto-approve league, leaving it queued", "leagueID", *league.ID, "err", err)
+		return false
+	}
+	league.SportID = approval.SportID
+	league.VenueID = approval.VenueID
+	league.Status = LeagueStatusApproved
+
+	note := fmt.Sprintf("Auto-approved by policy rule '%s'", league.PolicyDecision.RuleName)
+	s.recordRevision(ctx, s.serviceRepository, league, RevisionApproved, "", nil, &note)
+	s.notifyLeagueEditor(league, notifications.NotificationLeagueApproved, "League Approved",
+		fmt.Sprintf("Your league '%s' has been approved!", leagueDisplayName(league)))
+	return true
+}
+
+// GetPolicyRules lists the auto-approval policy rules in the order they're tried (admin only)
+func (s *Service) GetPolicyRules(ctx context.Context, userID string) ([]PolicyRule, error) {
+	if err := s.requirePolicyAdmin(ctx, userID); err != nil {
+		return nil, err
 	}
-	if !isAdmin {
-		return fmt.Errorf("only admins can reject leagues")
+	return s.repository(ctx).GetPolicyRules(ctx)
+}
+
+// CreatePolicyRule adds a rule to the auto-approval policy (admin only)
+func (s *Service) CreatePolicyRule(ctx context.Context, userID string, request *PolicyRuleRequest) (*PolicyRule, error) {
+	if err := s.requirePolicyAdmin(ctx, userID); err != nil {
+		return nil, err
 	}
 
-	if rejectionReason == "" {
-		return fmt.Errorf("rejection reason cannot be empty")
+	rule := policyRuleFromRequest(request)
+	rule.CreatedBy = &userID
+	if err := s.repository(ctx).CreatePolicyRule(ctx, rule); err != nil {
+		return nil, err
 	}
+	slog.Info("policy rule created", "ruleID", rule.ID, "name", rule.Name, "outcome", rule.Outcome, "userID", userID)
+	return rule, nil
+}
 
-	// Get the league to retrieve creator info
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
-	league, err := repo.GetByUUID(ctx, id)
-	if err != nil {
-		return fmt.Errorf("failed to fetch league: %w", err)
+// UpdatePolicyRule replaces the settings of an auto-approval policy rule (admin only)
+func (s *Service) UpdatePolicyRule(ctx context.Context, userID string, id int64, request *PolicyRuleRequest) (*PolicyRule, error) {
+	if err := s.requirePolicyAdmin(ctx, userID); err != nil {
+		return nil, err
 	}
 
-	// Check if league is already rejected
-	if league.Status == LeagueStatusRejected {
-		return fmt.Errorf("league is already rejected")
+	rule := policyRuleFromRequest(request)
+	rule.ID = id
+	if err := s.repository(ctx).UpdatePolicyRule(ctx, rule); err != nil {
+		return nil, err
 	}
+	slog.Info("policy rule updated", "ruleID", rule.ID, "name", rule.Name, "outcome", rule.Outcome, "enabled", rule.Enabled, "userID", userID)
+	return rule, nil
+}
 
-	// Update the league status
-	err = repo.UpdateStatusByUUID(ctx, id, LeagueStatusRejected, &rejectionReason, nil, nil)
-	if err != nil {
+// DeletePolicyRule removes a rule from the auto-approval policy (admin only)
+func (s *Service) DeletePolicyRule(ctx context.Context, userID string, id int64) error {
+	if err := s.requirePolicyAdmin(ctx, userID); err != nil {
 		return err
 	}
-
-	// Send notification to league creator that their league was rejected
-	if league.CreatedBy != nil {
-		leagueName := ""
-		if league.LeagueName != nil {
-			leagueName = *league.LeagueName
-		}
-		ctx := context.Background()
-		notificationErr := s.notificationsService.CreateNotification(
-			ctx,
-			*league.CreatedBy,
-			notifications.NotificationLeagueRejected.String(),
-			"League Rejected",
-			fmt.Sprintf("Your league '%s' was rejected. Reason: %s", leagueName, rejectionReason),
-			nil,
-			league.OrgID,
-		)
-		if notificationErr != nil {
-			slog.Error("failed to send league rejection notification", "leagueID", id, "userID", league.CreatedBy, "err", notificationErr)
-			// Don't return error - league was already rejected, notification failure isn't critical
-		}
+	if err := s.repository(ctx).DeletePolicyRule(ctx, id); err != nil {
+		return err
 	}
+	slog.Info("policy rule deleted", "ruleID", id, "userID", userID)
+	return nil
+}
 
+// requirePolicyAdmin checks that the user may manage the auto-approval policy
+func (s *Service) requirePolicyAdmin(ctx context.Context, userID string) error {
+	isAdmin, err := s.authService.IsUserAdmin(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to verify admin status: %w", err)
+	}
+	if !isAdmin {
+		return shared.Forbidden("only admins can manage the approval policy")
+	}
 	return nil
 }
 
 // ============= DRAFT METHODS =============
 
 // GetDraft retrieves the draft for an organization
-func (s *Service) GetDraft(ctx context.Context, orgID string) (*LeagueDraft, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+func (s *Service) GetDraft(ctx context.Context, userID string, orgID string, appRole string) (*LeagueDraft, error) {
+	if err := s.verifyOrgAccess(ctx, userID, orgID, appRole); err != nil {
+		return nil, err
+	}
+	repo := s.repository(ctx)
 	return repo.GetDraftByOrgID(ctx, orgID)
 }
 
 // SaveDraft saves or updates a draft for an organization
-func (s *Service) SaveDraft(ctx context.Context, orgID string, userID string, draftName *string, formData FormData) (*LeagueDraft, error) {
+func (s *Service) SaveDraft(ctx context.Context, orgID string, userID string, appRole string, draftName *string, formData FormData) (*LeagueDraft, error) {
 	if formData == nil || len(formData) == 0 {
-		return nil, fmt.Errorf("draft data cannot be empty")
+		return nil, shared.BadRequest("draft data cannot be empty")
+	}
+	if err := s.verifyOrgAccess(ctx, userID, orgID, appRole); err != nil {
+		return nil, err
 	}
 
 	// Auto-generate draft name from league_name if not provided
@@ -649,8 +2387,7 @@ func (s *Service) SaveDraft(ctx context.Context, orgID string, userID string, dr
 		CreatedBy: &userID,
 	}
 
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	if err := repo.SaveDraft(ctx, draft); err != nil {
 		return nil, fmt.Errorf("failed to save draft: %w", err)
 	}
@@ -659,14 +2396,16 @@ func (s *Service) SaveDraft(ctx context.Context, orgID string, userID string, dr
 }
 
 // UpdateDraft updates an existing draft with new data
-func (s *Service) UpdateDraft(ctx context.Context, draftID int, orgID string, formData FormData) (*LeagueDraft, error) {
+func (s *Service) UpdateDraft(ctx context.Context, userID string, draftID int, orgID string, appRole string, formData FormData) (*LeagueDraft, error) {
 	if formData == nil || len(formData) == 0 {
-		return nil, fmt.Errorf("draft data cannot be empty")
+		return nil, shared.BadRequest("draft data cannot be empty")
+	}
+	if err := s.verifyOrgAccess(ctx, userID, orgID, appRole); err != nil {
+		return nil, err
 	}
 
 	// Fetch existing draft to preserve original fields
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	existing, err := repo.GetDraftByID(ctx, draftID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch draft: %w", err)
@@ -674,12 +2413,12 @@ func (s *Service) UpdateDraft(ctx context.Context, draftID int, orgID string, fo
 
 	// Verify draft belongs to the organization
 	if existing.OrgID != orgID {
-		return nil, fmt.Errorf("draft does not belong to this organization")
+		return nil, shared.Forbidden("draft does not belong to this organization")
 	}
 
 	// Update draft data while preserving other fields
 	existing.FormData = formData
-	existing.UpdatedAt = Timestamp{time.Now()}
+	existing.UpdatedAt = Timestamp{Time: time.Now()}
 
 	if err := repo.SaveDraft(ctx, existing); err != nil {
 		return nil, fmt.Errorf("failed to update draft: %w", err)
@@ -689,13 +2428,16 @@ func (s *Service) UpdateDraft(ctx context.Context, draftID int, orgID string, fo
 }
 
 // SaveTemplate saves a league configuration as a reusable template
-func (s *Service) SaveTemplate(ctx context.Context, orgID string, userID string, name string, formData FormData) (*LeagueDraft, error) {
+func (s *Service) SaveTemplate(ctx context.Context, orgID string, userID string, appRole string, name string, formData FormData) (*LeagueDraft, error) {
 	if formData == nil || len(formData) == 0 {
-		return nil, fmt.Errorf("draft data cannot be empty")
+		return nil, shared.BadRequest("draft data cannot be empty")
 	}
 
 	if name == "" {
-		return nil, fmt.Errorf("template name is required")
+		return nil, shared.Validation(shared.FieldError{Field: "name", Message: "is required"})
+	}
+	if err := s.verifyOrgAccess(ctx, userID, orgID, appRole); err != nil {
+		return nil, err
 	}
 
 	template := &LeagueDraft{
@@ -706,8 +2448,7 @@ func (s *Service) SaveTemplate(ctx context.Context, orgID string, userID string,
 		CreatedBy: &userID,
 	}
 
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	if err := repo.SaveDraft(ctx, template); err != nil {
 		return nil, fmt.Errorf("failed to save template: %w", err)
 	}
@@ -716,27 +2457,34 @@ func (s *Service) SaveTemplate(ctx context.Context, orgID string, userID string,
 }
 
 // GetTemplatesByOrgID retrieves all templates for an organization
-func (s *Service) GetTemplatesByOrgID(ctx context.Context, orgID string) ([]LeagueDraft, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+func (s *Service) GetTemplatesByOrgID(ctx context.Context, userID string, orgID string, appRole string) ([]LeagueDraft, error) {
+	if err := s.verifyOrgAccess(ctx, userID, orgID, appRole); err != nil {
+		return nil, err
+	}
+	repo := s.repository(ctx)
 	return repo.GetTemplatesByOrgID(ctx, orgID)
 }
 
 // GetDraftsByOrgID retrieves all drafts for an organization
-func (s *Service) GetDraftsByOrgID(ctx context.Context, orgID string) ([]LeagueDraft, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+func (s *Service) GetDraftsByOrgID(ctx context.Context, userID string, orgID string, appRole string) ([]LeagueDraft, error) {
+	if err := s.verifyOrgAccess(ctx, userID, orgID, appRole); err != nil {
+		return nil, err
+	}
+	repo := s.repository(ctx)
 	return repo.GetDraftsByOrgID(ctx, orgID)
 }
 
 // UpdateTemplate updates an existing template
-func (s *Service) UpdateTemplate(ctx context.Context, templateID int, orgID string, name string, formData FormData) (*LeagueDraft, error) {
+func (s *Service) UpdateTemplate(ctx context.Context, userID string, templateID int, orgID string, appRole string, name string, formData FormData) (*LeagueDraft, error) {
 	if name == "" {
-		return nil, fmt.Errorf("template name is required")
+		return nil, shared.Validation(shared.FieldError{Field: "name", Message: "is required"})
 	}
 
 	if formData == nil || len(formData) == 0 {
-		return nil, fmt.Errorf("draft data cannot be empty")
+		return nil, shared.BadRequest("draft data cannot be empty")
+	}
+	if err := s.verifyOrgAccess(ctx, userID, orgID, appRole); err != nil {
+		return nil, err
 	}
 
 	template := &LeagueDraft{
@@ -747,8 +2495,7 @@ func (s *Service) UpdateTemplate(ctx context.Context, templateID int, orgID stri
 		Type:     DraftTypeTemplate,
 	}
 
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	if err := repo.UpdateTemplate(ctx, template); err != nil {
 		return nil, fmt.Errorf("failed to update template: %w", err)
 	}
@@ -757,35 +2504,79 @@ func (s *Service) UpdateTemplate(ctx context.Context, templateID int, orgID stri
 }
 
 // DeleteTemplate deletes a template
-func (s *Service) DeleteTemplate(ctx context.Context, templateID int, orgID string) error {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+func (s *Service) DeleteTemplate(ctx context.Context, userID string, templateID int, orgID string, appRole string) error {
+	if err := s.verifyOrgAccess(ctx, userID, orgID, appRole); err != nil {
+		return err
+	}
+	repo := s.repository(ctx)
 	return repo.DeleteTemplate(ctx, templateID, orgID)
 }
 
 // DeleteDraftByID deletes a specific draft by ID for an organization
-func (s *Service) DeleteDraftByID(ctx context.Context, draftID int, orgID string) error {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+func (s *Service) DeleteDraftByID(ctx context.Context, userID string, draftID int, orgID string, appRole string) error {
+	if err := s.verifyOrgAccess(ctx, userID, orgID, appRole); err != nil {
+		return err
+	}
+	repo := s.repository(ctx)
 	return repo.DeleteDraftByID(ctx, draftID, orgID)
 }
 
+// SubmitDraft turns a saved draft into a league submission and removes the draft
+// The draft's form data gets the same validation and review flow as CreateLeague
+func (s *Service) SubmitDraft(ctx context.Context, userID string, draftID int, appRole string) (*League, error) {
+	repo := s.repository(ctx)
+	draft, err := repo.GetDraftByID(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch draft: %w", err)
+	}
+	if err := s.verifyOrgAccess(ctx, userID, draft.OrgID, appRole); err != nil {
+		return nil, err
+	}
+	if draft.Type != DraftTypeDraft {
+		return nil, shared.BadRequest("only drafts can be submitted, not %ss", draft.Type)
+	}
+
+	request, err := draftLeagueRequest(draft.FormData)
+	if err != nil {
+		return nil, err
+	}
+	league, err := s.CreateLeague(ctx, userID, draft.OrgID, appRole, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.DeleteDraftByID(ctx, draft.ID, draft.OrgID); err != nil {
+		// The league exists; a leftover draft is only clutter
+		slog.Warn("failed to delete submitted draft", "draftID", draft.ID, "leagueID", league.ID, "err", err)
+	}
+	return league, nil
+}
+
 // GetAllDrafts retrieves all league drafts across all organizations (admin only)
 func (s *Service) GetAllDrafts(ctx context.Context) ([]LeagueDraft, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetAllDrafts(ctx)
 }
 
 // GetAllTemplates retrieves all templates across all organizations (admin only)
 func (s *Service) GetAllTemplates(ctx context.Context) ([]LeagueDraft, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetAllTemplates(ctx)
 }
 
 // ============= HELPER METHODS =============
 
+// verifyOrgAccess returns a Forbidden error unless the user is a member of the organization or an admin
+func (s *Service) verifyOrgAccess(ctx context.Context, userID string, orgID string, appRole string) error {
+	if appRole == "admin" {
+		return nil
+	}
+	if err := s.orgService.VerifyUserOrgAccess(ctx, userID, orgID); err != nil {
+		return fmt.Errorf("user does not have access to this organization: %w", err)
+	}
+	return nil
+}
+
 // calculatePricingPerPlayer calculates the per-player price based on pricing strategy
 func (s *Service) calculatePricingPerPlayer(strategy PricingStrategy, pricingAmount *float64, minimumTeamPlayers *int) *float64 {
 	if pricingAmount == nil {
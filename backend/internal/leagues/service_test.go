@@ -1,544 +1,386 @@
 package leagues
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
-)
 
-// MockLeagueRepository is a mock implementation of RepositoryInterface for testing
-type MockLeagueRepository struct {
-	leagues      map[int]*League
-	drafts       map[int]*LeagueDraft
-	nextLeagueID int
-	nextDraftID  int
-}
+	"github.com/leaguefindr/backend/internal/auth"
+	"github.com/leaguefindr/backend/internal/notifications"
+	"github.com/leaguefindr/backend/internal/organizations"
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/shared"
+	"github.com/leaguefindr/backend/internal/sports"
+	"github.com/leaguefindr/backend/internal/venues"
+)
 
-func NewMockLeagueRepository() *MockLeagueRepository {
-	return &MockLeagueRepository{
-		leagues:      make(map[int]*League),
-		drafts:       make(map[int]*LeagueDraft),
-		nextLeagueID: 1,
-		nextDraftID:  1,
+// testEnv is a league service wired to in-memory repositories for every dependency
+// It has one admin ("admin"), one organizer ("organizer") and the organizer's organization
+type testEnv struct {
+	service       *Service
+	repo          *MemoryRepository
+	authService   *auth.Service
+	notifications *notifications.Service
+	orgID         string
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	ctx := context.Background()
+
+	users := auth.NewMemoryRepository(
+		auth.User{ID: "admin", Email: "admin@example.com", Role: auth.RoleAdmin, IsActive: true},
+		auth.User{ID: "organizer", Email: "organizer@example.com", Role: auth.RoleOrganizer, IsActive: true},
+	)
+	authService := auth.NewServiceWithRepository(users)
+	orgService := organizations.NewServiceWithRepository(organizations.NewMemoryRepository())
+	orgID, err := orgService.CreateOrganization(ctx, "Seattle Rec", "https://seattlerec.example", "", "", "", "organizer")
+	if err != nil {
+		t.Fatalf("create organization: %v", err)
+	}
+	notificationsService := notifications.NewServiceWithRepository(notifications.NewMemoryRepository(users))
+
+	sportsRepo := sports.NewMemoryRepository()
+	venuesRepo := venues.NewMemoryRepository()
+	repo := NewMemoryRepository(sportsRepo, venuesRepo)
+	service := NewServiceWithRepository(
+		repo,
+		orgService,
+		authService,
+		sports.NewServiceWithRepository(sportsRepo),
+		venues.NewServiceWithRepository(venuesRepo),
+		notificationsService,
+	)
+	return &testEnv{service: service, repo: repo, authService: authService, notifications: notificationsService, orgID: orgID}
+}
+
+// notificationTypes lists the types of the notifications a user has received, newest first
+func (e *testEnv) notificationTypes(t *testing.T, userID string) []string {
+	t.Helper()
+	received, _, _, err := e.notifications.GetNotifications(context.Background(), userID, pagination.Params{Limit: 100})
+	if err != nil {
+		t.Fatalf("get notifications: %v", err)
 	}
-}
-
-func (m *MockLeagueRepository) GetAll() ([]League, error) {
-	var leagues []League
-	for _, league := range m.leagues {
-		leagues = append(leagues, *league)
+	types := make([]string, len(received))
+	for i, notification := range received {
+		types[i] = notification.Type
 	}
-	return leagues, nil
+	return types
 }
 
-func (m *MockLeagueRepository) GetAllApproved() ([]League, error) {
-	var leagues []League
-	for _, league := range m.leagues {
-		if league.Status == LeagueStatusApproved {
-			leagues = append(leagues, *league)
-		}
-	}
-	return leagues, nil
+// renumberLeague gives a stored league a numeric ID, like the leagues from before the move to UUIDs
+func (e *testEnv) renumberLeague(league *League, id string) {
+	e.repo.mu.Lock()
+	defer e.repo.mu.Unlock()
+	e.repo.league(*league.ID).ID = &id
 }
 
-func (m *MockLeagueRepository) GetByID(id int) (*League, error) {
-	league, exists := m.leagues[id]
-	if !exists || league.Status != LeagueStatusApproved {
-		return nil, ErrLeagueNotFound
+// submitTestLeague creates a pending league as the organizer
+func (e *testEnv) submitTestLeague(t *testing.T) *League {
+	t.Helper()
+	league, err := e.service.CreateLeague(context.Background(), "organizer", e.orgID, "", validTestRequest())
+	if err != nil {
+		t.Fatalf("create league: %v", err)
 	}
-	return league, nil
+	return league
 }
 
-func (m *MockLeagueRepository) GetByOrgID(orgID int) ([]League, error) {
-	var leagues []League
-	for _, league := range m.leagues {
-		if league.OrgID != nil && *league.OrgID == orgID {
-			leagues = append(leagues, *league)
-		}
-	}
-	return leagues, nil
-}
+func TestCreateLeague_OrganizerSubmitsForReview(t *testing.T) {
+	env := newTestEnv(t)
 
-func (m *MockLeagueRepository) GetByOrgIDAndStatus(orgID int, status LeagueStatus) ([]League, error) {
-	var leagues []League
-	for _, league := range m.leagues {
-		if league.OrgID != nil && *league.OrgID == orgID && league.Status == status {
-			leagues = append(leagues, *league)
-		}
+	league := env.submitTestLeague(t)
+	if league.Status != LeagueStatusPending || league.LifecycleStatus != LifecycleRegistrationOpen {
+		t.Errorf("expected a pending league open for registration, got %s/%s", league.Status, league.LifecycleStatus)
 	}
-	return leagues, nil
-}
-
-func (m *MockLeagueRepository) Create(league *League) error {
-	league.ID = m.nextLeagueID
-	league.CreatedAt = time.Now()
-	league.UpdatedAt = time.Now()
-	m.leagues[m.nextLeagueID] = league
-	m.nextLeagueID++
-	return nil
-}
-
-func (m *MockLeagueRepository) GetPending() ([]League, error) {
-	var leagues []League
-	for _, league := range m.leagues {
-		if league.Status == LeagueStatusPending {
-			leagues = append(leagues, *league)
-		}
+	if league.CreatedBy == nil || *league.CreatedBy != "organizer" || league.OrgID == nil || *league.OrgID != env.orgID {
+		t.Errorf("unexpected owner %v / %v", league.CreatedBy, league.OrgID)
 	}
-	return leagues, nil
-}
-
-func (m *MockLeagueRepository) UpdateStatus(id int, status LeagueStatus, rejectionReason *string) error {
-	league, exists := m.leagues[id]
-	if !exists {
-		return ErrLeagueNotFound
+	if league.PricingPerPlayer == nil || *league.PricingPerPlayer != 15 {
+		t.Errorf("expected $15 per player, got %v", league.PricingPerPlayer)
 	}
-	league.Status = status
-	league.RejectionReason = rejectionReason
-	league.UpdatedAt = time.Now()
-	return nil
-}
 
-func (m *MockLeagueRepository) GetDraftByOrgID(orgID int) (*LeagueDraft, error) {
-	for _, draft := range m.drafts {
-		if draft.OrgID == orgID {
-			return draft, nil
-		}
+	if types := env.notificationTypes(t, "admin"); len(types) != 1 || types[0] != "league_submitted" {
+		t.Errorf("expected the admin to be told about the submission, got %v", types)
 	}
-	return nil, nil
-}
-
-func (m *MockLeagueRepository) SaveDraft(draft *LeagueDraft) error {
-	// Check if draft already exists for this org
-	for id, d := range m.drafts {
-		if d.OrgID == draft.OrgID {
-			// Update existing draft - preserve ID and CreatedAt
-			draft.ID = d.ID
-			draft.CreatedAt = d.CreatedAt
-			draft.UpdatedAt = time.Now()
-			m.drafts[id] = draft
-			return nil
-		}
+	if approved, _ := env.service.GetApprovedLeagues(context.Background()); len(approved) != 0 {
+		t.Errorf("expected a pending league not to be listed, got %d", len(approved))
 	}
-	// Create new draft
-	draft.ID = m.nextDraftID
-	draft.CreatedAt = time.Now()
-	draft.UpdatedAt = time.Now()
-	m.drafts[m.nextDraftID] = draft
-	m.nextDraftID++
-	return nil
 }
 
-func (m *MockLeagueRepository) DeleteDraft(orgID int) error {
-	for id, draft := range m.drafts {
-		if draft.OrgID == orgID {
-			delete(m.drafts, id)
-			return nil
-		}
-	}
-	return ErrDraftNotFound
-}
+func TestCreateLeague_AdminApprovesDirectly(t *testing.T) {
+	env := newTestEnv(t)
 
-func (m *MockLeagueRepository) GetAllDrafts() ([]LeagueDraft, error) {
-	var drafts []LeagueDraft
-	for _, draft := range m.drafts {
-		drafts = append(drafts, *draft)
+	league, err := env.service.CreateLeague(context.Background(), "admin", env.orgID, "admin", validTestRequest())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if league.Status != LeagueStatusApproved {
+		t.Errorf("expected an admin-created league to be approved, got %s", league.Status)
+	}
+	if types := env.notificationTypes(t, "admin"); len(types) != 0 {
+		t.Errorf("expected no review notification, got %v", types)
 	}
-	return drafts, nil
 }
 
-// Error definitions for testing
-var (
-	ErrLeagueNotFound = &ValidationError{msg: "league not found"}
-	ErrDraftNotFound  = &ValidationError{msg: "draft not found"}
-)
+func TestCreateLeague_RequiresMembership(t *testing.T) {
+	env := newTestEnv(t)
 
-type ValidationError struct {
-	msg string
+	_, err := env.service.CreateLeague(context.Background(), "stranger", env.orgID, "", validTestRequest())
+	if !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected forbidden for a non-member, got %v", err)
+	}
 }
 
-func (e *ValidationError) Error() string {
-	return e.msg
+func TestUpdateLeague_ValidatesRequest(t *testing.T) {
+	env := newTestEnv(t)
+	league := env.submitTestLeague(t)
+
+	request := validTestRequest()
+	request.GameOccurrences[0].EndTime = "17:00"
+	_, err := env.service.UpdateLeague(context.Background(), "organizer", *league.ID, "", request)
+	fields := validationFields(t, err)
+	if len(fields) != 1 || fields[0].Field != "game_occurrences[0].end_time" {
+		t.Errorf("expected the bad end time to be reported, got %+v", fields)
+	}
 }
 
-// ============= SERVICE TESTS =============
+func TestApproveLeagueByUUID(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	league := env.submitTestLeague(t)
 
-func TestGetApprovedLeagues_Success(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
+	if err := env.service.ApproveLeagueByUUID(ctx, "organizer", *league.ID); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected an organizer to be forbidden, got %v", err)
+	}
+	if err := env.service.ApproveLeagueByUUID(ctx, "admin", *league.ID); err != nil {
+		t.Fatalf("approve failed: %v", err)
+	}
 
-	orgID := 1
-	league1 := &League{
-		OrgID:      &orgID,
-		LeagueName: stringPtr("League 1"),
-		Status:     LeagueStatusApproved,
+	approved, err := env.service.GetApprovedLeagues(ctx)
+	if err != nil || len(approved) != 1 || *approved[0].ID != *league.ID {
+		t.Fatalf("expected the league to be listed, got %+v, %v", approved, err)
 	}
-	league2 := &League{
-		OrgID:      &orgID,
-		LeagueName: stringPtr("League 2"),
-		Status:     LeagueStatusApproved,
+	// The sport submitted by name is created on approval
+	if approved[0].SportID == nil {
+		t.Error("expected the submitted sport to be created and linked")
 	}
-	league3 := &League{
-		OrgID:      &orgID,
-		LeagueName: stringPtr("Pending League"),
-		Status:     LeagueStatusPending,
+	if types := env.notificationTypes(t, "organizer"); len(types) != 1 || types[0] != "league_approved" {
+		t.Errorf("expected the organizer to be told about the approval, got %v", types)
 	}
 
-	repo.Create(league1)
-	repo.Create(league2)
-	repo.Create(league3)
-
-	leagues, err := service.GetApprovedLeagues()
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+	if err := env.service.ApproveLeagueByUUID(ctx, "admin", *league.ID); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict approving twice, got %v", err)
 	}
-
-	if len(leagues) != 2 {
-		t.Errorf("expected 2 approved leagues, got %d", len(leagues))
+	if err := env.service.ApproveLeagueByUUID(ctx, "admin", "00000000-0000-0000-0000-000000000000"); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected not found for an unknown league, got %v", err)
 	}
 }
 
-func TestGetLeagueByID_Success(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
+func TestRejectLeagueByUUID(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	league := env.submitTestLeague(t)
 
-	orgID := 1
-	league := &League{
-		OrgID:      &orgID,
-		LeagueName: stringPtr("Test League"),
-		Status:     LeagueStatusApproved,
+	if err := env.service.RejectLeagueByUUID(ctx, "admin", *league.ID, ""); !errors.Is(err, shared.ErrValidation) {
+		t.Errorf("expected a validation error without a reason, got %v", err)
 	}
-	repo.Create(league)
-
-	retrieved, err := service.GetLeagueByID(league.ID)
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+	if err := env.service.RejectLeagueByUUID(ctx, "admin", *league.ID, "Missing venue"); err != nil {
+		t.Fatalf("reject failed: %v", err)
 	}
 
-	if retrieved.LeagueName == nil || *retrieved.LeagueName != "Test League" {
-		t.Error("league name mismatch")
+	stored, _ := env.service.GetLeagueByUUID(ctx, *league.ID)
+	if stored.Status != LeagueStatusRejected || stored.RejectionReason == nil || *stored.RejectionReason != "Missing venue" {
+		t.Errorf("expected a rejected league with its reason, got %s %v", stored.Status, stored.RejectionReason)
 	}
-}
-
-func TestGetLeaguesByOrgID_Success(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
-
-	orgID1 := 1
-	orgID2 := 2
-	league1 := &League{OrgID: &orgID1, LeagueName: stringPtr("Org1 League1"), Status: LeagueStatusApproved}
-	league2 := &League{OrgID: &orgID1, LeagueName: stringPtr("Org1 League2"), Status: LeagueStatusPending}
-	league3 := &League{OrgID: &orgID2, LeagueName: stringPtr("Org2 League1"), Status: LeagueStatusApproved}
-
-	repo.Create(league1)
-	repo.Create(league2)
-	repo.Create(league3)
-
-	leagues, err := service.GetLeaguesByOrgID(orgID1)
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+	if types := env.notificationTypes(t, "organizer"); len(types) != 1 || types[0] != "league_rejected" {
+		t.Errorf("expected the organizer to be told about the rejection, got %v", types)
 	}
-
-	if len(leagues) != 2 {
-		t.Errorf("expected 2 leagues for org1, got %d", len(leagues))
+	if err := env.service.RejectLeagueByUUID(ctx, "admin", *league.ID, "Again"); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict rejecting twice, got %v", err)
 	}
-}
 
-func TestCalculatePricingPerPlayer_PerTeam(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
-
-	// Test per-team pricing: $100 / 5 players = $20 per player
-	pricing := 100.0
-	minPlayers := 5
-	result := service.calculatePricingPerPlayer(PricingStrategyPerTeam, &pricing, &minPlayers)
-
-	if result == nil || *result != 20.0 {
-		t.Errorf("expected $20 per player, got %v", result)
+	history, _ := env.service.GetLeagueHistory(ctx, *league.ID)
+	if len(history) != 2 || history[0].Action != RevisionCreated || history[1].Action != RevisionRejected {
+		t.Errorf("expected created and rejected revisions, got %+v", history)
 	}
 }
 
-func TestCalculatePricingPerPlayer_PerTeamWithRounding(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
+func TestReviewLeagueByIntegerID(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	league := env.submitTestLeague(t)
+	now := time.Now()
 
-	// Test per-team pricing with rounding: $100 / 3 players = $33.33... rounded up to $34
-	pricing := 100.0
-	minPlayers := 3
-	result := service.calculatePricingPerPlayer(PricingStrategyPerTeam, &pricing, &minPlayers)
+	env.renumberLeague(league, "7")
 
-	if result == nil || *result != 34.0 {
-		t.Errorf("expected $34 per player (rounded up), got %v", result)
+	if claimed, err := env.repo.ClaimLeague(ctx, "7", "other-admin", now, now.Add(claimDuration)); err != nil || !claimed {
+		t.Fatalf("claim league: %v, %v", claimed, err)
 	}
-}
-
-func TestCalculatePricingPerPlayer_PerPerson(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
-
-	// Test per-person pricing: $15 = $15 per player
-	pricing := 15.0
-	minPlayers := 5
-	result := service.calculatePricingPerPlayer(PricingStrategyPerPerson, &pricing, &minPlayers)
-
-	if result == nil || *result != 15.0 {
-		t.Errorf("expected $15 per player, got %v", result)
+	if err := env.service.ApproveLeague(ctx, "admin", 7); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict approving a league claimed by another admin, got %v", err)
 	}
-}
-
-func TestCreateLeague_Success(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
-
-	req := &CreateLeagueRequest{
-		SportID:              1,
-		LeagueName:           stringPtr("Test League"),
-		Division:             stringPtr("Beginner"),
-		RegistrationDeadline: stringPtr("2025-12-31"),
-		SeasonStartDate:      stringPtr("2026-01-15"),
-		GameOccurrences:      GameOccurrences{{Day: "Monday", StartTime: "19:00", EndTime: "21:00"}},
-		PricingStrategy:      PricingStrategyPerPerson,
-		PricingAmount:        float64Ptr(50.0),
-		AgeGroup:             stringPtr("Adult"),
-		Gender:               stringPtr("Co-ed"),
-		RegistrationURL:      stringPtr("https://example.com/register"),
-		Duration:             intPtr(10),
-		MinimumTeamPlayers:   intPtr(5),
-	}
-
-	league, err := service.CreateLeague("user_123", req)
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+	if err := env.service.RejectLeague(ctx, "admin", 7, "Spam"); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict rejecting a league claimed by another admin, got %v", err)
 	}
 
-	if league.Status != LeagueStatusPending {
-		t.Errorf("expected status pending, got %s", league.Status)
+	if claimed, _ := env.repo.ClaimLeague(ctx, "7", "other-admin", now, now.Add(-time.Minute)); !claimed {
+		t.Fatal("expected the claimant to shorten their own claim")
 	}
-
-	if league.CreatedBy == nil || *league.CreatedBy != "user_123" {
-		t.Error("created_by not set correctly")
+	if err := env.service.ApproveLeague(ctx, "admin", 7); err != nil {
+		t.Fatalf("approve league: %v", err)
 	}
-}
-
-func TestCreateLeague_InvalidDateFormat(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
-
-	req := &CreateLeagueRequest{
-		SportID:              1,
-		RegistrationDeadline: stringPtr("invalid-date"),
-		SeasonStartDate:      stringPtr("2026-01-15"),
+	if err := env.service.RejectLeague(ctx, "admin", 7, "Spam"); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict rejecting an approved league, got %v", err)
 	}
-
-	_, err := service.CreateLeague("user_123", req)
-	if err == nil {
-		t.Error("expected error for invalid date format")
+	if err := env.service.ApproveLeague(ctx, "admin", 8); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected not found for an unknown league, got %v", err)
 	}
 }
 
-func TestApproveLeague_Success(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
+func TestReviewLeagueByIntegerIDRecordsRevisions(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	env.renumberLeague(env.submitTestLeague(t), "7")
+	env.renumberLeague(env.submitTestLeague(t), "8")
 
-	orgID := 1
-	league := &League{
-		OrgID:      &orgID,
-		LeagueName: stringPtr("Test League"),
-		Status:     LeagueStatusPending,
+	if err := env.service.ApproveLeague(ctx, "admin", 7); err != nil {
+		t.Fatalf("approve league: %v", err)
 	}
-	repo.Create(league)
-	leagueID := league.ID
-
-	err := service.ApproveLeague(leagueID)
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+	if err := env.service.RejectLeague(ctx, "admin", 8, "Missing venue"); err != nil {
+		t.Fatalf("reject league: %v", err)
 	}
 
-	updated := repo.leagues[leagueID]
-	if updated.Status != LeagueStatusApproved {
-		t.Errorf("expected status approved, got %s", updated.Status)
+	approved, _ := env.service.GetLeagueHistory(ctx, "7")
+	if len(approved) != 1 || approved[0].Action != RevisionApproved || approved[0].CreatedBy == nil || *approved[0].CreatedBy != "admin" {
+		t.Errorf("expected an approval revision by the admin, got %+v", approved)
+	}
+	rejected, _ := env.service.GetLeagueHistory(ctx, "8")
+	if len(rejected) != 1 || rejected[0].Action != RevisionRejected || rejected[0].Note == nil || *rejected[0].Note != "Missing venue" {
+		t.Errorf("expected a rejection revision with the reason, got %+v", rejected)
 	}
 }
 
-func TestRejectLeague_Success(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
+func TestDraftsAndTemplatesStaySeparate(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
 
-	orgID := 1
-	league := &League{
-		OrgID:      &orgID,
-		LeagueName: stringPtr("Test League"),
-		Status:     LeagueStatusPending,
-	}
-	repo.Create(league)
-	leagueID := league.ID
-
-	reason := "Does not meet requirements"
-	err := service.RejectLeague(leagueID, reason)
+	draft, err := env.service.SaveDraft(ctx, env.orgID, "organizer", "", nil, FormData{"league_name": "Fall Kickball"})
 	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+		t.Fatalf("save draft failed: %v", err)
 	}
-
-	updated := repo.leagues[leagueID]
-	if updated.Status != LeagueStatusRejected {
-		t.Errorf("expected status rejected, got %s", updated.Status)
+	if draft.Name == nil || *draft.Name != "Fall Kickball Draft" {
+		t.Errorf("expected a generated draft name, got %v", draft.Name)
 	}
-
-	if updated.RejectionReason == nil || *updated.RejectionReason != reason {
-		t.Errorf("expected rejection reason %s, got %v", reason, updated.RejectionReason)
+	template, err := env.service.SaveTemplate(ctx, env.orgID, "organizer", "", "Kickball", FormData{"sport_name": "Kickball"})
+	if err != nil {
+		t.Fatalf("save template failed: %v", err)
 	}
-}
-
-func TestRejectLeague_EmptyReason(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
 
-	orgID := 1
-	league := &League{
-		OrgID:      &orgID,
-		LeagueName: stringPtr("Test League"),
-		Status:     LeagueStatusPending,
+	drafts, _ := env.service.GetDraftsByOrgID(ctx, "organizer", env.orgID, "")
+	templates, _ := env.service.GetTemplatesByOrgID(ctx, "organizer", env.orgID, "")
+	if len(drafts) != 1 || drafts[0].ID != draft.ID || len(templates) != 1 || templates[0].ID != template.ID {
+		t.Fatalf("expected one draft and one template, got %+v and %+v", drafts, templates)
 	}
-	repo.Create(league)
 
-	err := service.RejectLeague(league.ID, "")
-	if err == nil {
-		t.Error("expected error for empty rejection reason")
+	// Template operations never touch drafts
+	if _, err := env.service.UpdateTemplate(ctx, "organizer", draft.ID, env.orgID, "", "Renamed", FormData{"a": 1}); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected updating a draft as a template to be not found, got %v", err)
 	}
-}
-
-// ============= DRAFT TESTS =============
-
-func TestSaveDraft_Success(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
-
-	orgID := 1
-	draftData := DraftData{"name": "Test League Draft", "status": "draft"}
-
-	draft, err := service.SaveDraft(orgID, "user_123", draftData)
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+	if err := env.service.DeleteTemplate(ctx, "organizer", draft.ID, env.orgID, ""); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected deleting a draft as a template to be not found, got %v", err)
 	}
 
-	if draft.OrgID != orgID {
-		t.Errorf("expected org_id %d, got %d", orgID, draft.OrgID)
+	updated, err := env.service.UpdateDraft(ctx, "organizer", draft.ID, env.orgID, "", FormData{"league_name": "Winter Kickball"})
+	if err != nil || updated.FormData["league_name"] != "Winter Kickball" {
+		t.Errorf("expected the draft to be updated, got %+v, %v", updated, err)
 	}
-
-	if draft.CreatedBy == nil || *draft.CreatedBy != "user_123" {
-		t.Error("created_by not set correctly")
+	if _, err := env.service.UpdateDraft(ctx, "organizer", draft.ID, "other-org", "", FormData{"a": 1}); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected another organization to be forbidden, got %v", err)
 	}
-}
-
-func TestSaveDraft_UpdateExisting(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
-
-	orgID := 1
-	draftData1 := DraftData{"name": "Original Draft"}
-	draftData2 := DraftData{"name": "Updated Draft"}
 
-	// Save initial draft
-	draft1, err := service.SaveDraft(orgID, "user_123", draftData1)
-	if err != nil {
-		t.Fatalf("first save failed: %v", err)
+	if err := env.service.DeleteTemplate(ctx, "organizer", template.ID, env.orgID, ""); err != nil {
+		t.Fatalf("delete template failed: %v", err)
 	}
-	originalID := draft1.ID
-
-	// Update draft
-	draft2, err := service.SaveDraft(orgID, "user_456", draftData2)
-	if err != nil {
-		t.Fatalf("second save failed: %v", err)
+	if templates, _ := env.service.GetAllTemplates(ctx); len(templates) != 0 {
+		t.Errorf("expected no templates, got %+v", templates)
 	}
-
-	if draft2.ID != originalID {
-		t.Errorf("expected same draft ID, got different ID")
+	if drafts, _ := env.service.GetAllDrafts(ctx); len(drafts) != 1 {
+		t.Errorf("expected the draft to remain, got %+v", drafts)
 	}
 }
 
-func TestGetDraft_Success(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
-
-	orgID := 1
-	draftData := DraftData{"name": "Test Draft"}
-	service.SaveDraft(orgID, "user_123", draftData)
-
-	draft, err := service.GetDraft(orgID)
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
-	}
+func TestOrgScopedMethodsRequireMembership(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	env.submitTestLeague(t)
+	draft, _ := env.service.SaveDraft(ctx, env.orgID, "organizer", "", nil, FormData{"league_name": "Fall Kickball"})
+	template, _ := env.service.SaveTemplate(ctx, env.orgID, "organizer", "", "Kickball", FormData{"sport_name": "Kickball"})
+	changed := FormData{"league_name": "Hijacked"}
 
-	if draft == nil {
-		t.Error("expected draft to be returned")
+	calls := map[string]error{}
+	_, calls["GetLeaguesByOrgID"] = env.service.GetLeaguesByOrgID(ctx, "stranger", env.orgID, "")
+	_, calls["GetLeaguesByOrgIDAndStatus"] = env.service.GetLeaguesByOrgIDAndStatus(ctx, "stranger", env.orgID, "", LeagueStatusPending)
+	_, calls["GetDraft"] = env.service.GetDraft(ctx, "stranger", env.orgID, "")
+	_, calls["GetDraftsByOrgID"] = env.service.GetDraftsByOrgID(ctx, "stranger", env.orgID, "")
+	_, calls["GetTemplatesByOrgID"] = env.service.GetTemplatesByOrgID(ctx, "stranger", env.orgID, "")
+	_, calls["SaveDraft"] = env.service.SaveDraft(ctx, env.orgID, "stranger", "", nil, changed)
+	_, calls["UpdateDraft"] = env.service.UpdateDraft(ctx, "stranger", draft.ID, env.orgID, "", changed)
+	_, calls["SaveTemplate"] = env.service.SaveTemplate(ctx, env.orgID, "stranger", "", "Hijacked", changed)
+	_, calls["UpdateTemplate"] = env.service.UpdateTemplate(ctx, "stranger", template.ID, env.orgID, "", "Hijacked", changed)
+	_, calls["SubmitDraft"] = env.service.SubmitDraft(ctx, "stranger", draft.ID, "")
+	calls["DeleteTemplate"] = env.service.DeleteTemplate(ctx, "stranger", template.ID, env.orgID, "")
+	calls["DeleteDraftByID"] = env.service.DeleteDraftByID(ctx, "stranger", draft.ID, env.orgID, "")
+	for name, err := range calls {
+		if !errors.Is(err, shared.ErrForbidden) {
+			t.Errorf("%s: expected a non-member to be forbidden, got %v", name, err)
+		}
 	}
 
-	if draft.OrgID != orgID {
-		t.Errorf("expected org_id %d, got %d", orgID, draft.OrgID)
+	// Admins act for any organization, and see that nothing changed
+	drafts, err := env.service.GetDraftsByOrgID(ctx, "admin", env.orgID, "admin")
+	if err != nil || len(drafts) != 1 || drafts[0].FormData["league_name"] != "Fall Kickball" {
+		t.Errorf("expected the organizer's draft untouched, got %+v, %v", drafts, err)
 	}
-}
-
-func TestGetDraft_NotFound(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
-
-	draft, err := service.GetDraft(999)
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+	templates, err := env.service.GetTemplatesByOrgID(ctx, "admin", env.orgID, "admin")
+	if err != nil || len(templates) != 1 || *templates[0].Name != "Kickball" {
+		t.Errorf("expected the organizer's template untouched, got %+v, %v", templates, err)
 	}
-
-	if draft != nil {
-		t.Error("expected nil draft for non-existent org")
+	if leagues, err := env.service.GetLeaguesByOrgID(ctx, "admin", env.orgID, "admin"); err != nil || len(leagues) != 1 {
+		t.Errorf("expected the organization's league, got %d, %v", len(leagues), err)
 	}
 }
 
-func TestDeleteDraft_Success(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
+func TestSubmitDraft(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
 
-	orgID := 1
-	draftData := DraftData{"name": "Test Draft"}
-	service.SaveDraft(orgID, "user_123", draftData)
-
-	err := service.DeleteDraft(orgID)
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
-	}
-
-	// Verify draft is deleted
-	draft, _ := service.GetDraft(orgID)
-	if draft != nil {
-		t.Error("expected draft to be deleted")
+	template, _ := env.service.SaveTemplate(ctx, env.orgID, "organizer", "", "Kickball", FormData{"sport_name": "Kickball"})
+	if _, err := env.service.SubmitDraft(ctx, "organizer", template.ID, ""); !errors.Is(err, shared.ErrBadRequest) {
+		t.Errorf("expected a template submission to be rejected, got %v", err)
 	}
 }
 
-func TestGetAllDrafts_Success(t *testing.T) {
-	repo := NewMockLeagueRepository()
-	service := NewService(repo)
-
-	// Create multiple drafts
-	service.SaveDraft(1, "user_123", DraftData{"name": "Draft 1"})
-	service.SaveDraft(2, "user_456", DraftData{"name": "Draft 2"})
-	service.SaveDraft(3, "user_789", DraftData{"name": "Draft 3"})
-
-	drafts, err := service.GetAllDrafts()
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+func TestCalculatePricingPerPlayer(t *testing.T) {
+	service := newTestEnv(t).service
+	tests := []struct {
+		strategy   PricingStrategy
+		amount     float64
+		minPlayers int
+		want       float64
+	}{
+		{PricingStrategyPerTeam, 100, 5, 20},  // $100 / 5 players
+		{PricingStrategyPerTeam, 100, 3, 34},  // $33.33 rounded up
+		{PricingStrategyPerPerson, 15, 5, 15}, // Per-person pricing is already per player
 	}
-
-	if len(drafts) != 3 {
-		t.Errorf("expected 3 drafts, got %d", len(drafts))
+	for _, tt := range tests {
+		result := service.calculatePricingPerPlayer(tt.strategy, &tt.amount, &tt.minPlayers)
+		if result == nil || *result != tt.want {
+			t.Errorf("%s $%v / %d: expected $%v per player, got %v", tt.strategy, tt.amount, tt.minPlayers, tt.want, result)
+		}
 	}
 }
 
-// Helper functions
 func stringPtr(s string) *string {
 	return &s
 }
-
-func float64Ptr(f float64) *float64 {
-	return &f
-}
-
-func intPtr(i int) *int {
-	return &i
-}
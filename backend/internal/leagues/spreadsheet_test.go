@@ -0,0 +1,95 @@
+package leagues
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// buildXLSX zips the given parts into a minimal workbook
+func buildXLSX(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("zip create: %v", err)
+		}
+		w.Write([]byte(content))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zip close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadXLSX(t *testing.T) {
+	data := buildXLSX(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+			<sheets><sheet name="Leagues" sheetId="1" r:id="rId3"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+			<Relationship Id="rId1" Target="styles.xml"/>
+			<Relationship Id="rId3" Target="worksheets/leagues.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+			<si><t>league_name</t></si><si><t>season_start_date</t></si>
+			<si><r><t>Spring </t></r><r><t>Kickball</t></r></si></sst>`,
+		"xl/worksheets/leagues.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+			<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+			<row r="3"><c r="A3" t="s"><v>2</v></c><c r="C3" t="inlineStr"><is><t>extra</t></is></c></row>
+			<row r="4"><c r="B4"><v>45731</v></c></row>
+		</sheetData></worksheet>`,
+	})
+
+	rows, err := readXLSX(data)
+	if err != nil {
+		t.Fatalf("readXLSX: %v", err)
+	}
+
+	want := [][]string{
+		{"league_name", "season_start_date"},
+		nil,
+		{"Spring Kickball", "", "extra"},
+		{"", "45731"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("readXLSX() = %q, want %q", rows, want)
+	}
+}
+
+func TestReadXLSXRejectsNonWorkbooks(t *testing.T) {
+	if _, err := readXLSX([]byte("league_name,division\n")); err == nil {
+		t.Errorf("expected an error for a CSV file")
+	}
+	if _, err := readXLSX(buildXLSX(t, map[string]string{"word/document.xml": "<document/>"})); err == nil {
+		t.Errorf("expected an error for a zip without a workbook")
+	}
+}
+
+func TestReadCSVStripsByteOrderMark(t *testing.T) {
+	rows, err := readCSV([]byte("\xef\xbb\xbfleague_name,division\nSpring,A,extra\n"))
+	if err != nil {
+		t.Fatalf("readCSV: %v", err)
+	}
+	if rows[0][0] != "league_name" || len(rows[1]) != 3 {
+		t.Errorf("unexpected rows: %q", rows)
+	}
+}
+
+func TestParseImportFormat(t *testing.T) {
+	tests := map[string]ImportFormat{
+		".csv":                    ImportFormatCSV,
+		"text/csv; charset=utf-8": ImportFormatCSV,
+		".XLSX":                   ImportFormatXLSX,
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": ImportFormatXLSX,
+	}
+	for value, want := range tests {
+		if got, ok := ParseImportFormat(value); !ok || got != want {
+			t.Errorf("ParseImportFormat(%q) = %q, %v; want %q", value, got, ok, want)
+		}
+	}
+	if _, ok := ParseImportFormat(".xls"); ok {
+		t.Errorf("expected legacy .xls to be rejected")
+	}
+}
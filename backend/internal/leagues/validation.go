@@ -0,0 +1,199 @@
+package leagues
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/schedule"
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// validationMessages returns the field messages of a validation error, or the error itself as the only message
+func validationMessages(err error) []string {
+	domainErr, ok := shared.AsError(err)
+	if !ok || len(domainErr.Fields) == 0 {
+		return []string{err.Error()}
+	}
+	messages := make([]string, len(domainErr.Fields))
+	for i, field := range domainErr.Fields {
+		messages[i] = field.String()
+	}
+	return messages
+}
+
+// draftLeagueRequest reads a draft's form data as a create request
+// Values of the wrong type are reported against their field like any other validation failure
+func draftLeagueRequest(formData FormData) (*CreateLeagueRequest, error) {
+	encoded, err := json.Marshal(formData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read draft: %w", err)
+	}
+	var request CreateLeagueRequest
+	if err := json.Unmarshal(encoded, &request); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			return nil, shared.Validation(shared.FieldError{Field: typeErr.Field, Message: "must be " + jsonTypeName(typeErr.Type)})
+		}
+		return nil, fmt.Errorf("failed to read draft: %w", err)
+	}
+	return &request, nil
+}
+
+// jsonTypeName describes the JSON value a Go type expects
+func jsonTypeName(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "text"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Slice, reflect.Array:
+		return "a list"
+	case reflect.Map, reflect.Struct:
+		return "an object"
+	default:
+		return "a " + t.String()
+	}
+}
+
+// requestValidator runs the struct tag validation of requests, reporting fields by JSON name
+var requestValidator = shared.NewValidator()
+
+// leagueRequestValidation collects the field errors of one request
+type leagueRequestValidation struct {
+	fields []shared.FieldError
+}
+
+func (v *leagueRequestValidation) add(field string, format string, args ...interface{}) {
+	v.fields = append(v.fields, shared.FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// has reports whether a field already failed, so later checks don't pile onto a missing or malformed value
+func (v *leagueRequestValidation) has(field string) bool {
+	for _, existing := range v.fields {
+		if existing.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateLeagueRequest checks a create request beyond its struct tags: dates must parse and be in order
+// (registration deadline before the season starts, season end after the start), each game occurrence needs
+// a real weekday and HH:MM times ending after they start, and occurrences may not overlap each other
+// Returns nil or a shared Validation error listing every failing field
+func ValidateLeagueRequest(request *CreateLeagueRequest) error {
+	v := &leagueRequestValidation{}
+	v.checkTags(request)
+
+	deadline := v.date("registration_deadline", request.RegistrationDeadline)
+	start := v.date("season_start_date", request.SeasonStartDate)
+	end := v.date("season_end_date", request.SeasonEndDate)
+	if deadline != nil && start != nil && !deadline.Before(*start) {
+		v.add("registration_deadline", "must be before season_start_date")
+	}
+	if start != nil && end != nil && !end.After(*start) {
+		v.add("season_end_date", "must be after season_start_date")
+	}
+
+	if request.PricingStrategy != "" && !request.PricingStrategy.IsValid() {
+		v.add("pricing_strategy", "must be %s or %s", PricingStrategyPerTeam, PricingStrategyPerPerson)
+	}
+	if request.PricingAmount != nil && *request.PricingAmount < 0 {
+		v.add("pricing_amount", "cannot be negative")
+	}
+	if request.PerGameFee != nil && *request.PerGameFee < 0 {
+		v.add("per_game_fee", "cannot be negative")
+	}
+	if request.Duration != nil && (*request.Duration < 1 || *request.Duration > schedule.MaxWeeks) {
+		v.add("duration", "must be between 1 and %d weeks", schedule.MaxWeeks)
+	}
+	if request.MinimumTeamPlayers != nil && *request.MinimumTeamPlayers < 1 {
+		v.add("minimum_team_players", "must be at least 1")
+	}
+	if request.VenueID == nil && request.VenueAddress != nil && request.VenueName == nil {
+		v.add("venue_name", "is required for a new venue")
+	}
+
+	v.checkGameOccurrences(request.GameOccurrences)
+
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return shared.Validation(v.fields...)
+}
+
+// checkTags runs the struct tag validation, one error per failing field
+func (v *leagueRequestValidation) checkTags(request *CreateLeagueRequest) {
+	if err := requestValidator.Struct(request); err != nil {
+		v.fields = append(v.fields, shared.FieldErrorsOf(err)...)
+	}
+}
+
+// date parses an optional YYYY-MM-DD field; nil if it is missing or invalid
+func (v *leagueRequestValidation) date(field string, value *string) *time.Time {
+	if value == nil || v.has(field) {
+		return nil
+	}
+	parsed, err := time.Parse("2006-01-02", *value)
+	if err != nil {
+		v.add(field, "must be a date in YYYY-MM-DD format")
+		return nil
+	}
+	return &parsed
+}
+
+// checkGameOccurrences validates each weekly game slot and that no two of them overlap
+func (v *leagueRequestValidation) checkGameOccurrences(occurrences GameOccurrences) {
+	if len(occurrences) == 0 {
+		if !v.has("game_occurrences") {
+			v.add("game_occurrences", "must have at least one game")
+		}
+		return
+	}
+
+	slots := make([]*schedule.Slot, len(occurrences))
+	for i, occurrence := range occurrences {
+		prefix := fmt.Sprintf("game_occurrences[%d]", i)
+
+		day, ok := schedule.ParseWeekday(occurrence.Day)
+		if !ok {
+			v.add(prefix+".day", "must be a day of the week")
+		}
+		start, startErr := schedule.ParseClock(occurrence.StartTime)
+		if startErr != nil {
+			v.add(prefix+".start_time", "must be a time in HH:MM format")
+		}
+		end, endErr := schedule.ParseClock(occurrence.EndTime)
+		if endErr != nil {
+			v.add(prefix+".end_time", "must be a time in HH:MM format")
+		}
+		if startErr != nil || endErr != nil {
+			continue
+		}
+		if end.Hour*60+end.Minute <= start.Hour*60+start.Minute {
+			v.add(prefix+".end_time", "must be after start_time")
+			continue
+		}
+		if ok {
+			slots[i] = &schedule.Slot{Day: day, Start: start, End: end}
+		}
+	}
+
+	for i, slot := range slots {
+		if slot == nil {
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if slots[j] != nil && slotsOverlap(*slot, *slots[j]) {
+				v.add(fmt.Sprintf("game_occurrences[%d]", i), "overlaps game_occurrences[%d]", j)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package leagues
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+func validTestRequest() *CreateLeagueRequest {
+	amount := 120.0
+	duration := 10
+	players := 8
+	return &CreateLeagueRequest{
+		SportName:            "Kickball",
+		Division:             stringPtr("Rec"),
+		RegistrationDeadline: stringPtr("2025-03-01"),
+		SeasonStartDate:      stringPtr("2025-03-15"),
+		SeasonEndDate:        stringPtr("2025-05-31"),
+		GameOccurrences: GameOccurrences{
+			{Day: "Tuesday", StartTime: "18:00", EndTime: "20:00"},
+			{Day: "Tuesday", StartTime: "20:00", EndTime: "22:00"},
+		},
+		PricingStrategy:    PricingStrategyPerTeam,
+		PricingAmount:      &amount,
+		Gender:             stringPtr("Coed"),
+		RegistrationURL:    stringPtr("https://example.com/register"),
+		Duration:           &duration,
+		MinimumTeamPlayers: &players,
+	}
+}
+
+func validationFields(t *testing.T, err error) []shared.FieldError {
+	t.Helper()
+	if !errors.Is(err, shared.ErrValidation) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	domainErr, _ := shared.AsError(err)
+	return domainErr.Fields
+}
+
+func TestValidateLeagueRequest(t *testing.T) {
+	if err := ValidateLeagueRequest(validTestRequest()); err != nil {
+		t.Fatalf("expected a valid request to pass, got %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*CreateLeagueRequest)
+		want   []shared.FieldError
+	}{
+		{
+			"deadline after the season starts",
+			func(r *CreateLeagueRequest) { r.RegistrationDeadline = stringPtr("2025-03-15") },
+			[]shared.FieldError{{Field: "registration_deadline", Message: "must be before season_start_date"}},
+		},
+		{
+			"season ending before it starts",
+			func(r *CreateLeagueRequest) { r.SeasonEndDate = stringPtr("2025-03-01") },
+			[]shared.FieldError{{Field: "season_end_date", Message: "must be after season_start_date"}},
+		},
+		{
+			"malformed date",
+			func(r *CreateLeagueRequest) { r.SeasonStartDate = stringPtr("03/15/2025") },
+			[]shared.FieldError{{Field: "season_start_date", Message: "must be a date in YYYY-MM-DD format"}},
+		},
+		{
+			"unknown weekday and bad times",
+			func(r *CreateLeagueRequest) {
+				r.GameOccurrences[0].Day = "Funday"
+				r.GameOccurrences[1].StartTime = "8pm"
+			},
+			[]shared.FieldError{
+				{Field: "game_occurrences[0].day", Message: "must be a day of the week"},
+				{Field: "game_occurrences[1].start_time", Message: "must be a time in HH:MM format"},
+			},
+		},
+		{
+			"game ending before it starts",
+			func(r *CreateLeagueRequest) { r.GameOccurrences[1].EndTime = "19:30" },
+			[]shared.FieldError{{Field: "game_occurrences[1].end_time", Message: "must be after start_time"}},
+		},
+		{
+			"overlapping games",
+			func(r *CreateLeagueRequest) {
+				r.GameOccurrences = append(r.GameOccurrences, GameOccurrence{Day: "tuesday", StartTime: "19:00", EndTime: "21:00"})
+			},
+			[]shared.FieldError{{Field: "game_occurrences[2]", Message: "overlaps game_occurrences[0]"}},
+		},
+		{
+			"no games",
+			func(r *CreateLeagueRequest) { r.GameOccurrences = GameOccurrences{} },
+			[]shared.FieldError{{Field: "game_occurrences", Message: "must have at least one game"}},
+		},
+		{
+			"new venue without a name",
+			func(r *CreateLeagueRequest) { r.VenueAddress = stringPtr("123 Main St, Seattle") },
+			[]shared.FieldError{{Field: "venue_name", Message: "is required for a new venue"}},
+		},
+		{
+			"invalid pricing and counts",
+			func(r *CreateLeagueRequest) {
+				r.PricingStrategy = "per_game"
+				zero := 0
+				r.Duration = &zero
+			},
+			[]shared.FieldError{
+				{Field: "pricing_strategy", Message: "must be per_team or per_person"},
+				{Field: "duration", Message: "must be between 1 and 104 weeks"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := validTestRequest()
+			tt.modify(request)
+			got := validationFields(t, ValidateLeagueRequest(request))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("fields = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDraftLeagueRequest(t *testing.T) {
+	request, err := draftLeagueRequest(FormData{
+		"sport_name":       "Kickball",
+		"season_end_date":  "2025-05-31",
+		"duration":         10,
+		"game_occurrences": []interface{}{map[string]interface{}{"day": "Tuesday", "startTime": "18:00", "endTime": "20:00"}},
+	})
+	if err != nil {
+		t.Fatalf("draftLeagueRequest: %v", err)
+	}
+	if request.SportName != "Kickball" || *request.Duration != 10 || len(request.GameOccurrences) != 1 || request.GameOccurrences[0].EndTime != "20:00" {
+		t.Errorf("form data not read: %+v", request)
+	}
+
+	_, err = draftLeagueRequest(FormData{"duration": "ten weeks"})
+	fields := validationFields(t, err)
+	if len(fields) != 1 || fields[0].Field != "duration" || fields[0].Message != "must be a number" {
+		t.Errorf("unexpected fields %+v", fields)
+	}
+}
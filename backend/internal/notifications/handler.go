@@ -9,6 +9,8 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	"github.com/leaguefindr/backend/internal/auth"
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/shared"
 )
 
 // Handler handles notification HTTP requests
@@ -21,7 +23,7 @@ type Handler struct {
 func NewHandler(service *Service) *Handler {
 	return &Handler{
 		service:   service,
-		validator: validator.New(),
+		validator: shared.NewValidator(),
 	}
 }
 
@@ -36,48 +38,37 @@ func (h *Handler) RegisterRoutes(r chi.Router) {
 }
 
 // GetNotifications retrieves notifications for the authenticated user
-// Query parameters: limit (default 20), offset (default 0)
+// Query parameters: limit (default 20), cursor or offset (default 0), sort (default -created_at)
 func (h *Handler) GetNotifications(w http.ResponseWriter, r *http.Request) {
 	authenticatedUserID := r.Header.Get("X-Clerk-User-ID")
 	if authenticatedUserID == "" {
-		http.Error(w, "User ID not found in token", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "User ID not found in token")
 		return
 	}
 
-	// Parse query parameters
-	limitStr := r.URL.Query().Get("limit")
-	offsetStr := r.URL.Query().Get("offset")
-
-	limit := 20
-	offset := 0
-
-	if limitStr != "" {
-		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 100 {
-			limit = parsedLimit
-		}
-	}
-
-	if offsetStr != "" {
-		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
-			offset = parsedOffset
-		}
+	page, err := pagination.Parse(r.URL.Query(), notificationsPaging)
+	if err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, err.Error())
+		return
 	}
 
 	ctx := r.Context()
-	notifications, count, err := h.service.GetNotifications(ctx, authenticatedUserID, limit, offset)
+	notifications, count, nextCursor, err := h.service.GetNotifications(ctx, authenticatedUserID, page)
 	if err != nil {
 		slog.Error("failed to get notifications", "userID", authenticatedUserID, "err", err)
-		http.Error(w, "Failed to retrieve notifications", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to retrieve notifications")
 		return
 	}
 
+	pagination.SetLinkHeader(w, r, nextCursor)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"notifications": notifications,
 		"count":         count,
-		"limit":         limit,
-		"offset":        offset,
+		"limit":         page.Limit,
+		"offset":        page.Offset,
+		"next_cursor":   nextCursor,
 	})
 }
 
@@ -85,19 +76,19 @@ func (h *Handler) GetNotifications(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) MarkAsRead(w http.ResponseWriter, r *http.Request) {
 	authenticatedUserID := r.Header.Get("X-Clerk-User-ID")
 	if authenticatedUserID == "" {
-		http.Error(w, "User ID not found in token", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "User ID not found in token")
 		return
 	}
 
 	notificationIDStr := chi.URLParam(r, "notificationID")
 	if notificationIDStr == "" {
-		http.Error(w, "notificationID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "notificationID is required")
 		return
 	}
 
 	notificationID, err := strconv.Atoi(notificationIDStr)
 	if err != nil {
-		http.Error(w, "Invalid notification ID", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid notification ID")
 		return
 	}
 
@@ -105,7 +96,7 @@ func (h *Handler) MarkAsRead(w http.ResponseWriter, r *http.Request) {
 	err = h.service.MarkAsRead(ctx, notificationID, authenticatedUserID)
 	if err != nil {
 		slog.Error("failed to mark notification as read", "notificationID", notificationID, "userID", authenticatedUserID, "err", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to mark notification as read")
 		return
 	}
 
@@ -0,0 +1,116 @@
+package notifications
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// AdminSource lists the admin users that admin notifications go to
+type AdminSource interface {
+	GetAdminIDs(ctx context.Context) ([]string, error)
+}
+
+// MemoryRepository is a thread-safe in-memory RepositoryInterface used in tests and local development
+type MemoryRepository struct {
+	mu            sync.RWMutex
+	notifications []NotificationPayload
+	preferences   map[string]map[string]bool // user ID -> preference column -> enabled
+	admins        AdminSource
+	nextID        int
+}
+
+// NewMemoryRepository creates an empty in-memory repository
+// admins is usually the auth repository; a nil source means there are no admins to notify
+func NewMemoryRepository(admins AdminSource) *MemoryRepository {
+	return &MemoryRepository{
+		preferences: make(map[string]map[string]bool),
+		admins:      admins,
+		nextID:      1,
+	}
+}
+
+// SetPreference enables or disables a preference column for a user
+func (m *MemoryRepository) SetPreference(userID string, preferenceColumn string, enabled bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.preferences[userID] == nil {
+		m.preferences[userID] = make(map[string]bool)
+	}
+	m.preferences[userID][preferenceColumn] = enabled
+}
+
+// CreateNotification stores a notification and fills in its ID and timestamps
+func (m *MemoryRepository) CreateNotification(ctx context.Context, notification *NotificationPayload) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now().UTC()
+	notification.ID = m.nextID
+	notification.CreatedAt = now
+	notification.UpdatedAt = now
+	m.nextID++
+	m.notifications = append(m.notifications, *notification)
+	return nil
+}
+
+// GetPreference returns the user's setting for a preference column, or nil when the user has none
+func (m *MemoryRepository) GetPreference(ctx context.Context, userID string, preferenceColumn string) (*bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	enabled, ok := m.preferences[userID][preferenceColumn]
+	if !ok {
+		return nil, nil
+	}
+	return &enabled, nil
+}
+
+// GetByUserID retrieves a page of a user's notifications and their total count
+func (m *MemoryRepository) GetByUserID(ctx context.Context, userID string, page pagination.Params) ([]NotificationPayload, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var owned []NotificationPayload
+	for _, notification := range m.notifications {
+		if notification.UserID == userID {
+			owned = append(owned, notification)
+		}
+	}
+	window := pagination.Window(owned, page,
+		func(n NotificationPayload) *string {
+			key := n.CreatedAt.Format(time.RFC3339Nano)
+			return &key
+		},
+		func(n NotificationPayload) string { return strconv.Itoa(n.ID) },
+	)
+	return window, int64(len(owned)), nil
+}
+
+// MarkAsRead marks one of the user's notifications as read
+func (m *MemoryRepository) MarkAsRead(ctx context.Context, notificationID int, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, notification := range m.notifications {
+		if notification.ID == notificationID && notification.UserID == userID {
+			m.notifications[i].Read = true
+			m.notifications[i].UpdatedAt = time.Now().UTC()
+			return nil
+		}
+	}
+	return shared.NotFound("notification not found or unauthorized")
+}
+
+// GetAdminIDs returns the IDs of every admin user known to the admin source
+func (m *MemoryRepository) GetAdminIDs(ctx context.Context) ([]string, error) {
+	if m.admins == nil {
+		return nil, nil
+	}
+	return m.admins.GetAdminIDs(ctx)
+}
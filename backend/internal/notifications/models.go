@@ -1,14 +1,23 @@
 package notifications
 
+import "github.com/leaguefindr/backend/internal/pagination"
+
 // NotificationType represents the types of notifications
 type NotificationType string
 
 const (
-	NotificationLeagueApproved  NotificationType = "league_approved"
-	NotificationLeagueRejected  NotificationType = "league_rejected"
-	NotificationLeagueSubmitted NotificationType = "league_submitted"
-	NotificationDraftSaved      NotificationType = "draft_saved"
-	NotificationTemplateSaved   NotificationType = "template_saved"
+	NotificationLeagueApproved         NotificationType = "league_approved"
+	NotificationLeagueRejected         NotificationType = "league_rejected"
+	NotificationLeagueSubmitted        NotificationType = "league_submitted"
+	NotificationLeagueUpdateSubmitted  NotificationType = "league_update_submitted"
+	NotificationLeagueUpdateApproved   NotificationType = "league_update_approved"
+	NotificationLeagueUpdateRejected   NotificationType = "league_update_rejected"
+	NotificationLeagueChangesRequested NotificationType = "league_changes_requested"
+	NotificationLeagueComment          NotificationType = "league_comment"
+	NotificationLeagueReviewOverdue    NotificationType = "league_review_overdue"
+	NotificationLeagueFlagged          NotificationType = "league_flagged"
+	NotificationDraftSaved             NotificationType = "draft_saved"
+	NotificationTemplateSaved          NotificationType = "template_saved"
 )
 
 // String returns the string representation of the notification type
@@ -21,6 +30,14 @@ type MarkNotificationAsReadRequest struct {
 	NotificationID int `json:"notificationID" validate:"required"`
 }
 
+// notificationsPaging configures the notification list: newest first
+var notificationsPaging = pagination.Config{
+	DefaultLimit: 20,
+	MaxLimit:     100,
+	Sorts:        []pagination.SortField{{Name: "created_at", Column: "created_at"}},
+	DefaultSort:  "-created_at",
+}
+
 // GetNotificationsRequest represents a request to get notifications with pagination
 type GetNotificationsRequest struct {
 	Limit  int `query:"limit" validate:"omitempty,min=1,max=100" default:"20"`
@@ -0,0 +1,175 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/shared"
+	"github.com/supabase-community/postgrest-go"
+)
+
+// RepositoryInterface defines the contract for notification storage
+// Repository stores notifications in Supabase; MemoryRepository keeps them in memory
+type RepositoryInterface interface {
+	CreateNotification(ctx context.Context, notification *NotificationPayload) error
+	GetPreference(ctx context.Context, userID string, preferenceColumn string) (*bool, error)
+	GetByUserID(ctx context.Context, userID string, page pagination.Params) ([]NotificationPayload, int64, error)
+	MarkAsRead(ctx context.Context, notificationID int, userID string) error
+	GetAdminIDs(ctx context.Context) ([]string, error)
+}
+
+type Repository struct {
+	client *postgrest.Client
+}
+
+func NewRepository(client *postgrest.Client) *Repository {
+	return &Repository{client: client}
+}
+
+// CreateNotification inserts a notification and fills in its ID and timestamps
+func (r *Repository) CreateNotification(ctx context.Context, notification *NotificationPayload) error {
+	insertData := map[string]interface{}{
+		"user_id":           notification.UserID,
+		"type":              notification.Type,
+		"title":             notification.Title,
+		"message":           notification.Message,
+		"related_league_id": notification.RelatedLeagueID,
+		"related_org_id":    notification.RelatedOrgID,
+	}
+
+	var results []map[string]interface{}
+	_, err := r.client.From("notifications").
+		Insert(insertData, true, "", "", "").
+		ExecuteToWithContext(ctx, &results)
+
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	if len(results) == 0 {
+		return fmt.Errorf("failed to create notification: no result returned")
+	}
+
+	if id, ok := results[0]["id"].(float64); ok {
+		notification.ID = int(id)
+	}
+	if created, ok := results[0]["created_at"].(string); ok {
+		notification.CreatedAt = parseTimestamp(created)
+	}
+	if updated, ok := results[0]["updated_at"].(string); ok {
+		notification.UpdatedAt = parseTimestamp(updated)
+	}
+
+	return nil
+}
+
+// GetPreference returns the user's setting for a preference column, or nil when the user has none
+func (r *Repository) GetPreference(ctx context.Context, userID string, preferenceColumn string) (*bool, error) {
+	var prefs []map[string]interface{}
+	_, err := r.client.From("notification_preferences").
+		Select(preferenceColumn, "", false).
+		Eq("user_id", userID).
+		ExecuteToWithContext(ctx, &prefs)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch notification preference: %w", err)
+	}
+
+	if len(prefs) == 0 {
+		return nil, nil
+	}
+
+	if val, ok := prefs[0][preferenceColumn].(bool); ok {
+		return &val, nil
+	}
+
+	return nil, nil
+}
+
+// GetByUserID retrieves a page of a user's notifications and their total count
+// The page holds up to page.Limit+1 rows so the caller can tell whether more follow
+func (r *Repository) GetByUserID(ctx context.Context, userID string, page pagination.Params) ([]NotificationPayload, int64, error) {
+	var notifications []NotificationPayload
+
+	count, err := page.Apply(r.client.From("notifications").
+		Select("*", "exact", false).
+		Eq("user_id", userID)).
+		ExecuteToWithContext(ctx, &notifications)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query notifications: %w", err)
+	}
+
+	// The cursor filter hides earlier rows, so count them separately
+	if page.Cursor != nil {
+		_, count, err = r.client.From("notifications").
+			Select("id", "exact", true).
+			Eq("user_id", userID).
+			ExecuteWithContext(ctx)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to count notifications: %w", err)
+		}
+	}
+
+	return notifications, count, nil
+}
+
+// MarkAsRead marks one of the user's notifications as read
+func (r *Repository) MarkAsRead(ctx context.Context, notificationID int, userID string) error {
+	updateData := map[string]interface{}{
+		"read": true,
+	}
+
+	var result []map[string]interface{}
+	_, err := r.client.From("notifications").
+		Update(updateData, "", "").
+		Eq("id", strconv.Itoa(notificationID)).
+		Eq("user_id", userID).
+		ExecuteToWithContext(ctx, &result)
+
+	if err != nil {
+		return fmt.Errorf("failed to update notification: %w", err)
+	}
+
+	if len(result) == 0 {
+		return shared.NotFound("notification not found or unauthorized")
+	}
+
+	return nil
+}
+
+// GetAdminIDs returns the IDs of every admin user
+func (r *Repository) GetAdminIDs(ctx context.Context) ([]string, error) {
+	var adminUsers []map[string]interface{}
+	_, err := r.client.From("users").
+		Select("id", "", false).
+		Eq("role", "admin").
+		ExecuteToWithContext(ctx, &adminUsers)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch admin users: %w", err)
+	}
+
+	var ids []string
+	for _, adminUser := range adminUsers {
+		if adminID, ok := adminUser["id"].(string); ok {
+			ids = append(ids, adminID)
+		}
+	}
+
+	return ids, nil
+}
+
+// parseTimestamp parses a PostgREST timestamp, with or without a zone
+func parseTimestamp(value string) time.Time {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t
+	}
+	if t, err := time.Parse("2006-01-02T15:04:05", value); err == nil {
+		return t
+	}
+	return time.Time{}
+}
@@ -8,8 +8,10 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
+	"github.com/leaguefindr/backend/internal/pagination"
 	"github.com/supabase-community/postgrest-go"
 )
 
@@ -20,6 +22,8 @@ type Service struct {
 	supabaseBroadcastURL string
 	supabaseAPIKey       string
 	httpClient           *http.Client
+	repo                 RepositoryInterface // Reads and updates with the caller's client
+	serviceRepo          RepositoryInterface // Inserts and admin lookups with the service client
 }
 
 // NotificationPayload represents the notification message sent via Realtime
@@ -65,6 +69,18 @@ func NewService(postgrestClient *postgrest.Client, postgrestServiceClient *postg
 		supabaseBroadcastURL:   broadcastURL,
 		supabaseAPIKey:         apiKey,
 		httpClient:             &http.Client{Timeout: 5 * time.Second},
+		repo:                   NewRepository(postgrestClient),
+		serviceRepo:            NewRepository(postgrestServiceClient),
+	}
+}
+
+// NewServiceWithRepository creates a service that keeps its notifications in repo instead of Supabase
+// Realtime broadcasts are disabled
+func NewServiceWithRepository(repo RepositoryInterface) *Service {
+	return &Service{
+		httpClient:  &http.Client{Timeout: 5 * time.Second},
+		repo:        repo,
+		serviceRepo: repo,
 	}
 }
 
@@ -85,55 +101,7 @@ func (s *Service) CreateNotification(ctx context.Context, userID string, notific
 	}
 
 	// Create notification in database
-	insertData := map[string]interface{}{
-		"user_id":            userID,
-		"type":               notificationType,
-		"title":              title,
-		"message":            message,
-		"related_league_id":  relatedLeagueID,
-		"related_org_id":     relatedOrgID,
-	}
-
-	var results []map[string]interface{}
-	_, err = s.postgrestServiceClient.From("notifications").
-		Insert(insertData, true, "", "", "").
-		ExecuteToWithContext(ctx, &results)
-
-	if err != nil {
-		slog.Error("failed to create notification in database", "userID", userID, "type", notificationType, "err", err)
-		return fmt.Errorf("failed to create notification: %w", err)
-	}
-
-	if len(results) == 0 {
-		return fmt.Errorf("failed to create notification: no result returned")
-	}
-
-	var notificationID int
-	var createdAt, updatedAt time.Time
-
-	if id, ok := results[0]["id"].(float64); ok {
-		notificationID = int(id)
-	}
-	if created, ok := results[0]["created_at"].(string); ok {
-		// Parse timestamp - try multiple formats
-		if t, err := time.Parse(time.RFC3339Nano, created); err == nil {
-			createdAt = t
-		} else if t, err := time.Parse("2006-01-02T15:04:05", created); err == nil {
-			createdAt = t
-		}
-	}
-	if updated, ok := results[0]["updated_at"].(string); ok {
-		// Parse timestamp - try multiple formats
-		if t, err := time.Parse(time.RFC3339Nano, updated); err == nil {
-			updatedAt = t
-		} else if t, err := time.Parse("2006-01-02T15:04:05", updated); err == nil {
-			updatedAt = t
-		}
-	}
-
-	// Create payload for broadcasting
 	payload := NotificationPayload{
-		ID:              notificationID,
 		UserID:          userID,
 		Type:            notificationType,
 		Title:           title,
@@ -141,14 +109,18 @@ func (s *Service) CreateNotification(ctx context.Context, userID string, notific
 		Read:            false,
 		RelatedLeagueID: relatedLeagueID,
 		RelatedOrgID:    relatedOrgID,
-		CreatedAt:       createdAt,
-		UpdatedAt:       updatedAt,
+	}
+
+	err = s.serviceRepo.CreateNotification(ctx, &payload)
+	if err != nil {
+		slog.Error("failed to create notification in database", "userID", userID, "type", notificationType, "err", err)
+		return err
 	}
 
 	// Broadcast to user's notification channel
 	err = s.BroadcastNotification(ctx, userID, payload)
 	if err != nil {
-		slog.Error("failed to broadcast notification", "userID", userID, "notificationID", notificationID, "err", err)
+		slog.Error("failed to broadcast notification", "userID", userID, "notificationID", payload.ID, "err", err)
 		// Don't return error - notification was saved to DB, broadcast failure isn't fatal
 	}
 
@@ -284,12 +256,13 @@ func (s *Service) BroadcastToAdmins(ctx context.Context, payload NotificationPay
 func (s *Service) CheckNotificationPreference(ctx context.Context, userID string, notificationType string) (bool, error) {
 	// Map notification types to preference columns
 	var preferenceColumn string
+	// League update notifications share the preference of the matching submission notification
 	switch notificationType {
-	case "league_approved":
+	case "league_approved", "league_update_approved":
 		preferenceColumn = "league_approved"
-	case "league_rejected":
+	case "league_rejected", "league_update_rejected", "league_changes_requested":
 		preferenceColumn = "league_rejected"
-	case "league_submitted":
+	case "league_submitted", "league_update_submitted":
 		preferenceColumn = "league_submitted"
 	case "draft_saved":
 		preferenceColumn = "draft_saved"
@@ -299,98 +272,63 @@ func (s *Service) CheckNotificationPreference(ctx context.Context, userID string
 		return true, nil // Default to true if unknown type
 	}
 
-	var prefs []map[string]interface{}
-	_, err := s.postgrestClient.From("notification_preferences").
-		Select(preferenceColumn, "", false).
-		Eq("user_id", userID).
-		ExecuteToWithContext(ctx, &prefs)
-
-	if err != nil || len(prefs) == 0 {
+	enabled, err := s.repo.GetPreference(ctx, userID, preferenceColumn)
+	if err != nil || enabled == nil {
 		slog.Warn("failed to fetch notification preference", "userID", userID, "type", notificationType, "err", err)
 		return true, nil // Default to true if preference doesn't exist
 	}
 
-	// Extract the preference value
-	if val, ok := prefs[0][preferenceColumn].(bool); ok {
-		return val, nil
-	}
-
-	return true, nil // Default to true if can't parse
+	return *enabled, nil
 }
 
-// GetNotifications retrieves notifications for a user (with optional pagination)
-func (s *Service) GetNotifications(ctx context.Context, userID string, limit int, offset int) ([]NotificationPayload, int64, error) {
-	var notifications []NotificationPayload
-
-	count, err := s.postgrestClient.From("notifications").
-		Select("*", "exact", false).
-		Eq("user_id", userID).
-		Order("created_at", &postgrest.OrderOpts{Ascending: false}).
-		Range(offset, offset+limit-1, "").
-		ExecuteToWithContext(ctx, &notifications)
-
+// GetNotifications retrieves a page of notifications for a user
+// Returns the notifications, the total count and the cursor of the next page ("" on the last page)
+func (s *Service) GetNotifications(ctx context.Context, userID string, page pagination.Params) ([]NotificationPayload, int64, string, error) {
+	notifications, count, err := s.repo.GetByUserID(ctx, userID, page)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to query notifications: %w", err)
+		return nil, 0, "", err
 	}
 
 	if notifications == nil {
 		notifications = []NotificationPayload{}
 	}
 
-	return notifications, int64(count), nil
+	notifications, hasMore := pagination.Trim(notifications, page.Limit)
+	nextCursor := ""
+	if hasMore {
+		last := notifications[len(notifications)-1]
+		key := last.CreatedAt.Format(time.RFC3339Nano)
+		nextCursor = page.NextCursor(&key, strconv.Itoa(last.ID))
+	}
+
+	return notifications, count, nextCursor, nil
 }
 
 // MarkAsRead marks a notification as read
 func (s *Service) MarkAsRead(ctx context.Context, notificationID int, userID string) error {
-	updateData := map[string]interface{}{
-		"read": true,
-	}
-
-	var result []map[string]interface{}
-	_, err := s.postgrestClient.From("notifications").
-		Update(updateData, "", "").
-		Eq("id", fmt.Sprintf("%d", notificationID)).
-		Eq("user_id", userID).
-		ExecuteToWithContext(ctx, &result)
-
-	if err != nil {
-		return fmt.Errorf("failed to update notification: %w", err)
-	}
-
-	if len(result) == 0 {
-		return fmt.Errorf("notification not found or unauthorized")
-	}
-
-	return nil
+	return s.repo.MarkAsRead(ctx, notificationID, userID)
 }
 
 // CreateNotificationForAllAdmins sends a notification to all admins
 func (s *Service) CreateNotificationForAllAdmins(ctx context.Context, notificationType string, title string, message string, relatedLeagueID *int, relatedOrgID *string) error {
 	// Fetch all admin users
-	var adminUsers []map[string]interface{}
-	_, err := s.postgrestServiceClient.From("users").
-		Select("id", "", false).
-		Eq("role", "admin").
-		ExecuteToWithContext(ctx, &adminUsers)
-
+	adminIDs, err := s.serviceRepo.GetAdminIDs(ctx)
 	if err != nil {
 		slog.Error("failed to fetch admin users", "err", err)
-		return fmt.Errorf("failed to fetch admin users: %w", err)
+		return err
 	}
 
-	if len(adminUsers) == 0 {
+	if len(adminIDs) == 0 {
 		slog.Warn("no admin users found for notification")
 		return nil
 	}
 
 	// Create notification for each admin
-	for _, adminUser := range adminUsers {
-		if adminID, ok := adminUser["id"].(string); ok {
-			err := s.CreateNotification(ctx, adminID, notificationType, title, message, relatedLeagueID, relatedOrgID)
-			if err != nil {
-				slog.Error("failed to create admin notification", "adminID", adminID, "err", err)
-				// Continue with other admins even if one fails
-			}
+	for _, adminID := range adminIDs {
+		err := s.CreateNotification(ctx, adminID, notificationType, title, message, relatedLeagueID, relatedOrgID)
+		if err != nil {
+			slog.Error("failed to create admin notification", "adminID", adminID, "err", err)
+			// Continue with other admins even if one fails
 		}
 	}
 
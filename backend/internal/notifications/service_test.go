@@ -0,0 +1,101 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/leaguefindr/backend/internal/pagination"
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+type staticAdmins []string
+
+func (a staticAdmins) GetAdminIDs(ctx context.Context) ([]string, error) {
+	return a, nil
+}
+
+func TestCreateNotification_RespectsPreference(t *testing.T) {
+	repo := NewMemoryRepository(nil)
+	repo.SetPreference("user_1", "league_approved", false)
+	service := NewServiceWithRepository(repo)
+	ctx := context.Background()
+
+	// league_update_approved shares the league_approved preference
+	if err := service.CreateNotification(ctx, "user_1", "league_update_approved", "Approved", "Your update was approved", nil, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := service.CreateNotification(ctx, "user_1", "league_rejected", "Rejected", "Your league was rejected", nil, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	page, _ := pagination.Parse(url.Values{}, notificationsPaging)
+	notifications, total, _, err := service.GetNotifications(ctx, "user_1", page)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if total != 1 || len(notifications) != 1 || notifications[0].Type != "league_rejected" {
+		t.Errorf("expected only the rejection, got %d %+v", total, notifications)
+	}
+}
+
+func TestCreateNotificationForAllAdmins(t *testing.T) {
+	service := NewServiceWithRepository(NewMemoryRepository(staticAdmins{"admin_1", "admin_2"}))
+	ctx := context.Background()
+
+	if err := service.CreateNotificationForAllAdmins(ctx, "league_submitted", "New league", "A league is waiting for review", nil, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	page, _ := pagination.Parse(url.Values{}, notificationsPaging)
+	for _, adminID := range []string{"admin_1", "admin_2"} {
+		if _, total, _, _ := service.GetNotifications(ctx, adminID, page); total != 1 {
+			t.Errorf("expected %s to get one notification, got %d", adminID, total)
+		}
+	}
+}
+
+func TestGetNotifications_PagesNewestFirst(t *testing.T) {
+	service := NewServiceWithRepository(NewMemoryRepository(nil))
+	ctx := context.Background()
+
+	for _, title := range []string{"first", "second", "third"} {
+		_ = service.CreateNotification(ctx, "user_1", "league_submitted", title, title, nil, nil)
+	}
+
+	page, _ := pagination.Parse(url.Values{"limit": {"2"}}, notificationsPaging)
+	first, total, next, err := service.GetNotifications(ctx, "user_1", page)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if total != 3 || len(first) != 2 || first[0].Title != "third" || first[1].Title != "second" || next == "" {
+		t.Fatalf("unexpected first page %d %+v %q", total, first, next)
+	}
+
+	page, _ = pagination.Parse(url.Values{"limit": {"2"}, "cursor": {next}}, notificationsPaging)
+	second, total, next, _ := service.GetNotifications(ctx, "user_1", page)
+	if total != 3 || len(second) != 1 || second[0].Title != "first" || next != "" {
+		t.Errorf("unexpected second page %d %+v %q", total, second, next)
+	}
+}
+
+func TestMarkAsRead(t *testing.T) {
+	service := NewServiceWithRepository(NewMemoryRepository(nil))
+	ctx := context.Background()
+
+	_ = service.CreateNotification(ctx, "user_1", "league_submitted", "New league", "A league was submitted", nil, nil)
+
+	if err := service.MarkAsRead(ctx, 1, "user_2"); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected another user's notification to be not found, got %v", err)
+	}
+	if err := service.MarkAsRead(ctx, 1, "user_1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	page, _ := pagination.Parse(url.Values{}, notificationsPaging)
+	notifications, _, _, _ := service.GetNotifications(ctx, "user_1", page)
+	if len(notifications) != 1 || !notifications[0].Read {
+		t.Errorf("expected the notification to be read, got %+v", notifications)
+	}
+}
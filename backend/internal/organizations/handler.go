@@ -2,28 +2,38 @@ package organizations
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	"github.com/leaguefindr/backend/internal/auth"
+	"github.com/leaguefindr/backend/internal/idempotency"
+	"github.com/leaguefindr/backend/internal/shared"
 )
 
 type Handler struct {
 	service     *Service
 	authService *auth.Service
 	validator   *validator.Validate
+	idempotency *idempotency.Middleware
 }
 
 func NewHandler(service *Service, authService *auth.Service) *Handler {
 	return &Handler{
 		service:     service,
 		authService: authService,
-		validator:   validator.New(),
+		validator:   shared.NewValidator(),
 	}
 }
 
+// SetIdempotency makes the create route honor the Idempotency-Key header
+func (h *Handler) SetIdempotency(m *idempotency.Middleware) {
+	h.idempotency = m
+}
+
 // RegisterRoutes registers all organization routes
 func (h *Handler) RegisterRoutes(r chi.Router) {
 	r.Route("/organizations", func(r chi.Router) {
@@ -35,10 +45,16 @@ func (h *Handler) RegisterRoutes(r chi.Router) {
 			r.Get("/admin", h.GetAllOrganizations)
 			r.Get("/user", h.GetUserOrganizations)
 			r.Get("/{orgId}", h.GetOrganization)
-			r.Post("/", h.CreateOrganization)
+			r.With(h.idempotency.Handler).Post("/", h.CreateOrganization)
 			r.Post("/join", h.JoinOrganization)
 			r.Put("/{orgId}", h.UpdateOrganization)
 			r.Delete("/{orgId}", h.DeleteOrganization)
+			r.With(auth.RequireAdmin(h.authService)).Put("/{orgId}/verification", h.SetVerification)
+
+			// Holiday calendar, skipped by every league schedule of the organization
+			r.Get("/{orgId}/holidays", h.GetHolidays)
+			r.Post("/{orgId}/holidays", h.SaveHolidays)
+			r.Delete("/{orgId}/holidays/{holidayId}", h.DeleteHoliday)
 		})
 	})
 }
@@ -47,18 +63,18 @@ func (h *Handler) RegisterRoutes(r chi.Router) {
 func (h *Handler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
 	userID := r.Header.Get("X-Clerk-User-ID")
 	if userID == "" {
-		http.Error(w, "Missing user ID", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
 		return
 	}
 
 	var req CreateOrganizationRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
-	if err := h.validator.Struct(req); err != nil {
-		http.Error(w, "Validation failed: organization name and website URL are required", http.StatusBadRequest)
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		shared.WriteError(w, r, err, "Validation failed")
 		return
 	}
 
@@ -87,13 +103,13 @@ func (h *Handler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
 	)
 	if err != nil {
 		// Check if it's a duplicate URL error
-		if err.Error() == "organization with this URL already exists" {
+		if errors.Is(err, shared.ErrConflict) {
 			slog.Warn("Duplicate organization URL attempt", "error", err, "userId", userID, "url", req.OrgURL)
-			http.Error(w, "An organization with this website URL already exists. Please contact info@leaguefindr.com if you need assistance", http.StatusConflict)
+			shared.WriteProblem(w, r, http.StatusConflict, "An organization with this website URL already exists. Please contact info@leaguefindr.com if you need assistance")
 			return
 		}
 		slog.Error("Failed to create organization", "error", err, "userId", userID)
-		http.Error(w, "Failed to create organization", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to create organization")
 		return
 	}
 
@@ -109,14 +125,14 @@ func (h *Handler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) GetUserOrganizations(w http.ResponseWriter, r *http.Request) {
 	userID := r.Header.Get("X-Clerk-User-ID")
 	if userID == "" {
-		http.Error(w, "Missing user ID", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
 		return
 	}
 
 	orgs, err := h.service.GetUserOrganizations(r.Context(), userID)
 	if err != nil {
 		slog.Error("Failed to get user organizations", "error", err, "userId", userID)
-		http.Error(w, "Failed to get organizations", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to get organizations")
 		return
 	}
 
@@ -129,27 +145,27 @@ func (h *Handler) GetUserOrganizations(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) GetOrganization(w http.ResponseWriter, r *http.Request) {
 	userID := r.Header.Get("X-Clerk-User-ID")
 	if userID == "" {
-		http.Error(w, "Missing user ID", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
 		return
 	}
 
 	orgID := chi.URLParam(r, "orgId")
 	if orgID == "" {
-		http.Error(w, "Missing organization ID", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Missing organization ID")
 		return
 	}
 
 	// Verify user has access to this organization
 	if err := h.service.VerifyUserOrgAccess(r.Context(), userID, orgID); err != nil {
 		slog.Warn("User attempted unauthorized org access", "userId", userID, "orgId", orgID)
-		http.Error(w, "Forbidden", http.StatusForbidden)
+		shared.WriteError(w, r, err, "Failed to verify organization access")
 		return
 	}
 
 	org, err := h.service.GetOrganizationByID(r.Context(), orgID)
 	if err != nil {
 		slog.Error("Failed to get organization", "error", err, "orgId", orgID)
-		http.Error(w, "Organization not found", http.StatusNotFound)
+		shared.WriteError(w, r, err, "Failed to fetch organization")
 		return
 	}
 
@@ -162,24 +178,24 @@ func (h *Handler) GetOrganization(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) JoinOrganization(w http.ResponseWriter, r *http.Request) {
 	userID := r.Header.Get("X-Clerk-User-ID")
 	if userID == "" {
-		http.Error(w, "Missing user ID", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
 		return
 	}
 
 	var req JoinOrganizationRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
-	if err := h.validator.Struct(req); err != nil {
-		http.Error(w, "Validation failed", http.StatusBadRequest)
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		shared.WriteError(w, r, err, "Validation failed")
 		return
 	}
 
 	if err := h.service.JoinOrganization(r.Context(), userID, req.OrgID); err != nil {
 		slog.Error("Failed to join organization", "error", err, "userId", userID, "orgId", req.OrgID)
-		http.Error(w, "Failed to join organization", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to join organization")
 		return
 	}
 
@@ -187,7 +203,7 @@ func (h *Handler) JoinOrganization(w http.ResponseWriter, r *http.Request) {
 	org, err := h.service.GetOrganizationByID(r.Context(), req.OrgID)
 	if err != nil {
 		slog.Error("Failed to get organization after join", "error", err, "orgId", req.OrgID)
-		http.Error(w, "Failed to get organization", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to get organization")
 		return
 	}
 
@@ -204,7 +220,7 @@ func (h *Handler) GetAllOrganizations(w http.ResponseWriter, r *http.Request) {
 	orgs, err := h.service.GetAllOrganizations(r.Context())
 	if err != nil {
 		slog.Error("Failed to get all organizations", "error", err)
-		http.Error(w, "Failed to get organizations", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to get organizations")
 		return
 	}
 
@@ -217,36 +233,36 @@ func (h *Handler) GetAllOrganizations(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) UpdateOrganization(w http.ResponseWriter, r *http.Request) {
 	userID := r.Header.Get("X-Clerk-User-ID")
 	if userID == "" {
-		http.Error(w, "Missing user ID", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
 		return
 	}
 
 	orgID := chi.URLParam(r, "orgId")
 	if orgID == "" {
-		http.Error(w, "Missing organization ID", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Missing organization ID")
 		return
 	}
 
 	var req UpdateOrganizationRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
-	if err := h.validator.Struct(req); err != nil {
-		http.Error(w, "Validation failed", http.StatusBadRequest)
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		shared.WriteError(w, r, err, "Validation failed")
 		return
 	}
 
 	if err := h.service.UpdateOrganization(r.Context(), userID, orgID, req.OrgName, req.OrgURL, req.OrgEmail, req.OrgPhone, req.OrgAddress); err != nil {
 		// Check if it's a duplicate URL error
-		if err.Error() == "organization with this URL already exists" {
+		if errors.Is(err, shared.ErrConflict) {
 			slog.Warn("Duplicate organization URL attempt on update", "error", err, "userId", userID, "orgId", orgID)
-			http.Error(w, "An organization with this website URL already exists. Please contact info@leaguefindr.com if you need assistance", http.StatusConflict)
+			shared.WriteProblem(w, r, http.StatusConflict, "An organization with this website URL already exists. Please contact info@leaguefindr.com if you need assistance")
 			return
 		}
 		slog.Error("Failed to update organization", "error", err, "userId", userID, "orgId", orgID)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to update organization")
 		return
 	}
 
@@ -255,23 +271,149 @@ func (h *Handler) UpdateOrganization(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(map[string]string{"status": "updated"})
 }
 
+// SetVerification marks an organization as verified or not (app admins only)
+func (h *Handler) SetVerification(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+
+	orgID := chi.URLParam(r, "orgId")
+	if orgID == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Missing organization ID")
+		return
+	}
+
+	var req SetVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		shared.WriteError(w, r, err, "Validation failed")
+		return
+	}
+
+	if err := h.service.SetOrganizationVerified(r.Context(), userID, orgID, *req.Verified); err != nil {
+		slog.Error("Failed to set organization verification", "error", err, "userId", userID, "orgId", orgID)
+		shared.WriteError(w, r, err, "Failed to update organization verification")
+		return
+	}
+
+	status := "unverified"
+	if *req.Verified {
+		status = "verified"
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"status": status})
+}
+
 // DeleteOrganization deletes (soft delete) an organization (owner only)
 func (h *Handler) DeleteOrganization(w http.ResponseWriter, r *http.Request) {
 	userID := r.Header.Get("X-Clerk-User-ID")
 	if userID == "" {
-		http.Error(w, "Missing user ID", http.StatusUnauthorized)
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
 		return
 	}
 
 	orgID := chi.URLParam(r, "orgId")
 	if orgID == "" {
-		http.Error(w, "Missing organization ID", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Missing organization ID")
 		return
 	}
 
 	if err := h.service.DeleteOrganization(r.Context(), userID, orgID); err != nil {
 		slog.Error("Failed to delete organization", "error", err, "userId", userID, "orgId", orgID)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to delete organization")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"status": "deleted"})
+}
+
+// GetHolidays returns the organization's holiday calendar
+func (h *Handler) GetHolidays(w http.ResponseWriter, r *http.Request) {
+	orgID := chi.URLParam(r, "orgId")
+	if orgID == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Missing organization ID")
+		return
+	}
+
+	holidays, err := h.service.GetHolidays(r.Context(), orgID)
+	if err != nil {
+		slog.Error("Failed to get holidays", "error", err, "orgId", orgID)
+		shared.WriteError(w, r, err, "Failed to get holidays")
+		return
+	}
+	if holidays == nil {
+		holidays = []Holiday{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{"holidays": holidays})
+}
+
+// SaveHolidays adds holidays to the organization's calendar (org members only)
+func (h *Handler) SaveHolidays(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+
+	orgID := chi.URLParam(r, "orgId")
+	if orgID == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Missing organization ID")
+		return
+	}
+
+	var req SaveHolidaysRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := shared.ValidateStruct(h.validator, req); err != nil {
+		shared.WriteError(w, r, err, "Validation failed")
+		return
+	}
+
+	holidays, err := h.service.SaveHolidays(r.Context(), userID, orgID, req.Holidays)
+	if err != nil {
+		slog.Error("Failed to save holidays", "error", err, "userId", userID, "orgId", orgID)
+		shared.WriteError(w, r, err, "Failed to save holidays")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{"holidays": holidays})
+}
+
+// DeleteHoliday removes a holiday from the organization's calendar (org members only)
+func (h *Handler) DeleteHoliday(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-Clerk-User-ID")
+	if userID == "" {
+		shared.WriteProblem(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+
+	orgID := chi.URLParam(r, "orgId")
+	holidayID, err := strconv.ParseInt(chi.URLParam(r, "holidayId"), 10, 64)
+	if orgID == "" || err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid organization or holiday ID")
+		return
+	}
+
+	if err := h.service.DeleteHoliday(r.Context(), userID, orgID, holidayID); err != nil {
+		slog.Error("Failed to delete holiday", "error", err, "userId", userID, "orgId", orgID, "holidayId", holidayID)
+		shared.WriteError(w, r, err, "Failed to delete holiday")
 		return
 	}
 
@@ -0,0 +1,365 @@
+package organizations
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// MemoryRepository is a thread-safe in-memory RepositoryInterface used in tests and local development
+// Like the organizations table, org_url stays unique across soft-deleted organizations
+type MemoryRepository struct {
+	mu            sync.RWMutex
+	orgs          []Organization
+	members       []UserOrganization
+	holidays      []Holiday
+	nextMemberID  int
+	nextHolidayID int64
+}
+
+// NewMemoryRepository creates an empty in-memory repository
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{nextMemberID: 1, nextHolidayID: 1}
+}
+
+// CreateOrganization stores a new organization and returns its UUID
+func (m *MemoryRepository) CreateOrganization(ctx context.Context, orgName, orgURL, orgEmail, orgPhone, orgAddress, createdBy string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.urlTaken(orgURL, "") {
+		return "", shared.Conflict("organization with this URL already exists")
+	}
+	now := shared.Timestamp{Time: time.Now().UTC()}
+	org := Organization{
+		ID:         uuid.New().String(),
+		OrgName:    orgName,
+		OrgURL:     &orgURL,
+		OrgEmail:   &orgEmail,
+		OrgPhone:   &orgPhone,
+		OrgAddress: &orgAddress,
+		CreatedBy:  &createdBy,
+		IsActive:   true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	m.orgs = append(m.orgs, org)
+	return org.ID, nil
+}
+
+// UserHasAccessToOrg checks if a user has active access to an organization
+func (m *MemoryRepository) UserHasAccessToOrg(ctx context.Context, userID, orgID string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.activeMember(userID, orgID) != nil, nil
+}
+
+// GetUserOrgRole returns the user's role in an organization
+func (m *MemoryRepository) GetUserOrgRole(ctx context.Context, userID, orgID string) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	member := m.activeMember(userID, orgID)
+	if member == nil {
+		return "", shared.Forbidden("user does not have access to this organization")
+	}
+	return member.RoleInOrg, nil
+}
+
+// GetUserOrganizations returns all active organizations a user belongs to
+func (m *MemoryRepository) GetUserOrganizations(ctx context.Context, userID string) ([]Organization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	orgs := []Organization{}
+	for _, org := range m.orgs {
+		if org.IsActive && m.activeMember(userID, org.ID) != nil {
+			orgs = append(orgs, org)
+		}
+	}
+	return orgs, nil
+}
+
+// GetAllOrganizations returns all active organizations
+func (m *MemoryRepository) GetAllOrganizations(ctx context.Context) ([]Organization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	orgs := []Organization{}
+	for _, org := range m.orgs {
+		if org.IsActive {
+			orgs = append(orgs, org)
+		}
+	}
+	return orgs, nil
+}
+
+// LinkUserToOrganization creates a user-organization relationship or reactivates the existing one
+func (m *MemoryRepository) LinkUserToOrganization(ctx context.Context, userID, orgID, roleInOrg string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := shared.Timestamp{Time: time.Now().UTC()}
+	for i, member := range m.members {
+		if member.UserID == userID && member.OrgID == orgID {
+			m.members[i].IsActive = true
+			m.members[i].RoleInOrg = roleInOrg
+			m.members[i].UpdatedAt = now
+			return nil
+		}
+	}
+	m.members = append(m.members, UserOrganization{
+		ID:        m.nextMemberID,
+		UserID:    userID,
+		OrgID:     orgID,
+		RoleInOrg: roleInOrg,
+		IsActive:  true,
+		JoinedAt:  now,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	m.nextMemberID++
+	return nil
+}
+
+// GetOrganizationByID retrieves a single active organization by ID
+func (m *MemoryRepository) GetOrganizationByID(ctx context.Context, orgID string) (*Organization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, org := range m.orgs {
+		if org.ID == orgID && org.IsActive {
+			return &org, nil
+		}
+	}
+	return nil, shared.NotFound("organization not found")
+}
+
+// GetOrganizationByURL retrieves an active organization by its website URL, or nil when there is none
+func (m *MemoryRepository) GetOrganizationByURL(ctx context.Context, orgURL string) (*Organization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, org := range m.orgs {
+		if org.IsActive && org.OrgURL != nil && *org.OrgURL == orgURL {
+			return &org, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetOrganizationMembers returns all active members of an organization
+func (m *MemoryRepository) GetOrganizationMembers(ctx context.Context, orgID string) ([]UserOrganization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	members := []UserOrganization{}
+	for _, member := range m.members {
+		if member.OrgID == orgID && member.IsActive {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+// IsUserOrgAdmin checks if a user is an owner or admin in an organization
+func (m *MemoryRepository) IsUserOrgAdmin(ctx context.Context, userID, orgID string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	member := m.activeMember(userID, orgID)
+	return member != nil && (member.RoleInOrg == "owner" || member.RoleInOrg == "admin"), nil
+}
+
+// RemoveUserFromOrganization deactivates a user from an organization
+func (m *MemoryRepository) RemoveUserFromOrganization(ctx context.Context, userID, orgID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, member := range m.members {
+		if member.UserID == userID && member.OrgID == orgID {
+			m.members[i].IsActive = false
+			m.members[i].UpdatedAt = shared.Timestamp{Time: time.Now().UTC()}
+			return nil
+		}
+	}
+	return shared.NotFound("user is not a member of this organization")
+}
+
+// UpdateOrganization updates organization details
+func (m *MemoryRepository) UpdateOrganization(ctx context.Context, orgID string, orgName, orgURL, orgEmail, orgPhone, orgAddress *string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	i := m.indexOf(orgID)
+	if i < 0 {
+		return shared.NotFound("organization not found")
+	}
+	if orgURL != nil && m.urlTaken(*orgURL, orgID) {
+		return shared.Conflict("organization with this URL already exists")
+	}
+
+	org := &m.orgs[i]
+	if orgName != nil {
+		org.OrgName = *orgName
+	}
+	if orgURL != nil {
+		org.OrgURL = copyString(orgURL)
+	}
+	if orgEmail != nil {
+		org.OrgEmail = copyString(orgEmail)
+	}
+	if orgPhone != nil {
+		org.OrgPhone = copyString(orgPhone)
+	}
+	if orgAddress != nil {
+		org.OrgAddress = copyString(orgAddress)
+	}
+	org.UpdatedAt = shared.Timestamp{Time: time.Now().UTC()}
+	return nil
+}
+
+// DeleteOrganization soft deletes an organization
+func (m *MemoryRepository) DeleteOrganization(ctx context.Context, orgID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	i := m.indexOf(orgID)
+	if i < 0 {
+		return shared.NotFound("organization not found")
+	}
+	now := shared.Timestamp{Time: time.Now().UTC()}
+	m.orgs[i].IsActive = false
+	m.orgs[i].DeletedAt = &now
+	m.orgs[i].UpdatedAt = now
+	return nil
+}
+
+// SetOrganizationVerified marks an organization as verified by an admin, or clears it when verifiedBy is nil
+func (m *MemoryRepository) SetOrganizationVerified(ctx context.Context, orgID string, verifiedBy *string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	i := m.indexOf(orgID)
+	if i < 0 || !m.orgs[i].IsActive {
+		return shared.NotFound("organization not found")
+	}
+	now := shared.Timestamp{Time: time.Now().UTC()}
+	org := &m.orgs[i]
+	org.VerifiedAt = nil
+	org.VerifiedBy = nil
+	if verifiedBy != nil {
+		org.VerifiedAt = &now
+		org.VerifiedBy = copyString(verifiedBy)
+	}
+	org.UpdatedAt = now
+	return nil
+}
+
+// GetHolidaysByOrgID returns the organization's holidays in date order
+func (m *MemoryRepository) GetHolidaysByOrgID(ctx context.Context, orgID string) ([]Holiday, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	holidays := []Holiday{}
+	for _, holiday := range m.holidays {
+		if holiday.OrgID == orgID {
+			holidays = append(holidays, holiday)
+		}
+	}
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].HolidayDate.Before(holidays[j].HolidayDate.Time)
+	})
+	return holidays, nil
+}
+
+// SaveHolidays upserts holidays by date and returns the saved rows
+func (m *MemoryRepository) SaveHolidays(ctx context.Context, orgID string, createdBy string, holidays []HolidayInput) ([]Holiday, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	saved := make([]Holiday, 0, len(holidays))
+	for _, input := range holidays {
+		date, err := time.Parse("2006-01-02", input.Date)
+		if err != nil {
+			return nil, shared.BadRequest("invalid holiday date %q", input.Date)
+		}
+		holiday := Holiday{
+			ID:          m.nextHolidayID,
+			OrgID:       orgID,
+			HolidayDate: shared.Date{Time: date},
+			Name:        input.Name,
+			CreatedBy:   &createdBy,
+			CreatedAt:   shared.Timestamp{Time: time.Now().UTC()},
+		}
+		replaced := false
+		for i, existing := range m.holidays {
+			if existing.OrgID == orgID && existing.HolidayDate.Equal(date) {
+				m.holidays[i].Name = input.Name
+				holiday = m.holidays[i]
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			m.holidays = append(m.holidays, holiday)
+			m.nextHolidayID++
+		}
+		saved = append(saved, holiday)
+	}
+	return saved, nil
+}
+
+// DeleteHoliday removes one of the organization's holidays
+func (m *MemoryRepository) DeleteHoliday(ctx context.Context, orgID string, holidayID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, holiday := range m.holidays {
+		if holiday.ID == holidayID && holiday.OrgID == orgID {
+			m.holidays = append(m.holidays[:i], m.holidays[i+1:]...)
+			return nil
+		}
+	}
+	return shared.NotFound("holiday not found")
+}
+
+// activeMember returns the user's active membership in the organization, or nil
+func (m *MemoryRepository) activeMember(userID, orgID string) *UserOrganization {
+	for i, member := range m.members {
+		if member.UserID == userID && member.OrgID == orgID && member.IsActive {
+			return &m.members[i]
+		}
+	}
+	return nil
+}
+
+// indexOf returns the position of the organization, deleted or not, or -1
+func (m *MemoryRepository) indexOf(orgID string) int {
+	for i, org := range m.orgs {
+		if org.ID == orgID {
+			return i
+		}
+	}
+	return -1
+}
+
+// urlTaken reports whether an organization other than exceptID already uses orgURL
+func (m *MemoryRepository) urlTaken(orgURL, exceptID string) bool {
+	for _, org := range m.orgs {
+		if org.ID != exceptID && org.OrgURL != nil && *org.OrgURL == orgURL {
+			return true
+		}
+	}
+	return false
+}
+
+func copyString(s *string) *string {
+	value := *s
+	return &value
+}
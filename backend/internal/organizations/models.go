@@ -14,6 +14,8 @@ type Organization struct {
 	OrgAddress *string           `json:"org_address"`
 	CreatedBy *string            `json:"created_by"` // User ID who created it
 	IsActive  bool               `json:"is_active"`
+	VerifiedAt *shared.Timestamp `json:"verified_at"` // Set once an admin has confirmed the organization is genuine
+	VerifiedBy *string           `json:"verified_by"` // Admin who verified it
 	DeletedAt *shared.Timestamp  `json:"deleted_at"` // Nullable since not all orgs are deleted
 	CreatedAt shared.Timestamp   `json:"created_at"`
 	UpdatedAt shared.Timestamp   `json:"updated_at"`
@@ -40,6 +42,11 @@ type CreateOrganizationRequest struct {
 	OrgAddress *string `json:"org_address" validate:"omitempty"`
 }
 
+// SetVerificationRequest represents an admin marking an organization as verified or not
+type SetVerificationRequest struct {
+	Verified *bool `json:"verified" validate:"required"`
+}
+
 // JoinOrganizationRequest represents a request to join an organization
 type JoinOrganizationRequest struct {
 	OrgID string `json:"org_id" validate:"required,uuid"`
@@ -53,3 +60,24 @@ type UpdateOrganizationRequest struct {
 	OrgPhone   *string `json:"org_phone" validate:"omitempty"`
 	OrgAddress *string `json:"org_address" validate:"omitempty"`
 }
+
+// Holiday is a date on which none of the organization's leagues play
+type Holiday struct {
+	ID          int64            `json:"id"`
+	OrgID       string           `json:"org_id"`
+	HolidayDate shared.Date      `json:"holiday_date"`
+	Name        string           `json:"name"`
+	CreatedBy   *string          `json:"created_by"`
+	CreatedAt   shared.Timestamp `json:"created_at"`
+}
+
+// HolidayInput is one holiday in a SaveHolidaysRequest
+type HolidayInput struct {
+	Date string `json:"date" validate:"required,datetime=2006-01-02"` // ISO 8601 date
+	Name string `json:"name" validate:"required,min=1,max=255"`
+}
+
+// SaveHolidaysRequest adds holidays to the organization's calendar; a date that is already a holiday is renamed
+type SaveHolidaysRequest struct {
+	Holidays []HolidayInput `json:"holidays" validate:"required,min=1,max=366,dive"`
+}
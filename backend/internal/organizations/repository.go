@@ -3,8 +3,11 @@ package organizations
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/leaguefindr/backend/internal/shared"
 	"github.com/supabase-community/postgrest-go"
 )
 
@@ -23,6 +26,10 @@ type RepositoryInterface interface {
 	RemoveUserFromOrganization(ctx context.Context, userID, orgID string) error
 	UpdateOrganization(ctx context.Context, orgID string, orgName, orgURL, orgEmail, orgPhone, orgAddress *string) error
 	DeleteOrganization(ctx context.Context, orgID string) error
+	SetOrganizationVerified(ctx context.Context, orgID string, verifiedBy *string) error
+	GetHolidaysByOrgID(ctx context.Context, orgID string) ([]Holiday, error)
+	SaveHolidays(ctx context.Context, orgID string, createdBy string, holidays []HolidayInput) ([]Holiday, error)
+	DeleteHoliday(ctx context.Context, orgID string, holidayID int64) error
 }
 
 type Repository struct {
@@ -89,7 +96,7 @@ func (r *Repository) GetUserOrgRole(ctx context.Context, userID, orgID string) (
 		ExecuteToWithContext(ctx, &userOrgs)
 
 	if err != nil || len(userOrgs) == 0 {
-		return "", fmt.Errorf("user does not have access to this organization")
+		return "", shared.Forbidden("user does not have access to this organization").Wrap(err)
 	}
 
 	return userOrgs[0].RoleInOrg, nil
@@ -235,7 +242,7 @@ func (r *Repository) GetOrganizationByID(ctx context.Context, orgID string) (*Or
 		ExecuteToWithContext(ctx, &orgs)
 
 	if err != nil || len(orgs) == 0 {
-		return nil, fmt.Errorf("organization not found")
+		return nil, shared.NotFound("organization not found").Wrap(err)
 	}
 
 	return &orgs[0], nil
@@ -324,7 +331,7 @@ func (r *Repository) RemoveUserFromOrganization(ctx context.Context, userID, org
 	}
 
 	if len(result) == 0 {
-		return fmt.Errorf("user is not a member of this organization")
+		return shared.NotFound("user is not a member of this organization")
 	}
 
 	return nil
@@ -361,7 +368,7 @@ func (r *Repository) UpdateOrganization(ctx context.Context, orgID string, orgNa
 	}
 
 	if len(result) == 0 {
-		return fmt.Errorf("organization not found")
+		return shared.NotFound("organization not found")
 	}
 
 	return nil
@@ -384,7 +391,97 @@ func (r *Repository) DeleteOrganization(ctx context.Context, orgID string) error
 	}
 
 	if len(result) == 0 {
-		return fmt.Errorf("organization not found")
+		return shared.NotFound("organization not found")
+	}
+
+	return nil
+}
+
+// SetOrganizationVerified marks an organization as verified by an admin, or clears it when verifiedBy is nil
+func (r *Repository) SetOrganizationVerified(ctx context.Context, orgID string, verifiedBy *string) error {
+	updateData := map[string]interface{}{
+		"verified_at": nil,
+		"verified_by": nil,
+	}
+	if verifiedBy != nil {
+		updateData["verified_at"] = time.Now().UTC().Format("2006-01-02T15:04:05")
+		updateData["verified_by"] = *verifiedBy
+	}
+
+	var result []map[string]interface{}
+	_, err := r.client.From("organizations").
+		Update(updateData, "", "").
+		Eq("id", orgID).
+		Eq("is_active", "true").
+		ExecuteToWithContext(ctx, &result)
+
+	if err != nil {
+		return fmt.Errorf("failed to update organization verification: %w", err)
+	}
+
+	if len(result) == 0 {
+		return shared.NotFound("organization not found")
+	}
+
+	return nil
+}
+
+// GetHolidaysByOrgID returns the organization's holidays in date order
+func (r *Repository) GetHolidaysByOrgID(ctx context.Context, orgID string) ([]Holiday, error) {
+	var holidays []Holiday
+
+	_, err := r.client.From("organization_holidays").
+		Select("*", "", false).
+		Eq("org_id", orgID).
+		Order("holiday_date", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteToWithContext(ctx, &holidays)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch holidays: %w", err)
+	}
+
+	return holidays, nil
+}
+
+// SaveHolidays upserts holidays by date and returns the saved rows
+func (r *Repository) SaveHolidays(ctx context.Context, orgID string, createdBy string, holidays []HolidayInput) ([]Holiday, error) {
+	insertData := make([]map[string]interface{}, len(holidays))
+	for i, holiday := range holidays {
+		insertData[i] = map[string]interface{}{
+			"org_id":       orgID,
+			"holiday_date": holiday.Date,
+			"name":         holiday.Name,
+			"created_by":   createdBy,
+		}
+	}
+
+	var result []Holiday
+	_, err := r.client.From("organization_holidays").
+		Insert(insertData, true, "org_id,holiday_date", "representation", "").
+		ExecuteToWithContext(ctx, &result)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to save holidays: %w", err)
+	}
+
+	return result, nil
+}
+
+// DeleteHoliday removes one of the organization's holidays
+func (r *Repository) DeleteHoliday(ctx context.Context, orgID string, holidayID int64) error {
+	var result []map[string]interface{}
+	_, err := r.client.From("organization_holidays").
+		Delete("representation", "").
+		Eq("id", strconv.FormatInt(holidayID, 10)).
+		Eq("org_id", orgID).
+		ExecuteToWithContext(ctx, &result)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete holiday: %w", err)
+	}
+
+	if len(result) == 0 {
+		return shared.NotFound("holiday not found")
 	}
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/leaguefindr/backend/internal/shared"
 	"github.com/supabase-community/postgrest-go"
 )
 
@@ -12,14 +13,31 @@ type Service struct {
 	serviceClient     *postgrest.Client
 	baseURL           string
 	anonKey           string
+	repository        func(ctx context.Context) RepositoryInterface // Repository for the caller of a request
+	serviceRepository RepositoryInterface                           // Repository that bypasses RLS once the service has authorized the caller
 }
 
 func NewService(baseClient *postgrest.Client, serviceClient *postgrest.Client, baseURL string, anonKey string) *Service {
+	s := &Service{
+		baseClient:        baseClient,
+		serviceClient:     serviceClient,
+		baseURL:           baseURL,
+		anonKey:           anonKey,
+		serviceRepository: NewRepository(serviceClient),
+	}
+	s.repository = func(ctx context.Context) RepositoryInterface {
+		return NewRepository(s.getClientWithAuth(ctx))
+	}
+	return s
+}
+
+// NewServiceWithRepository creates a service that keeps its organizations in repo instead of Supabase
+func NewServiceWithRepository(repo RepositoryInterface) *Service {
 	return &Service{
-		baseClient:    baseClient,
-		serviceClient: serviceClient,
-		baseURL:       baseURL,
-		anonKey:       anonKey,
+		repository: func(ctx context.Context) RepositoryInterface {
+			return repo
+		},
+		serviceRepository: repo,
 	}
 }
 
@@ -53,8 +71,7 @@ func (s *Service) getClientWithAuth(ctx context.Context) *postgrest.Client {
 // VerifyUserOrgAccess checks if user has access to organization
 // Returns error if user doesn't have access
 func (s *Service) VerifyUserOrgAccess(ctx context.Context, userID, orgID string) error {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 
 	hasAccess, err := repo.UserHasAccessToOrg(ctx, userID, orgID)
 	if err != nil {
@@ -62,7 +79,7 @@ func (s *Service) VerifyUserOrgAccess(ctx context.Context, userID, orgID string)
 	}
 
 	if !hasAccess {
-		return fmt.Errorf("user does not have access to this organization")
+		return shared.Forbidden("user does not have access to this organization")
 	}
 
 	return nil
@@ -70,42 +87,39 @@ func (s *Service) VerifyUserOrgAccess(ctx context.Context, userID, orgID string)
 
 // GetUserOrganizations returns all organizations a user belongs to
 func (s *Service) GetUserOrganizations(ctx context.Context, userID string) ([]Organization, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetUserOrganizations(ctx, userID)
 }
 
 // GetAllOrganizations returns all organizations (admin only)
 func (s *Service) GetAllOrganizations(ctx context.Context) ([]Organization, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetAllOrganizations(ctx)
 }
 
 // CreateOrganization creates a new organization
 func (s *Service) CreateOrganization(ctx context.Context, orgName, orgURL, orgEmail, orgPhone, orgAddress, createdBy string) (string, error) {
 	if orgName == "" {
-		return "", fmt.Errorf("organization name is required")
+		return "", shared.Validation(shared.FieldError{Field: "org_name", Message: "is required"})
 	}
 	if orgURL == "" {
-		return "", fmt.Errorf("organization website URL is required")
+		return "", shared.Validation(shared.FieldError{Field: "org_url", Message: "is required"})
 	}
 
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 
 	// Check if organization with this URL already exists
 	existingOrg, err := repo.GetOrganizationByURL(ctx, orgURL)
 	if err == nil && existingOrg != nil {
 		// Organization with this URL already exists
-		return "", fmt.Errorf("organization with this URL already exists")
+		return "", shared.Conflict("organization with this URL already exists")
 	}
 
 	orgID, err := repo.CreateOrganization(ctx, orgName, orgURL, orgEmail, orgPhone, orgAddress, createdBy)
 	if err != nil {
 		// Check if it's a unique constraint violation (duplicate URL)
 		if err.Error() == "failed to create organization: pq: duplicate key value violates unique constraint \"unique_org_url\"" {
-			return "", fmt.Errorf("organization with this URL already exists")
+			return "", shared.Conflict("organization with this URL already exists")
 		}
 		return "", err
 	}
@@ -121,8 +135,7 @@ func (s *Service) CreateOrganization(ctx context.Context, orgName, orgURL, orgEm
 
 // JoinOrganization allows a user to join an organization (direct join for MVP)
 func (s *Service) JoinOrganization(ctx context.Context, userID, orgID string) error {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 
 	// Verify organization exists
 	_, err := repo.GetOrganizationByID(ctx, orgID)
@@ -136,36 +149,31 @@ func (s *Service) JoinOrganization(ctx context.Context, userID, orgID string) er
 
 // GetOrganizationByID retrieves an organization by ID
 func (s *Service) GetOrganizationByID(ctx context.Context, orgID string) (*Organization, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetOrganizationByID(ctx, orgID)
 }
 
 // GetOrganizationMembers returns all members of an organization
 func (s *Service) GetOrganizationMembers(ctx context.Context, orgID string) ([]UserOrganization, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetOrganizationMembers(ctx, orgID)
 }
 
 // IsUserOrgAdmin checks if user is admin or owner in organization
 func (s *Service) IsUserOrgAdmin(ctx context.Context, userID, orgID string) (bool, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.IsUserOrgAdmin(ctx, userID, orgID)
 }
 
 // RemoveUserFromOrganization removes a user from organization
 func (s *Service) RemoveUserFromOrganization(ctx context.Context, userID, orgID string) error {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.RemoveUserFromOrganization(ctx, userID, orgID)
 }
 
 // UpdateOrganization updates organization details (admin/owner only)
 func (s *Service) UpdateOrganization(ctx context.Context, userID, orgID string, orgName, orgURL, orgEmail, orgPhone, orgAddress *string) error {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 
 	// Verify user is admin or owner
 	isAdmin, err := repo.IsUserOrgAdmin(ctx, userID, orgID)
@@ -173,12 +181,12 @@ func (s *Service) UpdateOrganization(ctx context.Context, userID, orgID string,
 		return fmt.Errorf("failed to verify admin status: %w", err)
 	}
 	if !isAdmin {
-		return fmt.Errorf("only admins and owners can update organization details")
+		return shared.Forbidden("only admins and owners can update organization details")
 	}
 
 	// Verify at least one field is being updated
 	if orgName == nil && orgURL == nil && orgEmail == nil && orgPhone == nil && orgAddress == nil {
-		return fmt.Errorf("at least one field must be provided to update")
+		return shared.BadRequest("at least one field must be provided to update")
 	}
 
 	// If updating URL, check if another organization already has this URL
@@ -186,7 +194,7 @@ func (s *Service) UpdateOrganization(ctx context.Context, userID, orgID string,
 		existingOrg, err := repo.GetOrganizationByURL(ctx, *orgURL)
 		if err == nil && existingOrg != nil && existingOrg.ID != orgID {
 			// Another organization already has this URL
-			return fmt.Errorf("organization with this URL already exists")
+			return shared.Conflict("organization with this URL already exists")
 		}
 	}
 
@@ -196,8 +204,7 @@ func (s *Service) UpdateOrganization(ctx context.Context, userID, orgID string,
 // DeleteOrganization soft deletes an organization (owner only)
 func (s *Service) DeleteOrganization(ctx context.Context, userID, orgID string) error {
 	// Use authenticated client to verify user is owner
-	authClient := s.getClientWithAuth(ctx)
-	authRepo := NewRepository(authClient)
+	authRepo := s.repository(ctx)
 
 	// Verify user is owner
 	role, err := authRepo.GetUserOrgRole(ctx, userID, orgID)
@@ -205,11 +212,48 @@ func (s *Service) DeleteOrganization(ctx context.Context, userID, orgID string)
 		return fmt.Errorf("failed to verify ownership: %w", err)
 	}
 	if role != "owner" {
-		return fmt.Errorf("only organization owner can delete the organization")
+		return shared.Forbidden("only organization owner can delete the organization")
 	}
 
 	// Use service client (with elevated privileges) to perform the delete
 	// This bypasses RLS since authorization is already verified above
-	serviceRepo := NewRepository(s.serviceClient)
-	return serviceRepo.DeleteOrganization(ctx, orgID)
+	return s.serviceRepository.DeleteOrganization(ctx, orgID)
+}
+
+// SetOrganizationVerified marks an organization as verified or not (app admins only, checked by the route)
+// Verification is one of the signals the league auto-approval rules read
+func (s *Service) SetOrganizationVerified(ctx context.Context, userID, orgID string, verified bool) error {
+	var verifiedBy *string
+	if verified {
+		verifiedBy = &userID
+	}
+	// App admins aren't members of the organization, so the update bypasses RLS
+	return s.serviceRepository.SetOrganizationVerified(ctx, orgID, verifiedBy)
+}
+
+// GetHolidays returns the organization's holiday calendar
+// Holidays are public so league schedules can be expanded for anonymous visitors
+func (s *Service) GetHolidays(ctx context.Context, orgID string) ([]Holiday, error) {
+	repo := s.repository(ctx)
+	return repo.GetHolidaysByOrgID(ctx, orgID)
+}
+
+// SaveHolidays adds holidays to the organization's calendar (org members only)
+func (s *Service) SaveHolidays(ctx context.Context, userID, orgID string, holidays []HolidayInput) ([]Holiday, error) {
+	if err := s.VerifyUserOrgAccess(ctx, userID, orgID); err != nil {
+		return nil, err
+	}
+
+	repo := s.repository(ctx)
+	return repo.SaveHolidays(ctx, orgID, userID, holidays)
+}
+
+// DeleteHoliday removes a holiday from the organization's calendar (org members only)
+func (s *Service) DeleteHoliday(ctx context.Context, userID, orgID string, holidayID int64) error {
+	if err := s.VerifyUserOrgAccess(ctx, userID, orgID); err != nil {
+		return err
+	}
+
+	repo := s.repository(ctx)
+	return repo.DeleteHoliday(ctx, orgID, holidayID)
 }
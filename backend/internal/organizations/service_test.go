@@ -0,0 +1,154 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+func TestCreateOrganization_LinksOwner(t *testing.T) {
+	service := NewServiceWithRepository(NewMemoryRepository())
+	ctx := context.Background()
+
+	orgID, err := service.CreateOrganization(ctx, "Seattle Rec", "https://seattlerec.example", "", "", "", "user_1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	orgs, err := service.GetUserOrganizations(ctx, "user_1")
+	if err != nil || len(orgs) != 1 || orgs[0].ID != orgID {
+		t.Fatalf("expected the creator to belong to the new organization, got %+v, %v", orgs, err)
+	}
+	if admin, _ := service.IsUserOrgAdmin(ctx, "user_1", orgID); !admin {
+		t.Error("expected the creator to be an owner")
+	}
+}
+
+func TestCreateOrganization_URLStaysUniqueAfterDelete(t *testing.T) {
+	service := NewServiceWithRepository(NewMemoryRepository())
+	ctx := context.Background()
+
+	orgID, err := service.CreateOrganization(ctx, "Seattle Rec", "https://seattlerec.example", "", "", "", "user_1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.CreateOrganization(ctx, "Copycat", "https://seattlerec.example", "", "", "", "user_2"); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected a conflict for a duplicate URL, got %v", err)
+	}
+
+	if err := service.DeleteOrganization(ctx, "user_1", orgID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := service.GetOrganizationByID(ctx, orgID); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected a deleted organization to be hidden, got %v", err)
+	}
+	if orgs, _ := service.GetAllOrganizations(ctx); len(orgs) != 0 {
+		t.Errorf("expected no active organizations, got %+v", orgs)
+	}
+	if _, err := service.CreateOrganization(ctx, "Copycat", "https://seattlerec.example", "", "", "", "user_2"); !errors.Is(err, shared.ErrConflict) {
+		t.Errorf("expected the URL of a deleted organization to stay taken, got %v", err)
+	}
+}
+
+func TestDeleteOrganization_OwnerOnly(t *testing.T) {
+	service := NewServiceWithRepository(NewMemoryRepository())
+	ctx := context.Background()
+
+	orgID, _ := service.CreateOrganization(ctx, "Seattle Rec", "https://seattlerec.example", "", "", "", "owner")
+	if err := service.JoinOrganization(ctx, "member", orgID); err != nil {
+		t.Fatalf("join failed: %v", err)
+	}
+
+	if err := service.DeleteOrganization(ctx, "member", orgID); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected a member to be forbidden from deleting, got %v", err)
+	}
+	if err := service.DeleteOrganization(ctx, "stranger", orgID); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected a non-member to be forbidden from deleting, got %v", err)
+	}
+}
+
+func TestRemoveUserFromOrganization(t *testing.T) {
+	service := NewServiceWithRepository(NewMemoryRepository())
+	ctx := context.Background()
+
+	orgID, _ := service.CreateOrganization(ctx, "Seattle Rec", "https://seattlerec.example", "", "", "", "owner")
+	_ = service.JoinOrganization(ctx, "member", orgID)
+
+	if err := service.RemoveUserFromOrganization(ctx, "member", orgID); err != nil {
+		t.Fatalf("remove failed: %v", err)
+	}
+	if err := service.VerifyUserOrgAccess(ctx, "member", orgID); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected a removed member to lose access, got %v", err)
+	}
+	if err := service.RemoveUserFromOrganization(ctx, "stranger", orgID); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected not found for a non-member, got %v", err)
+	}
+
+	// Rejoining reactivates the membership
+	if err := service.JoinOrganization(ctx, "member", orgID); err != nil {
+		t.Fatalf("rejoin failed: %v", err)
+	}
+	if members, _ := service.GetOrganizationMembers(ctx, orgID); len(members) != 2 {
+		t.Errorf("expected owner and member, got %+v", members)
+	}
+}
+
+func TestSaveHolidays_UpsertsByDate(t *testing.T) {
+	service := NewServiceWithRepository(NewMemoryRepository())
+	ctx := context.Background()
+
+	orgID, _ := service.CreateOrganization(ctx, "Seattle Rec", "https://seattlerec.example", "", "", "", "owner")
+	if _, err := service.SaveHolidays(ctx, "owner", orgID, []HolidayInput{
+		{Date: "2026-12-25", Name: "Christmas"},
+		{Date: "2026-11-26", Name: "Thanksgiving"},
+	}); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+	if _, err := service.SaveHolidays(ctx, "owner", orgID, []HolidayInput{{Date: "2026-12-25", Name: "Christmas Day"}}); err != nil {
+		t.Fatalf("second save failed: %v", err)
+	}
+
+	holidays, _ := service.GetHolidays(ctx, orgID)
+	if len(holidays) != 2 || holidays[0].Name != "Thanksgiving" || holidays[1].Name != "Christmas Day" {
+		t.Errorf("expected two holidays in date order with the rename applied, got %+v", holidays)
+	}
+
+	if _, err := service.SaveHolidays(ctx, "stranger", orgID, []HolidayInput{{Date: "2026-07-04", Name: "Independence Day"}}); !errors.Is(err, shared.ErrForbidden) {
+		t.Errorf("expected a non-member to be forbidden, got %v", err)
+	}
+	if err := service.DeleteHoliday(ctx, "owner", orgID, 999); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected not found for an unknown holiday, got %v", err)
+	}
+}
+
+func TestSetOrganizationVerified(t *testing.T) {
+	service := NewServiceWithRepository(NewMemoryRepository())
+	ctx := context.Background()
+
+	orgID, err := service.CreateOrganization(ctx, "Seattle Rec", "https://seattlerec.example", "", "", "", "user_1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := service.SetOrganizationVerified(ctx, "admin_1", orgID, true); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	org, _ := service.GetOrganizationByID(ctx, orgID)
+	if org.VerifiedAt == nil || org.VerifiedBy == nil || *org.VerifiedBy != "admin_1" {
+		t.Errorf("expected the organization to be verified by admin_1, got %v by %v", org.VerifiedAt, org.VerifiedBy)
+	}
+
+	if err := service.SetOrganizationVerified(ctx, "admin_1", orgID, false); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	org, _ = service.GetOrganizationByID(ctx, orgID)
+	if org.VerifiedAt != nil || org.VerifiedBy != nil {
+		t.Errorf("expected the verification to be cleared, got %v by %v", org.VerifiedAt, org.VerifiedBy)
+	}
+
+	if err := service.SetOrganizationVerified(ctx, "admin_1", "missing", true); !errors.Is(err, shared.ErrNotFound) {
+		t.Errorf("expected not found for an unknown organization, got %v", err)
+	}
+}
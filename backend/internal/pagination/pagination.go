@@ -0,0 +1,334 @@
+package pagination
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/supabase-community/postgrest-go"
+)
+
+// SortField maps a public sort name (the value of ?sort=) to the column it orders by
+type SortField struct {
+	Name   string
+	Column string
+}
+
+// Config describes the paging options a list endpoint accepts
+type Config struct {
+	DefaultLimit int
+	MaxLimit     int
+	Sorts        []SortField // Whitelisted sort fields
+	DefaultSort  string      // Used when ?sort= is absent, prefix with "-" for descending (e.g. "-created_at")
+	IDColumn     string      // Unique column used to break ties, defaults to "id"
+}
+
+// Params are the parsed paging parameters of a list request
+type Params struct {
+	Limit        int
+	Offset       int // Legacy offset paging, ignored when Cursor is set
+	Sort         SortField
+	Descending   bool
+	SortExplicit bool // The client asked for this sort rather than getting the default
+	Cursor       *Cursor
+	IDColumn     string
+}
+
+// Cursor marks where the previous page ended; clients only ever see it encoded
+type Cursor struct {
+	Sort   string  `json:"s"`           // Sort the cursor was issued for, e.g. "-deadline"
+	Key    *string `json:"k,omitempty"` // Sort key of the last row, nil when it was NULL
+	ID     string  `json:"i,omitempty"` // ID of the last row
+	Offset int     `json:"o,omitempty"` // Used instead of Key/ID for orderings computed outside the database
+}
+
+// Parse reads limit, offset, sort and cursor from the query string
+// Invalid limit/offset values fall back to the defaults; an unknown sort or a bad cursor is an error
+func Parse(query url.Values, cfg Config) (Params, error) {
+	if cfg.IDColumn == "" {
+		cfg.IDColumn = "id"
+	}
+
+	params := Params{
+		Limit:    cfg.DefaultLimit,
+		IDColumn: cfg.IDColumn,
+	}
+
+	if v := query.Get("limit"); v != "" {
+		if limit, err := strconv.Atoi(v); err == nil && limit > 0 {
+			params.Limit = min(limit, cfg.MaxLimit)
+		}
+	}
+
+	if v := query.Get("offset"); v != "" {
+		if offset, err := strconv.Atoi(v); err == nil && offset >= 0 {
+			params.Offset = offset
+		}
+	}
+
+	sortValue := strings.TrimSpace(query.Get("sort"))
+	params.SortExplicit = sortValue != ""
+	if sortValue == "" {
+		sortValue = cfg.DefaultSort
+	}
+
+	params.Descending = strings.HasPrefix(sortValue, "-")
+	name := strings.TrimPrefix(sortValue, "-")
+	found := false
+	for _, field := range cfg.Sorts {
+		if field.Name == name {
+			params.Sort = field
+			found = true
+			break
+		}
+	}
+	if !found {
+		names := make([]string, len(cfg.Sorts))
+		for i, field := range cfg.Sorts {
+			names[i] = field.Name
+		}
+		return params, fmt.Errorf("invalid sort: must be one of %s (prefix with - for descending)", strings.Join(names, ", "))
+	}
+
+	if v := query.Get("cursor"); v != "" {
+		cursor, err := DecodeCursor(v)
+		if err != nil {
+			return params, err
+		}
+		if cursor.Sort != params.SortString() {
+			return params, fmt.Errorf("invalid cursor: it was issued for a different sort")
+		}
+		params.Cursor = cursor
+	}
+
+	return params, nil
+}
+
+// SortString returns the sort in its query string form, e.g. "-deadline"
+func (p Params) SortString() string {
+	if p.Descending {
+		return "-" + p.Sort.Name
+	}
+	return p.Sort.Name
+}
+
+// StartOffset returns the index of the first row of the page for orderings computed outside the database
+func (p Params) StartOffset() int {
+	if p.Cursor != nil {
+		return p.Cursor.Offset
+	}
+	return p.Offset
+}
+
+// Apply adds ordering, the cursor position and the row window to a query
+// One extra row is requested so Trim can tell whether another page follows
+func (p Params) Apply(query *postgrest.FilterBuilder) *postgrest.FilterBuilder {
+	if p.Cursor != nil && p.Cursor.ID != "" {
+		query = query.And(p.keysetFilter(), "")
+	}
+
+	query = query.Order(p.Sort.Column, &postgrest.OrderOpts{Ascending: !p.Descending})
+	if p.Sort.Column != p.IDColumn {
+		query = query.Order(p.IDColumn, &postgrest.OrderOpts{Ascending: !p.Descending})
+	}
+
+	if p.Cursor != nil {
+		return query.Limit(p.Limit+1, "")
+	}
+	return query.Range(p.Offset, p.Offset+p.Limit, "")
+}
+
+// keysetFilter selects the rows after the cursor in (sort key, id) order, with NULL keys sorting last
+func (p Params) keysetFilter() string {
+	op := "gt"
+	if p.Descending {
+		op = "lt"
+	}
+	column, idColumn := p.Sort.Column, p.IDColumn
+	id := quote(p.Cursor.ID)
+
+	if column == idColumn {
+		return fmt.Sprintf("%s.%s.%s", idColumn, op, id)
+	}
+
+	if p.Cursor.Key == nil {
+		// Already in the NULL section at the end, only the id orders the rest
+		return fmt.Sprintf("%s.is.null,%s.%s.%s", column, idColumn, op, id)
+	}
+
+	key := quote(*p.Cursor.Key)
+	return fmt.Sprintf("or(%s.%s.%s,and(%s.eq.%s,%s.%s.%s),%s.is.null)",
+		column, op, key, column, key, idColumn, op, id, column)
+}
+
+// ApplySQL does what Apply does for a hand-written SQL query
+// arg adds a query argument and returns its placeholder. condition selects the rows after the cursor
+// ("" without one) and suffix holds the ORDER BY and row window to end the query with
+func (p Params) ApplySQL(arg func(value any) string) (condition string, suffix string) {
+	direction, op := "ASC", ">"
+	if p.Descending {
+		direction, op = "DESC", "<"
+	}
+	column, idColumn := p.Sort.Column, p.IDColumn
+
+	if p.Cursor != nil && p.Cursor.ID != "" {
+		id := arg(p.Cursor.ID)
+		switch {
+		case column == idColumn:
+			condition = fmt.Sprintf("%s %s %s", idColumn, op, id)
+		case p.Cursor.Key == nil:
+			condition = fmt.Sprintf("(%s IS NULL AND %s %s %s)", column, idColumn, op, id)
+		default:
+			key := arg(*p.Cursor.Key)
+			condition = fmt.Sprintf("(%s %s %s OR (%s = %s AND %s %s %s) OR %s IS NULL)",
+				column, op, key, column, key, idColumn, op, id, column)
+		}
+	}
+
+	suffix = fmt.Sprintf(" ORDER BY %s %s NULLS LAST", column, direction)
+	if column != idColumn {
+		suffix += fmt.Sprintf(", %s %s", idColumn, direction)
+	}
+	suffix += fmt.Sprintf(" LIMIT %d", p.Limit+1)
+	if p.Cursor == nil && p.Offset > 0 {
+		suffix += fmt.Sprintf(" OFFSET %d", p.Offset)
+	}
+	return condition, suffix
+}
+
+// NextCursor encodes a cursor pointing after the row with the given sort key and id
+func (p Params) NextCursor(key *string, id string) string {
+	return EncodeCursor(Cursor{Sort: p.SortString(), Key: key, ID: id})
+}
+
+// OffsetCursor encodes a cursor for the page starting at offset
+func (p Params) OffsetCursor(offset int) string {
+	return EncodeCursor(Cursor{Sort: p.SortString(), Offset: offset})
+}
+
+// Window does what Apply does for rows held in memory, such as those of an in-memory repository
+// key returns a row's sort key in the form NextCursor takes (nil for NULL) and id its unique ID
+// Keys and IDs are compared as numbers or timestamps when both sides parse as one
+func Window[T any](rows []T, p Params, key func(T) *string, id func(T) string) []T {
+	sorted := make([]T, len(rows))
+	copy(sorted, rows)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return p.compareRows(key(sorted[i]), id(sorted[i]), key(sorted[j]), id(sorted[j])) < 0
+	})
+
+	if p.Cursor != nil {
+		start := 0
+		if p.Cursor.ID != "" {
+			for start < len(sorted) && p.compareRows(key(sorted[start]), id(sorted[start]), p.Cursor.Key, p.Cursor.ID) <= 0 {
+				start++
+			}
+		}
+		return sorted[start:min(start+p.Limit+1, len(sorted))]
+	}
+
+	start := min(p.Offset, len(sorted))
+	return sorted[start:min(start+p.Limit+1, len(sorted))]
+}
+
+// compareRows orders two rows by sort key then ID in the page's direction, with NULL keys last
+func (p Params) compareRows(keyA *string, idA string, keyB *string, idB string) int {
+	if p.Sort.Column != p.IDColumn {
+		switch {
+		case keyA == nil && keyB != nil:
+			return 1
+		case keyA != nil && keyB == nil:
+			return -1
+		case keyA != nil && keyB != nil:
+			if c := compareValues(*keyA, *keyB); c != 0 {
+				if p.Descending {
+					return -c
+				}
+				return c
+			}
+		}
+	}
+
+	c := compareValues(idA, idB)
+	if p.Descending {
+		return -c
+	}
+	return c
+}
+
+// compareValues compares two sort keys as numbers, as timestamps, or else as strings
+func compareValues(a, b string) int {
+	if x, err := strconv.ParseFloat(a, 64); err == nil {
+		if y, err := strconv.ParseFloat(b, 64); err == nil {
+			return cmp.Compare(x, y)
+		}
+	}
+	if x, err := time.Parse(time.RFC3339Nano, a); err == nil {
+		if y, err := time.Parse(time.RFC3339Nano, b); err == nil {
+			return x.Compare(y)
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// Trim drops the extra row requested by Apply and reports whether another page follows
+func Trim[T any](items []T, limit int) ([]T, bool) {
+	if len(items) > limit {
+		return items[:limit], true
+	}
+	return items, false
+}
+
+// EncodeCursor returns the opaque string form of a cursor
+func EncodeCursor(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a cursor produced by EncodeCursor
+func DecodeCursor(value string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.Sort == "" || cursor.Offset < 0 {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	return &cursor, nil
+}
+
+// SetLinkHeader sets an RFC 5988 Link header with the first page and, when there is one, the next page
+func SetLinkHeader(w http.ResponseWriter, r *http.Request, nextCursor string) {
+	query := r.URL.Query()
+	query.Del("cursor")
+	query.Del("offset")
+
+	links := []string{fmt.Sprintf(`<%s>; rel="first"`, pageURL(r, query))}
+	if nextCursor != "" {
+		query.Set("cursor", nextCursor)
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, pageURL(r, query)))
+	}
+
+	w.Header().Set("Link", strings.Join(links, ", "))
+}
+
+func pageURL(r *http.Request, query url.Values) string {
+	u := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+	return u.String()
+}
+
+// quote wraps a value in double quotes so PostgREST treats reserved characters (, . : ( )) literally
+func quote(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return `"` + value + `"`
+}
@@ -0,0 +1,248 @@
+package pagination
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var testConfig = Config{
+	DefaultLimit: 20,
+	MaxLimit:     100,
+	Sorts: []SortField{
+		{Name: "deadline", Column: "registration_deadline"},
+		{Name: "created_at", Column: "created_at"},
+	},
+	DefaultSort: "-created_at",
+}
+
+func TestParseDefaults(t *testing.T) {
+	params, err := Parse(url.Values{}, testConfig)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if params.Limit != 20 || params.Offset != 0 {
+		t.Errorf("expected default limit/offset, got %d/%d", params.Limit, params.Offset)
+	}
+	if params.Sort.Column != "created_at" || !params.Descending || params.SortExplicit {
+		t.Errorf("expected default sort -created_at, got %+v", params)
+	}
+	if params.IDColumn != "id" {
+		t.Errorf("expected id column to default to id, got %q", params.IDColumn)
+	}
+}
+
+func TestParseLimitAndSort(t *testing.T) {
+	query, _ := url.ParseQuery("limit=500&offset=40&sort=deadline")
+	params, err := Parse(query, testConfig)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if params.Limit != 100 {
+		t.Errorf("expected limit to be capped at 100, got %d", params.Limit)
+	}
+	if params.Offset != 40 {
+		t.Errorf("expected offset 40, got %d", params.Offset)
+	}
+	if params.Sort.Column != "registration_deadline" || params.Descending || !params.SortExplicit {
+		t.Errorf("expected explicit ascending deadline sort, got %+v", params)
+	}
+
+	query, _ = url.ParseQuery("limit=abc&offset=-1")
+	params, _ = Parse(query, testConfig)
+	if params.Limit != 20 || params.Offset != 0 {
+		t.Errorf("expected invalid values to fall back to defaults, got %d/%d", params.Limit, params.Offset)
+	}
+}
+
+func TestParseRejectsUnknownSort(t *testing.T) {
+	query, _ := url.ParseQuery("sort=league_name")
+	_, err := Parse(query, testConfig)
+	if err == nil || !strings.Contains(err.Error(), "deadline, created_at") {
+		t.Errorf("expected error listing allowed sorts, got %v", err)
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	params, _ := Parse(url.Values{"sort": {"deadline"}}, testConfig)
+	key := "2025-03-01"
+	encoded := params.NextCursor(&key, "abc-123")
+
+	query := url.Values{"sort": {"deadline"}, "cursor": {encoded}}
+	next, err := Parse(query, testConfig)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if next.Cursor == nil || next.Cursor.ID != "abc-123" || next.Cursor.Key == nil || *next.Cursor.Key != key {
+		t.Errorf("unexpected cursor: %+v", next.Cursor)
+	}
+
+	// A cursor can't be reused with a different sort
+	query.Set("sort", "-deadline")
+	if _, err := Parse(query, testConfig); err == nil {
+		t.Errorf("expected error for cursor issued for another sort")
+	}
+
+	if _, err := Parse(url.Values{"cursor": {"not-a-cursor"}}, testConfig); err == nil {
+		t.Errorf("expected error for malformed cursor")
+	}
+}
+
+func TestStartOffset(t *testing.T) {
+	params, _ := Parse(url.Values{"offset": {"30"}}, testConfig)
+	if params.StartOffset() != 30 {
+		t.Errorf("expected offset 30, got %d", params.StartOffset())
+	}
+
+	next, _ := Parse(url.Values{"offset": {"30"}, "cursor": {params.OffsetCursor(50)}}, testConfig)
+	if next.StartOffset() != 50 {
+		t.Errorf("expected cursor offset to win, got %d", next.StartOffset())
+	}
+}
+
+func TestKeysetFilter(t *testing.T) {
+	key := "2025-03-01"
+	params := Params{
+		Sort:     SortField{Name: "deadline", Column: "registration_deadline"},
+		IDColumn: "id",
+		Cursor:   &Cursor{Key: &key, ID: "abc"},
+	}
+
+	want := `or(registration_deadline.gt."2025-03-01",and(registration_deadline.eq."2025-03-01",id.gt."abc"),registration_deadline.is.null)`
+	if got := params.keysetFilter(); got != want {
+		t.Errorf("ascending keyset:\n got %s\nwant %s", got, want)
+	}
+
+	params.Descending = true
+	if got := params.keysetFilter(); !strings.HasPrefix(got, `or(registration_deadline.lt.`) || !strings.Contains(got, `id.lt."abc"`) {
+		t.Errorf("expected descending comparisons, got %s", got)
+	}
+
+	params.Cursor.Key = nil
+	if got := params.keysetFilter(); got != `registration_deadline.is.null,id.lt."abc"` {
+		t.Errorf("unexpected null-key filter: %s", got)
+	}
+
+	params.Sort = SortField{Name: "id", Column: "id"}
+	if got := params.keysetFilter(); got != `id.lt."abc"` {
+		t.Errorf("unexpected id-only filter: %s", got)
+	}
+}
+
+func TestApplySQL(t *testing.T) {
+	var args []any
+	arg := func(value any) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	key := "2025-03-01"
+	params := Params{
+		Limit:    10,
+		Sort:     SortField{Name: "deadline", Column: "registration_deadline"},
+		IDColumn: "id",
+		Cursor:   &Cursor{Key: &key, ID: "abc"},
+	}
+	condition, suffix := params.ApplySQL(arg)
+	if want := "(registration_deadline > $2 OR (registration_deadline = $2 AND id > $1) OR registration_deadline IS NULL)"; condition != want {
+		t.Errorf("ascending keyset:\n got %s\nwant %s", condition, want)
+	}
+	if want := " ORDER BY registration_deadline ASC NULLS LAST, id ASC LIMIT 11"; suffix != want {
+		t.Errorf("unexpected suffix %q", suffix)
+	}
+	if len(args) != 2 || args[0] != "abc" || args[1] != key {
+		t.Errorf("unexpected args %v", args)
+	}
+
+	params.Descending = true
+	params.Cursor.Key = nil
+	condition, _ = params.ApplySQL(arg)
+	if condition != "(registration_deadline IS NULL AND id < $3)" {
+		t.Errorf("unexpected null-key condition: %s", condition)
+	}
+
+	params.Cursor = nil
+	params.Offset = 20
+	condition, suffix = params.ApplySQL(arg)
+	if condition != "" || suffix != " ORDER BY registration_deadline DESC NULLS LAST, id DESC LIMIT 11 OFFSET 20" {
+		t.Errorf("unexpected offset page %q %q", condition, suffix)
+	}
+}
+
+func TestQuoteEscapes(t *testing.T) {
+	if got := quote(`a"b\c`); got != `"a\"b\\c"` {
+		t.Errorf("unexpected quoting: %s", got)
+	}
+}
+
+func TestTrim(t *testing.T) {
+	items, more := Trim([]int{1, 2, 3}, 2)
+	if len(items) != 2 || !more {
+		t.Errorf("expected 2 items and more, got %v %v", items, more)
+	}
+
+	items, more = Trim([]int{1, 2}, 2)
+	if len(items) != 2 || more {
+		t.Errorf("expected 2 items and no more, got %v %v", items, more)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	type row struct {
+		id    string
+		price *string
+	}
+	price := func(v string) *string { return &v }
+	rows := []row{{"1", price("9")}, {"2", nil}, {"3", price("10")}, {"4", price("9")}}
+	key := func(r row) *string { return r.price }
+	id := func(r row) string { return r.id }
+	ids := func(rows []row) string {
+		var out []string
+		for _, r := range rows {
+			out = append(out, r.id)
+		}
+		return strings.Join(out, ",")
+	}
+
+	params := Params{Limit: 2, Sort: SortField{Name: "price", Column: "pricing_per_player"}, IDColumn: "id"}
+	if got := ids(Window(rows, params, key, id)); got != "1,4,3" {
+		t.Errorf("first page = %s, want 1,4,3 (numeric order plus one extra row)", got)
+	}
+
+	params.Cursor = &Cursor{Key: price("9"), ID: "4"}
+	if got := ids(Window(rows, params, key, id)); got != "3,2" {
+		t.Errorf("page after cursor = %s, want 3,2 (NULL keys last)", got)
+	}
+
+	params.Cursor = nil
+	params.Descending = true
+	params.Offset = 1
+	if got := ids(Window(rows, params, key, id)); got != "4,1,2" {
+		t.Errorf("descending page = %s, want 4,1,2", got)
+	}
+}
+
+func TestSetLinkHeader(t *testing.T) {
+	r := httptest.NewRequest("GET", "/v1/leagues?sort=deadline&offset=20&cursor=old&limit=10", nil)
+	w := httptest.NewRecorder()
+
+	SetLinkHeader(w, r, "next123")
+
+	link := w.Header().Get("Link")
+	if !strings.Contains(link, `</v1/leagues?limit=10&sort=deadline>; rel="first"`) {
+		t.Errorf("expected first link without cursor or offset, got %s", link)
+	}
+	if !strings.Contains(link, `</v1/leagues?cursor=next123&limit=10&sort=deadline>; rel="next"`) {
+		t.Errorf("expected next link with new cursor, got %s", link)
+	}
+
+	w = httptest.NewRecorder()
+	SetLinkHeader(w, r, "")
+	if strings.Contains(w.Header().Get("Link"), `rel="next"`) {
+		t.Errorf("expected no next link on the last page")
+	}
+}
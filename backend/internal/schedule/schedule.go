@@ -0,0 +1,274 @@
+// Package schedule expands a weekly game pattern into dated games
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxWeeks caps how far a season is expanded, so a bad end date can't produce an endless schedule
+const MaxWeeks = 104
+
+// ErrUnbounded is returned for a season with neither an end date nor a number of weeks
+var ErrUnbounded = errors.New("season needs an end date or a number of weeks")
+
+// Clock is a time of day
+type Clock struct {
+	Hour   int
+	Minute int
+}
+
+// ParseClock parses an HH:MM time of day
+func ParseClock(value string) (Clock, error) {
+	hourPart, minutePart, ok := strings.Cut(strings.TrimSpace(value), ":")
+	if ok {
+		hour, hourErr := strconv.Atoi(hourPart)
+		minute, minuteErr := strconv.Atoi(minutePart)
+		if hourErr == nil && minuteErr == nil && hour >= 0 && hour <= 23 && minute >= 0 && minute <= 59 && len(minutePart) == 2 {
+			return Clock{Hour: hour, Minute: minute}, nil
+		}
+	}
+	return Clock{}, fmt.Errorf("invalid time %q: expected HH:MM", value)
+}
+
+// String formats the clock as HH:MM
+func (c Clock) String() string {
+	return fmt.Sprintf("%02d:%02d", c.Hour, c.Minute)
+}
+
+// On returns the clock time on the given date
+func (c Clock) On(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), c.Hour, c.Minute, 0, 0, date.Location())
+}
+
+// ParseWeekday parses an English weekday name, ignoring case
+func ParseWeekday(value string) (time.Weekday, bool) {
+	trimmed := strings.TrimSpace(value)
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(trimmed, weekday.String()) {
+			return weekday, true
+		}
+	}
+	return 0, false
+}
+
+// Slot is one weekly game: a day and a start and end time
+// A slot ending at or before its start time ends the next day
+type Slot struct {
+	Day   time.Weekday
+	Start Clock
+	End   Clock
+}
+
+// ParseSlot parses a weekday name and HH:MM start and end times
+func ParseSlot(day, start, end string) (Slot, error) {
+	weekday, ok := ParseWeekday(day)
+	if !ok {
+		return Slot{}, fmt.Errorf("invalid game day %q", day)
+	}
+	startClock, err := ParseClock(start)
+	if err != nil {
+		return Slot{}, err
+	}
+	endClock, err := ParseClock(end)
+	if err != nil {
+		return Slot{}, err
+	}
+	return Slot{Day: weekday, Start: startClock, End: endClock}, nil
+}
+
+// times returns when the slot's game on date starts and ends
+func (s Slot) times(date time.Time) (time.Time, time.Time) {
+	start := s.Start.On(date)
+	end := s.End.On(date)
+	if !end.After(start) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return start, end
+}
+
+// Season is a weekly pattern with its bounds
+// Weeks counts weeks with at least one game, so weeks lost to closures push the season back; expansion also
+// stops at End. At least one of End and Weeks must be set
+type Season struct {
+	Start time.Time // Date of the first possible game
+	End   *time.Time
+	Weeks int
+	Slots []Slot
+}
+
+// Closure is a day without games, such as a league blackout date or an organization holiday
+type Closure struct {
+	Date   time.Time
+	Reason string
+}
+
+// ExceptionKind is a change made to a single game
+type ExceptionKind string
+
+const (
+	ExceptionCancel     ExceptionKind = "cancelled"
+	ExceptionReschedule ExceptionKind = "rescheduled"
+)
+
+// Exception cancels or moves the games planned on Date
+// With Start set it only applies to the game starting at that time; otherwise to every game of the day
+type Exception struct {
+	Kind     ExceptionKind
+	Date     time.Time
+	Start    *Clock
+	NewStart time.Time // Reschedule only
+	NewEnd   time.Time // Reschedule only
+	Reason   string
+}
+
+// Matches reports whether the exception applies to the game
+func (e Exception) Matches(game Game) bool {
+	if !sameDay(e.Date, game.Planned) {
+		return false
+	}
+	return e.Start == nil || (e.Start.Hour == game.Planned.Hour() && e.Start.Minute == game.Planned.Minute())
+}
+
+// Status is the state of a single game
+type Status string
+
+const (
+	StatusScheduled   Status = "scheduled"
+	StatusCancelled   Status = "cancelled"
+	StatusRescheduled Status = "rescheduled"
+)
+
+// Game is one dated game of a season
+type Game struct {
+	Week    int // 1-based count of weeks with games
+	Slot    int // Index into Season.Slots
+	Planned time.Time
+	Start   time.Time // Differs from Planned once the game is rescheduled
+	End     time.Time
+	Status  Status
+	Reason  string
+}
+
+// Skipped is a slot date left out because of a closure
+type Skipped struct {
+	Date   time.Time
+	Slot   int
+	Reason string
+}
+
+// Schedule is an expanded season
+type Schedule struct {
+	Games   []Game // Ordered by start time
+	Skipped []Skipped
+	Weeks   int
+	// Incomplete is set when the season ended (or hit MaxWeeks) before all of Season.Weeks could be played
+	Incomplete bool
+}
+
+// Expand lists every game of the season, leaving out closed days and applying the exceptions in order
+// Exceptions that match no game are ignored
+func Expand(season Season, closures []Closure, exceptions []Exception) (*Schedule, error) {
+	result := &Schedule{Games: []Game{}, Skipped: []Skipped{}}
+	if len(season.Slots) == 0 {
+		return result, nil
+	}
+	if season.End == nil && season.Weeks <= 0 {
+		return nil, ErrUnbounded
+	}
+
+	start := day(season.Start)
+	var end time.Time
+	if season.End != nil {
+		end = day(*season.End)
+	}
+	closed := make(map[time.Time]string, len(closures))
+	for _, closure := range closures {
+		if _, ok := closed[day(closure.Date)]; !ok {
+			closed[day(closure.Date)] = closure.Reason
+		}
+	}
+
+	for week := 0; week < MaxWeeks; week++ {
+		if season.Weeks > 0 && result.Weeks == season.Weeks {
+			break
+		}
+		weekStart := start.AddDate(0, 0, 7*week)
+		if season.End != nil && weekStart.After(end) {
+			break
+		}
+
+		played := false
+		for i, slot := range season.Slots {
+			date := nextWeekday(weekStart, slot.Day)
+			if season.End != nil && date.After(end) {
+				continue
+			}
+			if reason, ok := closed[date]; ok {
+				result.Skipped = append(result.Skipped, Skipped{Date: date, Slot: i, Reason: reason})
+				continue
+			}
+			gameStart, gameEnd := slot.times(date)
+			result.Games = append(result.Games, Game{
+				Week:    result.Weeks + 1,
+				Slot:    i,
+				Planned: gameStart,
+				Start:   gameStart,
+				End:     gameEnd,
+				Status:  StatusScheduled,
+			})
+			played = true
+		}
+		if played {
+			result.Weeks++
+		}
+	}
+	result.Incomplete = season.Weeks > 0 && result.Weeks < season.Weeks
+
+	for i := range result.Games {
+		for _, exception := range exceptions {
+			if exception.Matches(result.Games[i]) {
+				apply(&result.Games[i], exception)
+			}
+		}
+	}
+
+	sort.SliceStable(result.Games, func(i, j int) bool {
+		return result.Games[i].Start.Before(result.Games[j].Start)
+	})
+	sort.SliceStable(result.Skipped, func(i, j int) bool {
+		return result.Skipped[i].Date.Before(result.Skipped[j].Date)
+	})
+	return result, nil
+}
+
+func apply(game *Game, exception Exception) {
+	game.Reason = exception.Reason
+	switch exception.Kind {
+	case ExceptionCancel:
+		game.Status = StatusCancelled
+	case ExceptionReschedule:
+		game.Status = StatusRescheduled
+		game.Start = exception.NewStart
+		game.End = exception.NewEnd
+	}
+}
+
+// day truncates a time to midnight UTC of its calendar date
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
+
+// nextWeekday returns the first date on or after date that falls on weekday
+func nextWeekday(date time.Time, weekday time.Weekday) time.Time {
+	offset := (int(weekday) - int(date.Weekday()) + 7) % 7
+	return date.AddDate(0, 0, offset)
+}
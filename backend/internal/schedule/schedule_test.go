@@ -0,0 +1,198 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func date(value string) time.Time {
+	parsed, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func dates(games []Game) []string {
+	result := make([]string, len(games))
+	for i, game := range games {
+		result[i] = game.Start.Format("2006-01-02 15:04")
+	}
+	return result
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func mustSlot(t *testing.T, day, start, end string) Slot {
+	t.Helper()
+	slot, err := ParseSlot(day, start, end)
+	if err != nil {
+		t.Fatalf("ParseSlot: %v", err)
+	}
+	return slot
+}
+
+func TestExpandEnforcesWeeks(t *testing.T) {
+	// 2025-03-15 is a Saturday, so the first Tuesday game is on the 18th and the Saturday game on the 15th
+	season := Season{
+		Start: date("2025-03-15"),
+		Weeks: 3,
+		Slots: []Slot{mustSlot(t, "Tuesday", "18:00", "20:00"), mustSlot(t, "saturday", "10:00", "11:00")},
+	}
+
+	result, err := Expand(season, nil, nil)
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	want := []string{
+		"2025-03-15 10:00", "2025-03-18 18:00",
+		"2025-03-22 10:00", "2025-03-25 18:00",
+		"2025-03-29 10:00", "2025-04-01 18:00",
+	}
+	if got := dates(result.Games); !equalStrings(got, want) {
+		t.Errorf("games = %v, want %v", got, want)
+	}
+	if result.Weeks != 3 || result.Incomplete {
+		t.Errorf("expected 3 complete weeks, got %d (incomplete %v)", result.Weeks, result.Incomplete)
+	}
+	if result.Games[5].Week != 3 || result.Games[5].Status != StatusScheduled {
+		t.Errorf("unexpected last game %+v", result.Games[5])
+	}
+}
+
+func TestExpandSkipsClosures(t *testing.T) {
+	season := Season{
+		Start: date("2025-03-18"),
+		Weeks: 3,
+		Slots: []Slot{mustSlot(t, "Tuesday", "18:00", "20:00")},
+	}
+	closures := []Closure{
+		{Date: date("2025-03-25"), Reason: "Spring break"},
+		{Date: date("2025-03-26"), Reason: "Not a game day"},
+	}
+
+	result, err := Expand(season, closures, nil)
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	want := []string{"2025-03-18 18:00", "2025-04-01 18:00", "2025-04-08 18:00"}
+	if got := dates(result.Games); !equalStrings(got, want) {
+		t.Errorf("expected the closed week to push the season back, got %v", got)
+	}
+	if len(result.Skipped) != 1 || result.Skipped[0].Reason != "Spring break" || !result.Skipped[0].Date.Equal(date("2025-03-25")) {
+		t.Errorf("unexpected skipped dates %+v", result.Skipped)
+	}
+	if result.Games[1].Week != 2 {
+		t.Errorf("expected the game after the break to be week 2, got %d", result.Games[1].Week)
+	}
+}
+
+func TestExpandStopsAtSeasonEnd(t *testing.T) {
+	end := date("2025-04-01")
+	season := Season{
+		Start: date("2025-03-18"),
+		End:   &end,
+		Weeks: 8,
+		Slots: []Slot{mustSlot(t, "Tuesday", "18:00", "20:00")},
+	}
+
+	result, err := Expand(season, nil, nil)
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	if len(result.Games) != 3 || !result.Incomplete {
+		t.Errorf("expected 3 games and an incomplete season, got %v (incomplete %v)", dates(result.Games), result.Incomplete)
+	}
+
+	season.Weeks = 0
+	result, err = Expand(season, nil, nil)
+	if err != nil || len(result.Games) != 3 || result.Incomplete {
+		t.Errorf("expected the end date alone to bound the season, got %v, %v", result, err)
+	}
+
+	season.End = nil
+	if _, err := Expand(season, nil, nil); !errors.Is(err, ErrUnbounded) {
+		t.Errorf("expected ErrUnbounded, got %v", err)
+	}
+}
+
+func TestExpandAppliesExceptions(t *testing.T) {
+	season := Season{
+		Start: date("2025-03-18"),
+		Weeks: 3,
+		Slots: []Slot{mustSlot(t, "Tuesday", "18:00", "20:00"), mustSlot(t, "Tuesday", "20:00", "22:00")},
+	}
+	early := Clock{Hour: 18}
+	exceptions := []Exception{
+		{Kind: ExceptionCancel, Date: date("2025-03-18"), Reason: "Field flooded"},
+		{
+			Kind:     ExceptionReschedule,
+			Date:     date("2025-03-25"),
+			Start:    &early,
+			NewStart: time.Date(2025, 4, 10, 19, 0, 0, 0, time.UTC),
+			NewEnd:   time.Date(2025, 4, 10, 21, 0, 0, 0, time.UTC),
+		},
+		{Kind: ExceptionCancel, Date: date("2025-05-01")},
+	}
+
+	result, err := Expand(season, nil, exceptions)
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	if result.Games[0].Status != StatusCancelled || result.Games[1].Status != StatusCancelled || result.Games[0].Reason != "Field flooded" {
+		t.Errorf("expected both games on the 18th to be cancelled, got %+v %+v", result.Games[0], result.Games[1])
+	}
+
+	last := result.Games[len(result.Games)-1]
+	if last.Status != StatusRescheduled || last.Start.Format("2006-01-02 15:04") != "2025-04-10 19:00" {
+		t.Fatalf("expected the rescheduled game to sort last, got %+v", last)
+	}
+	if last.Planned.Format("2006-01-02 15:04") != "2025-03-25 18:00" {
+		t.Errorf("expected the planned time to be kept, got %v", last.Planned)
+	}
+	if result.Games[2].Planned.Hour() != 20 || result.Games[2].Status != StatusScheduled {
+		t.Errorf("expected the late game on the 25th to be untouched, got %+v", result.Games[2])
+	}
+}
+
+func TestSlotEndingAfterMidnight(t *testing.T) {
+	season := Season{Start: date("2025-03-15"), Weeks: 1, Slots: []Slot{mustSlot(t, "Saturday", "23:00", "01:00")}}
+	result, err := Expand(season, nil, nil)
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	if got := result.Games[0].End.Format("2006-01-02 15:04"); got != "2025-03-16 01:00" {
+		t.Errorf("expected the game to end the next day, got %s", got)
+	}
+}
+
+func TestParseSlot(t *testing.T) {
+	tests := []struct {
+		day, start, end string
+		wantErr         bool
+	}{
+		{"Monday", "19:00", "21:00", false},
+		{" wednesday ", "6:30", "08:00", false},
+		{"Funday", "19:00", "21:00", true},
+		{"Monday", "7pm", "21:00", true},
+		{"Monday", "19:00", "24:00", true},
+		{"Monday", "19:0", "21:00", true},
+	}
+	for _, tt := range tests {
+		_, err := ParseSlot(tt.day, tt.start, tt.end)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSlot(%q, %q, %q) error = %v, wantErr %v", tt.day, tt.start, tt.end, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrorCode classifies a domain error; handlers map it to an HTTP status and clients switch on it
+type ErrorCode string
+
+const (
+	CodeBadRequest     ErrorCode = "bad_request"
+	CodeUnauthorized   ErrorCode = "unauthorized"
+	CodeForbidden      ErrorCode = "forbidden"
+	CodeNotFound       ErrorCode = "not_found"
+	CodeConflict       ErrorCode = "conflict"
+	CodeValidation     ErrorCode = "validation_failed"
+	CodeTooLarge       ErrorCode = "too_large"
+	CodeNotImplemented ErrorCode = "not_implemented"
+	CodeInternal       ErrorCode = "internal"
+)
+
+// Sentinels for errors.Is, e.g. errors.Is(err, shared.ErrNotFound)
+var (
+	ErrBadRequest   = &Error{Code: CodeBadRequest}
+	ErrUnauthorized = &Error{Code: CodeUnauthorized}
+	ErrForbidden    = &Error{Code: CodeForbidden}
+	ErrNotFound     = &Error{Code: CodeNotFound}
+	ErrConflict     = &Error{Code: CodeConflict}
+	ErrValidation   = &Error{Code: CodeValidation}
+)
+
+// FieldError is a problem with one input of a request, keyed by its path in the request
+// (e.g. "season_end_date" or "game_occurrences[1].end_time") so the form can highlight it
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// String returns the error as a sentence, e.g. "division is required"
+func (e FieldError) String() string {
+	return e.Field + " " + e.Message
+}
+
+// Error is a domain error with a code and a message that is safe to show to clients
+// Services return it (usually wrapped with fmt.Errorf and %w) and WriteError turns it into a problem response
+type Error struct {
+	Code    ErrorCode
+	Message string
+	Fields  []FieldError // Failing inputs, for CodeValidation
+	Err     error        // Underlying cause, logged but never sent to clients
+}
+
+func (e *Error) Error() string {
+	message := e.Message
+	if message == "" {
+		message = string(e.Code)
+	}
+	if e.Err != nil {
+		return message + ": " + e.Err.Error()
+	}
+	return message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is matches errors with the same code, so any not found error is errors.Is(err, ErrNotFound)
+func (e *Error) Is(target error) bool {
+	other, ok := target.(*Error)
+	return ok && other.Code == e.Code
+}
+
+// Wrap returns a copy of the error with cause attached
+func (e *Error) Wrap(cause error) *Error {
+	wrapped := *e
+	wrapped.Err = cause
+	return &wrapped
+}
+
+func newError(code ErrorCode, format string, args []interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// BadRequest reports a request that can't be processed as sent
+func BadRequest(format string, args ...interface{}) *Error {
+	return newError(CodeBadRequest, format, args)
+}
+
+// Unauthorized reports a missing or invalid identity
+func Unauthorized(format string, args ...interface{}) *Error {
+	return newError(CodeUnauthorized, format, args)
+}
+
+// Forbidden reports a caller who is known but not allowed to do this
+func Forbidden(format string, args ...interface{}) *Error {
+	return newError(CodeForbidden, format, args)
+}
+
+// NotFound reports a missing resource
+func NotFound(format string, args ...interface{}) *Error {
+	return newError(CodeNotFound, format, args)
+}
+
+// Conflict reports a request that clashes with the current state, like approving an approved league
+func Conflict(format string, args ...interface{}) *Error {
+	return newError(CodeConflict, format, args)
+}
+
+// Validation reports failing inputs; the message lists them
+func Validation(fields ...FieldError) *Error {
+	messages := make([]string, len(fields))
+	for i, field := range fields {
+		messages[i] = field.String()
+	}
+	return &Error{Code: CodeValidation, Message: strings.Join(messages, "; "), Fields: fields}
+}
+
+// AsError returns the first *Error in err's chain, if any
+func AsError(err error) (*Error, bool) {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+	return nil, false
+}
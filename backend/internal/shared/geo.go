@@ -0,0 +1,156 @@
+package shared
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// EarthRadiusKm is the mean radius of the earth used for distance calculations
+const EarthRadiusKm = 6371.0
+
+// GeoPoint is a latitude/longitude pair in decimal degrees
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// BoundingBox is a rectangular area in decimal degrees
+type BoundingBox struct {
+	MinLat float64 `json:"min_lat"`
+	MinLng float64 `json:"min_lng"`
+	MaxLat float64 `json:"max_lat"`
+	MaxLng float64 `json:"max_lng"`
+}
+
+// HaversineKm returns the great-circle distance between two points in kilometers
+func HaversineKm(a, b GeoPoint) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := (b.Lat - a.Lat) * math.Pi / 180
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// BoundingBoxAround returns the smallest box containing every point within radiusKm of center
+// Used to pre-filter rows in the database before computing exact distances
+func BoundingBoxAround(center GeoPoint, radiusKm float64) BoundingBox {
+	angular := radiusKm / EarthRadiusKm
+	latDelta := angular * 180 / math.Pi
+
+	// Longitude degrees shrink towards the poles; near them the box spans every longitude
+	lngDelta := 180.0
+	if ratio := math.Sin(angular) / math.Cos(center.Lat*math.Pi/180); ratio >= 0 && ratio < 1 {
+		lngDelta = math.Asin(ratio) * 180 / math.Pi
+	}
+
+	return BoundingBox{
+		MinLat: math.Max(-90, center.Lat-latDelta),
+		MinLng: math.Max(-180, center.Lng-lngDelta),
+		MaxLat: math.Min(90, center.Lat+latDelta),
+		MaxLng: math.Min(180, center.Lng+lngDelta),
+	}
+}
+
+// Contains reports whether the point lies inside the box (edges included)
+func (b BoundingBox) Contains(p GeoPoint) bool {
+	return p.Lat >= b.MinLat && p.Lat <= b.MaxLat && p.Lng >= b.MinLng && p.Lng <= b.MaxLng
+}
+
+// Intersect returns the overlap of two boxes; the second return value is false if they don't overlap
+func (b BoundingBox) Intersect(other BoundingBox) (BoundingBox, bool) {
+	result := BoundingBox{
+		MinLat: math.Max(b.MinLat, other.MinLat),
+		MinLng: math.Max(b.MinLng, other.MinLng),
+		MaxLat: math.Min(b.MaxLat, other.MaxLat),
+		MaxLng: math.Min(b.MaxLng, other.MaxLng),
+	}
+	if result.MinLat > result.MaxLat || result.MinLng > result.MaxLng {
+		return BoundingBox{}, false
+	}
+	return result, true
+}
+
+// ParseGeoPoint parses a "lat,lng" string
+func ParseGeoPoint(value string) (GeoPoint, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 2 {
+		return GeoPoint{}, fmt.Errorf("expected lat,lng")
+	}
+
+	coords, err := parseCoordinates(parts)
+	if err != nil {
+		return GeoPoint{}, err
+	}
+
+	point := GeoPoint{Lat: coords[0], Lng: coords[1]}
+	if err := validateLat(point.Lat); err != nil {
+		return GeoPoint{}, err
+	}
+	if err := validateLng(point.Lng); err != nil {
+		return GeoPoint{}, err
+	}
+
+	return point, nil
+}
+
+// ParseBoundingBox parses a "minLng,minLat,maxLng,maxLat" string (the order Mapbox uses for map bounds)
+func ParseBoundingBox(value string) (BoundingBox, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 4 {
+		return BoundingBox{}, fmt.Errorf("expected minLng,minLat,maxLng,maxLat")
+	}
+
+	coords, err := parseCoordinates(parts)
+	if err != nil {
+		return BoundingBox{}, err
+	}
+
+	box := BoundingBox{MinLng: coords[0], MinLat: coords[1], MaxLng: coords[2], MaxLat: coords[3]}
+	for _, lat := range []float64{box.MinLat, box.MaxLat} {
+		if err := validateLat(lat); err != nil {
+			return BoundingBox{}, err
+		}
+	}
+	for _, lng := range []float64{box.MinLng, box.MaxLng} {
+		if err := validateLng(lng); err != nil {
+			return BoundingBox{}, err
+		}
+	}
+	if box.MinLat > box.MaxLat || box.MinLng > box.MaxLng {
+		return BoundingBox{}, fmt.Errorf("minimum coordinates must not exceed maximum coordinates")
+	}
+
+	return box, nil
+}
+
+func parseCoordinates(parts []string) ([]float64, error) {
+	coords := make([]float64, len(parts))
+	for i, part := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("%q is not a valid coordinate", strings.TrimSpace(part))
+		}
+		coords[i] = v
+	}
+	return coords, nil
+}
+
+func validateLat(lat float64) error {
+	if lat < -90 || lat > 90 {
+		return fmt.Errorf("latitude %v out of range [-90, 90]", lat)
+	}
+	return nil
+}
+
+func validateLng(lng float64) error {
+	if lng < -180 || lng > 180 {
+		return fmt.Errorf("longitude %v out of range [-180, 180]", lng)
+	}
+	return nil
+}
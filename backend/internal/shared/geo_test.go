@@ -0,0 +1,93 @@
+package shared
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHaversineKm(t *testing.T) {
+	// Downtown Seattle to downtown Portland is roughly 233 km
+	seattle := GeoPoint{Lat: 47.6062, Lng: -122.3321}
+	portland := GeoPoint{Lat: 45.5152, Lng: -122.6784}
+
+	got := HaversineKm(seattle, portland)
+	if math.Abs(got-233.5) > 1.5 {
+		t.Errorf("expected about 233.5 km, got %.2f", got)
+	}
+
+	if d := HaversineKm(seattle, seattle); d != 0 {
+		t.Errorf("expected zero distance for the same point, got %v", d)
+	}
+}
+
+func TestBoundingBoxAround(t *testing.T) {
+	center := GeoPoint{Lat: 47.6062, Lng: -122.3321}
+	box := BoundingBoxAround(center, 10)
+
+	if !box.Contains(center) {
+		t.Fatalf("expected box to contain its center")
+	}
+
+	// Points exactly 10 km north and east must fall inside the box
+	north := GeoPoint{Lat: center.Lat + 10/EarthRadiusKm*180/math.Pi, Lng: center.Lng}
+	if !box.Contains(north) {
+		t.Errorf("expected box to contain point 10 km north")
+	}
+	east := GeoPoint{Lat: center.Lat, Lng: box.MaxLng}
+	if d := HaversineKm(center, east); d < 10 {
+		t.Errorf("expected box edge to be at least 10 km east, got %.2f", d)
+	}
+
+	if box.Contains(GeoPoint{Lat: 45.5152, Lng: -122.6784}) {
+		t.Errorf("expected distant point to fall outside the box")
+	}
+}
+
+func TestBoundingBoxIntersect(t *testing.T) {
+	a := BoundingBox{MinLat: 0, MinLng: 0, MaxLat: 10, MaxLng: 10}
+	b := BoundingBox{MinLat: 5, MinLng: 5, MaxLat: 15, MaxLng: 15}
+
+	got, ok := a.Intersect(b)
+	if !ok {
+		t.Fatalf("expected boxes to overlap")
+	}
+	if got != (BoundingBox{MinLat: 5, MinLng: 5, MaxLat: 10, MaxLng: 10}) {
+		t.Errorf("unexpected intersection: %+v", got)
+	}
+
+	if _, ok := a.Intersect(BoundingBox{MinLat: 20, MinLng: 20, MaxLat: 30, MaxLng: 30}); ok {
+		t.Errorf("expected disjoint boxes not to overlap")
+	}
+}
+
+func TestParseGeoPoint(t *testing.T) {
+	point, err := ParseGeoPoint("47.6062, -122.3321")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if point.Lat != 47.6062 || point.Lng != -122.3321 {
+		t.Errorf("unexpected point: %+v", point)
+	}
+
+	for _, input := range []string{"", "47.6", "a,b", "91,0", "0,181", "1,2,3"} {
+		if _, err := ParseGeoPoint(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
+
+func TestParseBoundingBox(t *testing.T) {
+	box, err := ParseBoundingBox("-122.5,47.5,-122.2,47.7")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if box.MinLng != -122.5 || box.MinLat != 47.5 || box.MaxLng != -122.2 || box.MaxLat != 47.7 {
+		t.Errorf("unexpected box: %+v", box)
+	}
+
+	for _, input := range []string{"", "1,2,3", "-122.2,47.5,-122.5,47.7", "0,-95,1,1", "x,1,2,3"} {
+		if _, err := ParseBoundingBox(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
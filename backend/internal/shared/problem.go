@@ -0,0 +1,110 @@
+package shared
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details body
+// Code is an extension member that clients switch on instead of parsing Detail
+type Problem struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail,omitempty"`
+	Instance string       `json:"instance,omitempty"`
+	Code     ErrorCode    `json:"code"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+// statusByCode maps error codes to HTTP statuses
+var statusByCode = map[ErrorCode]int{
+	CodeBadRequest:     http.StatusBadRequest,
+	CodeUnauthorized:   http.StatusUnauthorized,
+	CodeForbidden:      http.StatusForbidden,
+	CodeNotFound:       http.StatusNotFound,
+	CodeConflict:       http.StatusConflict,
+	CodeValidation:     http.StatusBadRequest,
+	CodeTooLarge:       http.StatusRequestEntityTooLarge,
+	CodeNotImplemented: http.StatusNotImplemented,
+	CodeInternal:       http.StatusInternalServerError,
+}
+
+// codeByStatus is the reverse of statusByCode for responses written from a status
+var codeByStatus = map[int]ErrorCode{
+	http.StatusBadRequest:            CodeBadRequest,
+	http.StatusUnauthorized:          CodeUnauthorized,
+	http.StatusForbidden:             CodeForbidden,
+	http.StatusNotFound:              CodeNotFound,
+	http.StatusConflict:              CodeConflict,
+	http.StatusRequestEntityTooLarge: CodeTooLarge,
+	http.StatusNotImplemented:        CodeNotImplemented,
+	http.StatusInternalServerError:   CodeInternal,
+}
+
+// StatusOf returns the HTTP status for an error: the status of its code, or 500 for untyped errors
+func StatusOf(err error) int {
+	if domainErr, ok := AsError(err); ok {
+		if status, ok := statusByCode[domainErr.Code]; ok {
+			return status
+		}
+	}
+	return http.StatusInternalServerError
+}
+
+// WriteError writes err as a problem response
+// Typed errors use their own status and message; anything else is a 500 with the fallback detail,
+// so internal error text never reaches clients
+func WriteError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	domainErr, ok := AsError(err)
+	if !ok {
+		WriteProblem(w, r, http.StatusInternalServerError, fallback)
+		return
+	}
+	status := StatusOf(err)
+	writeProblem(w, Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   domainErr.Message,
+		Instance: instanceOf(r),
+		Code:     domainErr.Code,
+		Errors:   domainErr.Fields,
+	})
+}
+
+// WriteProblem writes a problem response for a status, for errors found by the handler itself
+// such as a missing header or a malformed body
+func WriteProblem(w http.ResponseWriter, r *http.Request, status int, detail string) {
+	code, ok := codeByStatus[status]
+	if !ok {
+		code = CodeInternal
+		if status < http.StatusInternalServerError {
+			code = CodeBadRequest
+		}
+	}
+	writeProblem(w, Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: instanceOf(r),
+		Code:     code,
+	})
+}
+
+func writeProblem(w http.ResponseWriter, problem Problem) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(problem.Status)
+	json.NewEncoder(w).Encode(problem)
+}
+
+func instanceOf(r *http.Request) string {
+	if r == nil || r.URL == nil {
+		return ""
+	}
+	return r.URL.Path
+}
@@ -0,0 +1,92 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func decodeProblem(t *testing.T, rec *httptest.ResponseRecorder) Problem {
+	t.Helper()
+	if got := rec.Header().Get("Content-Type"); got != ProblemContentType {
+		t.Errorf("Content-Type = %q, want %q", got, ProblemContentType)
+	}
+	var problem Problem
+	if err := json.NewDecoder(rec.Body).Decode(&problem); err != nil {
+		t.Fatalf("decode problem: %v", err)
+	}
+	if problem.Status != rec.Code {
+		t.Errorf("body status %d does not match response status %d", problem.Status, rec.Code)
+	}
+	return problem
+}
+
+func TestWriteErrorMapsTypedErrors(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+		code   ErrorCode
+		detail string
+	}{
+		{NotFound("league not found"), http.StatusNotFound, CodeNotFound, "league not found"},
+		{fmt.Errorf("failed to approve: %w", Forbidden("only admins can approve leagues")), http.StatusForbidden, CodeForbidden, "only admins can approve leagues"},
+		{Conflict("league is already %s", "approved"), http.StatusConflict, CodeConflict, "league is already approved"},
+		{errors.New("pq: connection refused"), http.StatusInternalServerError, CodeInternal, "Failed to approve league"},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPut, "/v1/leagues/admin/abc/approve", nil)
+		WriteError(rec, req, tt.err, "Failed to approve league")
+
+		if rec.Code != tt.status {
+			t.Errorf("%v: status = %d, want %d", tt.err, rec.Code, tt.status)
+		}
+		problem := decodeProblem(t, rec)
+		if problem.Code != tt.code || problem.Detail != tt.detail || problem.Instance != "/v1/leagues/admin/abc/approve" || problem.Type != "about:blank" {
+			t.Errorf("%v: unexpected problem %+v", tt.err, problem)
+		}
+	}
+}
+
+func TestWriteErrorIncludesFields(t *testing.T) {
+	err := Validation(FieldError{Field: "game_occurrences[1].end_time", Message: "must be after start_time"})
+	rec := httptest.NewRecorder()
+	WriteError(rec, httptest.NewRequest(http.MethodPost, "/v1/leagues", nil), err, "Failed to create league")
+
+	problem := decodeProblem(t, rec)
+	if rec.Code != http.StatusBadRequest || problem.Code != CodeValidation {
+		t.Fatalf("unexpected response %d %+v", rec.Code, problem)
+	}
+	if len(problem.Errors) != 1 || problem.Errors[0].Field != "game_occurrences[1].end_time" {
+		t.Errorf("unexpected field errors %+v", problem.Errors)
+	}
+	if problem.Detail != "game_occurrences[1].end_time must be after start_time" {
+		t.Errorf("unexpected detail %q", problem.Detail)
+	}
+}
+
+func TestWriteProblem(t *testing.T) {
+	rec := httptest.NewRecorder()
+	WriteProblem(rec, httptest.NewRequest(http.MethodGet, "/v1/venues/1/occupancy", nil), http.StatusNotImplemented, "Venue occupancy is not available")
+	problem := decodeProblem(t, rec)
+	if problem.Code != CodeNotImplemented || problem.Title != "Not Implemented" {
+		t.Errorf("unexpected problem %+v", problem)
+	}
+}
+
+func TestErrorIsMatchesCode(t *testing.T) {
+	cause := errors.New("no rows")
+	err := fmt.Errorf("failed to fetch league: %w", NotFound("league not found").Wrap(cause))
+	if !errors.Is(err, ErrNotFound) || errors.Is(err, ErrForbidden) {
+		t.Errorf("expected the error to match ErrNotFound only")
+	}
+	if !errors.Is(err, cause) {
+		t.Errorf("expected the cause to stay in the chain")
+	}
+	if StatusOf(err) != http.StatusNotFound {
+		t.Errorf("StatusOf = %d, want 404", StatusOf(err))
+	}
+}
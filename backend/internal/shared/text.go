@@ -0,0 +1,126 @@
+package shared
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormalizeText lowercases the input, replaces punctuation with spaces and collapses whitespace
+// "  Ice-Hockey, Co-ed " -> "ice hockey co ed"
+func NormalizeText(s string) string {
+	return strings.Join(Tokenize(s), " ")
+}
+
+// Tokenize splits the input into lowercase words of letters and digits
+func Tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Levenshtein returns the edit distance between two strings
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 {
+		return len(rb)
+	}
+	if len(rb) == 0 {
+		return len(ra)
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// AllowedEdits returns how many typos a search token may contain and still match
+// Tokens under 3 characters must match exactly, up to 5 characters allow one edit, longer tokens allow two
+func AllowedEdits(token string) int {
+	switch n := len([]rune(token)); {
+	case n < 3:
+		return 0
+	case n <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// TokenMatchScore scores how well a single query token matches the best of the given words
+// Exact matches score 1, prefixes 0.8 and typo matches 0.6 (one edit) or 0.5 (two edits); 0 means no match
+func TokenMatchScore(token string, words []string) float64 {
+	best := 0.0
+	allowed := AllowedEdits(token)
+	tokenLen := len([]rune(token))
+
+	for _, word := range words {
+		score := 0.0
+		switch {
+		case word == token:
+			score = 1
+		case tokenLen >= 2 && strings.HasPrefix(word, token):
+			score = 0.8
+		case allowed > 0 && abs(len([]rune(word))-tokenLen) <= allowed:
+			switch Levenshtein(token, word) {
+			case 1:
+				score = 0.6
+			case 2:
+				if allowed >= 2 {
+					score = 0.5
+				}
+			}
+		}
+		if score > best {
+			best = score
+			if best == 1 {
+				break
+			}
+		}
+	}
+
+	return best
+}
+
+// FuzzyMatchScore scores how well the query matches the text, between 0 and 1
+// Every query token has to match some word in the text, otherwise the score is 0
+func FuzzyMatchScore(query, text string) float64 {
+	queryTokens := Tokenize(query)
+	if len(queryTokens) == 0 {
+		return 0
+	}
+
+	words := Tokenize(text)
+	total := 0.0
+	for _, token := range queryTokens {
+		score := TokenMatchScore(token, words)
+		if score == 0 {
+			return 0
+		}
+		total += score
+	}
+
+	return total / float64(len(queryTokens))
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,77 @@
+package shared
+
+import "testing"
+
+func TestNormalizeText(t *testing.T) {
+	tests := map[string]string{
+		"  Ice-Hockey, Co-ed ": "ice hockey co ed",
+		"123 Main St.":         "123 main st",
+		"Soccer":               "soccer",
+		"%_":                   "",
+	}
+
+	for input, want := range tests {
+		if got := NormalizeText(input); got != want {
+			t.Errorf("NormalizeText(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"soccer", "soccer", 0},
+		{"socer", "soccer", 1},
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+		{"café", "cafe", 1},
+	}
+
+	for _, tt := range tests {
+		if got := Levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestTokenMatchScore(t *testing.T) {
+	words := []string{"monday", "night", "soccer"}
+
+	tests := []struct {
+		token string
+		want  float64
+	}{
+		{"soccer", 1},
+		{"socc", 0.8},
+		{"socer", 0.6},
+		{"nigt", 0.6},
+		{"sokker", 0.5},
+		{"ni", 0.8},
+		{"xy", 0},
+		{"tennis", 0},
+	}
+
+	for _, tt := range tests {
+		if got := TokenMatchScore(tt.token, words); got != tt.want {
+			t.Errorf("TokenMatchScore(%q) = %v, want %v", tt.token, got, tt.want)
+		}
+	}
+}
+
+func TestFuzzyMatchScore(t *testing.T) {
+	if score := FuzzyMatchScore("Socer", "Soccer"); score != 0.6 {
+		t.Errorf("expected typo match score 0.6, got %v", score)
+	}
+	if score := FuzzyMatchScore("ice hockey", "Ice-Hockey"); score != 1 {
+		t.Errorf("expected exact match score 1, got %v", score)
+	}
+	if score := FuzzyMatchScore("ice hockey", "Field Hockey"); score != 0 {
+		t.Errorf("expected no match when a token is missing, got %v", score)
+	}
+	if score := FuzzyMatchScore("", "Soccer"); score != 0 {
+		t.Errorf("expected empty query not to match, got %v", score)
+	}
+}
@@ -0,0 +1,74 @@
+package shared
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// NewValidator returns a struct validator that reports fields by their JSON names
+func NewValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return v
+}
+
+// FieldErrorsOf converts struct validation failures into field errors, one per failing field
+// A nil optional pointer is not reported against length or format rules, only against required
+func FieldErrorsOf(err error) []FieldError {
+	validationErrors, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return []FieldError{{Field: "request", Message: "is invalid: " + err.Error()}}
+	}
+
+	var fields []FieldError
+	for _, fieldErr := range validationErrors {
+		if fieldErr.Tag() != "required" && isNilValue(fieldErr.Value()) {
+			continue
+		}
+		var message string
+		switch fieldErr.Tag() {
+		case "required":
+			message = "is required"
+		case "max":
+			message = fmt.Sprintf("must be at most %s characters", fieldErr.Param())
+		case "min":
+			message = fmt.Sprintf("must be at least %s", fieldErr.Param())
+		case "oneof":
+			message = "must be one of " + strings.ReplaceAll(fieldErr.Param(), " ", ", ")
+		default:
+			message = fmt.Sprintf("failed %s validation", fieldErr.Tag())
+		}
+		fields = append(fields, FieldError{Field: fieldErr.Field(), Message: message})
+	}
+	return fields
+}
+
+// ValidateStruct runs struct validation and returns a Validation error listing the failing fields, or nil
+func ValidateStruct(v *validator.Validate, value interface{}) error {
+	err := v.Struct(value)
+	if err == nil {
+		return nil
+	}
+	fields := FieldErrorsOf(err)
+	if len(fields) == 0 {
+		return nil
+	}
+	return Validation(fields...)
+}
+
+func isNilValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	rv := reflect.ValueOf(value)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
@@ -9,20 +9,28 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	"github.com/leaguefindr/backend/internal/auth"
+	"github.com/leaguefindr/backend/internal/idempotency"
+	"github.com/leaguefindr/backend/internal/shared"
 )
 
 type Handler struct {
-	service   *Service
-	validator *validator.Validate
+	service     *Service
+	validator   *validator.Validate
+	idempotency *idempotency.Middleware
 }
 
 func NewHandler(service *Service) *Handler {
 	return &Handler{
 		service:   service,
-		validator: validator.New(),
+		validator: shared.NewValidator(),
 	}
 }
 
+// SetIdempotency makes the create route honor the Idempotency-Key header
+func (h *Handler) SetIdempotency(m *idempotency.Middleware) {
+	h.idempotency = m
+}
+
 // RegisterRoutes registers all sports routes
 func (h *Handler) RegisterRoutes(r chi.Router) {
 	r.Route("/sports", func(r chi.Router) {
@@ -34,7 +42,7 @@ func (h *Handler) RegisterRoutes(r chi.Router) {
 		// Protected routes (JWT required)
 		r.Group(func(r chi.Router) {
 			r.Use(auth.JWTMiddleware)
-			r.Post("/", h.CreateSport)
+			r.With(h.idempotency.Handler).Post("/", h.CreateSport)
 		})
 	})
 }
@@ -44,7 +52,7 @@ func (h *Handler) GetAllSports(w http.ResponseWriter, r *http.Request) {
 	sports, err := h.service.GetAllSports(r.Context())
 	if err != nil {
 		slog.Error("get all sports error", "err", err)
-		http.Error(w, "Failed to fetch sports", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to fetch sports")
 		return
 	}
 
@@ -61,20 +69,20 @@ func (h *Handler) GetAllSports(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) GetSportByID(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	if idStr == "" {
-		http.Error(w, "sport ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "sport ID is required")
 		return
 	}
 
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		http.Error(w, "Invalid sport ID", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid sport ID")
 		return
 	}
 
 	sport, err := h.service.GetSportByID(r.Context(), id)
 	if err != nil {
 		slog.Error("get sport by id error", "id", id, "err", err)
-		http.Error(w, "Sport not found", http.StatusNotFound)
+		shared.WriteError(w, r, err, "Failed to fetch sport")
 		return
 	}
 
@@ -90,23 +98,22 @@ func (h *Handler) CreateSport(w http.ResponseWriter, r *http.Request) {
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		slog.Error("create sport error", "err", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	// Validate request
-	err = h.validator.Struct(req)
+	err = shared.ValidateStruct(h.validator, req)
 	if err != nil {
-		validationErrors := err.(validator.ValidationErrors)
 		slog.Error("create sport error", "err", err)
-		http.Error(w, "Validation failed: "+validationErrors.Error(), http.StatusBadRequest)
+		shared.WriteError(w, r, err, "Validation failed")
 		return
 	}
 
 	sport, err := h.service.CreateSport(r.Context(), &req)
 	if err != nil {
 		slog.Error("create sport error", "err", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to create sport")
 		return
 	}
 
@@ -119,19 +126,29 @@ func (h *Handler) CreateSport(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) CheckSportExists(w http.ResponseWriter, r *http.Request) {
 	name := r.URL.Query().Get("name")
 	if name == "" {
-		http.Error(w, "name query parameter is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "name query parameter is required")
 		return
 	}
 
 	sport, err := h.service.CheckSportExists(r.Context(), name)
 	if err != nil {
 		slog.Error("check sport exists error", "name", name, "err", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to check sport exists")
 		return
 	}
 
 	response := CheckSportExistsResponse{
 		Exists: sport != nil,
+		Sport:  sport,
+	}
+
+	if sport == nil {
+		suggestions, err := h.service.FindSimilarSports(r.Context(), name)
+		if err != nil {
+			// Suggestions are best-effort, the exists check already succeeded
+			slog.Error("find similar sports error", "name", name, "err", err)
+		}
+		response.Suggestions = suggestions
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,58 @@
+package sports
+
+import "testing"
+
+func TestFindSportByName(t *testing.T) {
+	sports := []Sport{
+		{ID: 1, Name: "Soccer"},
+		{ID: 2, Name: "Ice Hockey"},
+	}
+
+	tests := []struct {
+		input  string
+		wantID int64
+	}{
+		{"soccer", 1},
+		{"  SOCCER ", 1},
+		{"ice-hockey", 2},
+		{"Ice  Hockey", 2},
+		{"Socer", 0},
+		{"Hockey", 0},
+		{"%", 0},
+		{"", 0},
+	}
+
+	for _, tt := range tests {
+		sport := findSportByName(sports, tt.input)
+		switch {
+		case tt.wantID == 0 && sport != nil:
+			t.Errorf("findSportByName(%q): expected no match, got %+v", tt.input, sport)
+		case tt.wantID != 0 && (sport == nil || sport.ID != tt.wantID):
+			t.Errorf("findSportByName(%q): expected sport %d, got %+v", tt.input, tt.wantID, sport)
+		}
+	}
+}
+
+func TestRankSimilarSports(t *testing.T) {
+	sports := []Sport{
+		{ID: 1, Name: "Soccer"},
+		{ID: 2, Name: "Indoor Soccer"},
+		{ID: 3, Name: "Softball"},
+		{ID: 4, Name: "Ice Hockey"},
+	}
+
+	similar := rankSimilarSports(sports, "Socer", 5)
+	if len(similar) != 2 || similar[0].ID != 1 || similar[1].ID != 2 {
+		t.Errorf("expected Soccer then Indoor Soccer, got %+v", similar)
+	}
+
+	similar = rankSimilarSports(sports, "hocky", 5)
+	if len(similar) != 1 || similar[0].ID != 4 {
+		t.Errorf("expected Ice Hockey, got %+v", similar)
+	}
+
+	similar = rankSimilarSports(sports, "so", 1)
+	if len(similar) != 1 {
+		t.Errorf("expected suggestions to be capped at 1, got %d", len(similar))
+	}
+}
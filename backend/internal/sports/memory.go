@@ -0,0 +1,74 @@
+package sports
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// MemoryRepository is a thread-safe in-memory RepositoryInterface used in tests and local development
+type MemoryRepository struct {
+	mu     sync.RWMutex
+	sports []Sport
+	nextID int64
+}
+
+// NewMemoryRepository creates an in-memory repository holding the given sports
+// Sports created later are numbered after the highest seeded ID
+func NewMemoryRepository(seed ...Sport) *MemoryRepository {
+	m := &MemoryRepository{nextID: 1}
+	for _, sport := range seed {
+		m.sports = append(m.sports, sport)
+		m.nextID = max(m.nextID, sport.ID+1)
+	}
+	return m
+}
+
+// GetAll retrieves all sports in ID order
+func (m *MemoryRepository) GetAll(ctx context.Context) ([]Sport, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	sports := make([]Sport, len(m.sports))
+	copy(sports, m.sports)
+	return sports, nil
+}
+
+// GetByID retrieves a sport by ID
+func (m *MemoryRepository) GetByID(ctx context.Context, id int) (*Sport, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, sport := range m.sports {
+		if sport.ID == int64(id) {
+			return &sport, nil
+		}
+	}
+	return nil, shared.NotFound("sport not found")
+}
+
+// GetByName retrieves a sport by name (case-insensitive), or nil when there is none
+func (m *MemoryRepository) GetByName(ctx context.Context, name string) (*Sport, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, sport := range m.sports {
+		if strings.EqualFold(sport.Name, name) {
+			return &sport, nil
+		}
+	}
+	return nil, nil
+}
+
+// Create stores a new sport with the next ID
+func (m *MemoryRepository) Create(ctx context.Context, name string) (*Sport, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sport := Sport{ID: m.nextID, Name: name}
+	m.nextID++
+	m.sports = append(m.sports, sport)
+	return &sport, nil
+}
@@ -28,5 +28,7 @@ type ErrorResponse struct {
 
 // CheckSportExistsResponse represents the response when checking if a sport exists
 type CheckSportExistsResponse struct {
-	Exists bool `json:"exists"`
+	Exists      bool    `json:"exists"`
+	Sport       *Sport  `json:"sport,omitempty"`       // The matching sport when it exists
+	Suggestions []Sport `json:"suggestions,omitempty"` // Close matches when it doesn't (e.g. typos)
 }
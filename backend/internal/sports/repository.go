@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"strconv"
 
+	"github.com/leaguefindr/backend/internal/shared"
 	"github.com/supabase-community/postgrest-go"
 )
 
@@ -52,7 +53,7 @@ func (r *Repository) GetByID(ctx context.Context, id int) (*Sport, error) {
 		ExecuteToWithContext(ctx, &sports)
 
 	if err != nil || len(sports) == 0 {
-		return nil, fmt.Errorf("sport not found")
+		return nil, shared.NotFound("sport not found").Wrap(err)
 	}
 
 	return &sports[0], nil
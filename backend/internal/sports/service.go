@@ -3,22 +3,42 @@ package sports
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/leaguefindr/backend/internal/shared"
 	"github.com/supabase-community/postgrest-go"
 )
 
+// maxSportSuggestions caps the similar sports returned when a name doesn't match exactly
+const maxSportSuggestions = 5
+
 type Service struct {
 	baseClient *postgrest.Client
 	baseURL    string
 	anonKey    string
+	repository func(ctx context.Context) RepositoryInterface // Repository for the caller of a request
 }
 
 func NewService(baseClient *postgrest.Client, baseURL string, anonKey string) *Service {
-	return &Service{
+	s := &Service{
 		baseClient: baseClient,
 		baseURL:    baseURL,
 		anonKey:    anonKey,
 	}
+	s.repository = func(ctx context.Context) RepositoryInterface {
+		return NewRepository(s.getClientWithAuth(ctx))
+	}
+	return s
+}
+
+// NewServiceWithRepository creates a service that keeps its sports in repo instead of Supabase
+func NewServiceWithRepository(repo RepositoryInterface) *Service {
+	return &Service{
+		repository: func(ctx context.Context) RepositoryInterface {
+			return repo
+		},
+	}
 }
 
 // getClientWithAuth creates a new PostgREST client with JWT from context
@@ -50,47 +70,112 @@ func (s *Service) getClientWithAuth(ctx context.Context) *postgrest.Client {
 
 // GetAllSports retrieves all sports
 func (s *Service) GetAllSports(ctx context.Context) ([]Sport, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetAll(ctx)
 }
 
 // GetSportByID retrieves a sport by ID
 func (s *Service) GetSportByID(ctx context.Context, id int) (*Sport, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetByID(ctx, id)
 }
 
 // CheckSportExists checks if a sport exists by name
+// Names are compared after normalizing case, punctuation and whitespace ("Ice-Hockey" matches "ice hockey")
 func (s *Service) CheckSportExists(ctx context.Context, name string) (*Sport, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
-	return repo.GetByName(ctx, name)
+	repo := s.repository(ctx)
+
+	sports, err := repo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check sport existence: %w", err)
+	}
+
+	return findSportByName(sports, name), nil
+}
+
+// FindSimilarSports returns sports whose names closely match name, best match first
+// Tolerates typos so "Socer" suggests "Soccer"
+func (s *Service) FindSimilarSports(ctx context.Context, name string) ([]Sport, error) {
+	repo := s.repository(ctx)
+
+	sports, err := repo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find similar sports: %w", err)
+	}
+
+	return rankSimilarSports(sports, name, maxSportSuggestions), nil
 }
 
 // CreateSport creates a new sport (auto-creates if doesn't exist)
 // Returns the existing sport if it already exists, or the newly created one
 func (s *Service) CreateSport(ctx context.Context, req *CreateSportRequest) (*Sport, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 
 	// Check if sport already exists
-	existingSport, err := repo.GetByName(ctx, req.Name)
+	sports, err := repo.GetAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check sport existence: %w", err)
 	}
 
 	// If sport already exists, return it
-	if existingSport != nil {
+	if existingSport := findSportByName(sports, req.Name); existingSport != nil {
 		return existingSport, nil
 	}
 
 	// Sport doesn't exist, create it
-	sport, err := repo.Create(ctx, req.Name)
+	sport, err := repo.Create(ctx, strings.TrimSpace(req.Name))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create sport: %w", err)
 	}
 
 	return sport, nil
 }
+
+// findSportByName returns the sport whose normalized name equals the normalized input, or nil
+func findSportByName(sports []Sport, name string) *Sport {
+	normalized := shared.NormalizeText(name)
+	if normalized == "" {
+		return nil
+	}
+
+	for i := range sports {
+		if shared.NormalizeText(sports[i].Name) == normalized {
+			return &sports[i]
+		}
+	}
+
+	return nil
+}
+
+// rankSimilarSports returns up to limit sports fuzzily matching name, best match first
+func rankSimilarSports(sports []Sport, name string, limit int) []Sport {
+	type candidate struct {
+		sport Sport
+		score float64
+	}
+
+	var candidates []candidate
+	for _, sport := range sports {
+		if score := shared.FuzzyMatchScore(name, sport.Name); score > 0 {
+			candidates = append(candidates, candidate{sport: sport, score: score})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		// Prefer the shorter name on ties ("Soccer" before "Indoor Soccer")
+		if len(candidates[i].sport.Name) != len(candidates[j].sport.Name) {
+			return len(candidates[i].sport.Name) < len(candidates[j].sport.Name)
+		}
+		return candidates[i].sport.Name < candidates[j].sport.Name
+	})
+
+	similar := []Sport{}
+	for i := 0; i < len(candidates) && i < limit; i++ {
+		similar = append(similar, candidates[i].sport)
+	}
+
+	return similar
+}
@@ -1,40 +1,66 @@
 package venues
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"log/slog"
+	"math"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	"github.com/leaguefindr/backend/internal/auth"
+	"github.com/leaguefindr/backend/internal/idempotency"
+	"github.com/leaguefindr/backend/internal/shared"
 )
 
 type Handler struct {
-	service   *Service
-	validator *validator.Validate
+	service     *Service
+	validator   *validator.Validate
+	occupancy   OccupancyProvider
+	idempotency *idempotency.Middleware
+}
+
+// OccupancyProvider lists the weekly slots booked at a venue
+// Bookings come from leagues, which depend on this package, so they are provided from outside
+type OccupancyProvider interface {
+	GetVenueOccupancy(ctx context.Context, venueID int64, from time.Time) ([]OccupiedSlot, error)
 }
 
 func NewHandler(service *Service) *Handler {
 	return &Handler{
 		service:   service,
-		validator: validator.New(),
+		validator: shared.NewValidator(),
 	}
 }
 
+// SetOccupancyProvider sets where venue occupancy is read from; without one the occupancy route returns 501
+func (h *Handler) SetOccupancyProvider(provider OccupancyProvider) {
+	h.occupancy = provider
+}
+
+// SetIdempotency makes the create route honor the Idempotency-Key header
+func (h *Handler) SetIdempotency(m *idempotency.Middleware) {
+	h.idempotency = m
+}
+
 // RegisterRoutes registers all venue routes
 func (h *Handler) RegisterRoutes(r chi.Router) {
 	r.Route("/venues", func(r chi.Router) {
 		// Public routes (no auth required)
 		r.Get("/", h.GetAllVenues)
 		r.Get("/exists", h.CheckVenueExists) // Must come before /{id} to avoid being matched as an ID
+		r.Get("/nearby", h.GetNearbyVenues)
 		r.Get("/{id}", h.GetVenueByID)
+		r.Get("/{id}/occupancy", h.GetVenueOccupancy)
 
 		// Protected routes (JWT required)
 		r.Group(func(r chi.Router) {
 			r.Use(auth.JWTMiddleware)
-			r.Post("/", h.CreateVenue)
+			r.With(h.idempotency.Handler).Post("/", h.CreateVenue)
 		})
 	})
 }
@@ -44,7 +70,7 @@ func (h *Handler) GetAllVenues(w http.ResponseWriter, r *http.Request) {
 	venues, err := h.service.GetAllVenues(r.Context())
 	if err != nil {
 		slog.Error("get all venues error", "err", err)
-		http.Error(w, "Failed to fetch venues", http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to fetch venues")
 		return
 	}
 
@@ -57,24 +83,72 @@ func (h *Handler) GetAllVenues(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(GetVenuesResponse{Venues: venues})
 }
 
+// GetNearbyVenues returns venues near a point ordered by distance (public)
+// Query: near=lat,lng (required), radius_km (optional, defaults to DefaultNearbyRadiusKm)
+func (h *Handler) GetNearbyVenues(w http.ResponseWriter, r *http.Request) {
+	near := r.URL.Query().Get("near")
+	if near == "" {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "near query parameter is required")
+		return
+	}
+
+	center, err := shared.ParseGeoPoint(near)
+	if err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid near: "+err.Error())
+		return
+	}
+
+	radiusKm, err := ParseRadiusKm(r.URL.Query().Get("radius_km"))
+	if err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	venues, err := h.service.GetVenuesNearby(r.Context(), center, radiusKm)
+	if err != nil {
+		slog.Error("get nearby venues error", "near", near, "radius_km", radiusKm, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch venues")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GetNearbyVenuesResponse{Venues: venues, RadiusKm: radiusKm})
+}
+
+// ParseRadiusKm parses an optional radius_km query value
+// Empty values return DefaultNearbyRadiusKm
+func ParseRadiusKm(value string) (float64, error) {
+	if value == "" {
+		return DefaultNearbyRadiusKm, nil
+	}
+
+	radiusKm, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(radiusKm) || radiusKm <= 0 || radiusKm > MaxNearbyRadiusKm {
+		return 0, fmt.Errorf("invalid radius_km: must be greater than 0 and at most %v", MaxNearbyRadiusKm)
+	}
+
+	return radiusKm, nil
+}
+
 // GetVenueByID returns a specific venue by ID (public)
 func (h *Handler) GetVenueByID(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	if idStr == "" {
-		http.Error(w, "venue ID is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "venue ID is required")
 		return
 	}
 
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		http.Error(w, "Invalid venue ID", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid venue ID")
 		return
 	}
 
 	venue, err := h.service.GetVenueByID(r.Context(), id)
 	if err != nil {
 		slog.Error("get venue by id error", "id", id, "err", err)
-		http.Error(w, "Venue not found", http.StatusNotFound)
+		shared.WriteError(w, r, err, "Failed to fetch venue")
 		return
 	}
 
@@ -83,6 +157,51 @@ func (h *Handler) GetVenueByID(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(venue)
 }
 
+// GetVenueOccupancy returns the weekly slots booked at a venue by approved leagues, flagging overlaps (public)
+// Seasons that ended before ?from= (YYYY-MM-DD, default today) are left out
+func (h *Handler) GetVenueOccupancy(w http.ResponseWriter, r *http.Request) {
+	if h.occupancy == nil {
+		shared.WriteProblem(w, r, http.StatusNotImplemented, "Venue occupancy is not available")
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid venue ID")
+		return
+	}
+
+	from := time.Now().UTC()
+	if value := r.URL.Query().Get("from"); value != "" {
+		from, err = time.Parse("2006-01-02", value)
+		if err != nil {
+			shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid from date, expected YYYY-MM-DD")
+			return
+		}
+	}
+
+	venue, err := h.service.GetVenueByID(r.Context(), id)
+	if err != nil {
+		slog.Error("get venue occupancy error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch venue")
+		return
+	}
+
+	slots, err := h.occupancy.GetVenueOccupancy(r.Context(), venue.ID, from)
+	if err != nil {
+		slog.Error("get venue occupancy error", "id", id, "err", err)
+		shared.WriteError(w, r, err, "Failed to fetch venue occupancy")
+		return
+	}
+	if slots == nil {
+		slots = []OccupiedSlot{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GetVenueOccupancyResponse{Venue: *venue, From: from.Format("2006-01-02"), Slots: slots})
+}
+
 // CreateVenue creates a new venue (auto-creates if doesn't exist)
 func (h *Handler) CreateVenue(w http.ResponseWriter, r *http.Request) {
 	var req CreateVenueRequest
@@ -90,23 +209,22 @@ func (h *Handler) CreateVenue(w http.ResponseWriter, r *http.Request) {
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		slog.Error("create venue error", "err", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	// Validate request
-	err = h.validator.Struct(req)
+	err = shared.ValidateStruct(h.validator, req)
 	if err != nil {
-		validationErrors := err.(validator.ValidationErrors)
 		slog.Error("create venue error", "err", err)
-		http.Error(w, "Validation failed: "+validationErrors.Error(), http.StatusBadRequest)
+		shared.WriteError(w, r, err, "Validation failed")
 		return
 	}
 
 	venue, err := h.service.CreateVenue(r.Context(), &req)
 	if err != nil {
 		slog.Error("create venue error", "err", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to create venue")
 		return
 	}
 
@@ -119,19 +237,29 @@ func (h *Handler) CreateVenue(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) CheckVenueExists(w http.ResponseWriter, r *http.Request) {
 	address := r.URL.Query().Get("address")
 	if address == "" {
-		http.Error(w, "address query parameter is required", http.StatusBadRequest)
+		shared.WriteProblem(w, r, http.StatusBadRequest, "address query parameter is required")
 		return
 	}
 
 	venue, err := h.service.CheckVenueExists(r.Context(), address)
 	if err != nil {
 		slog.Error("check venue exists error", "address", address, "err", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		shared.WriteError(w, r, err, "Failed to check venue exists")
 		return
 	}
 
 	response := CheckVenueExistsResponse{
 		Exists: venue != nil,
+		Venue:  venue,
+	}
+
+	if venue == nil {
+		suggestions, err := h.service.FindSimilarVenues(r.Context(), address)
+		if err != nil {
+			// Suggestions are best-effort, the exists check already succeeded
+			slog.Error("find similar venues error", "address", address, "err", err)
+		}
+		response.Suggestions = suggestions
 	}
 
 	w.Header().Set("Content-Type", "application/json")
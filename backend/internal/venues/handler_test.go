@@ -7,545 +7,156 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/leaguefindr/backend/internal/auth"
+	"github.com/leaguefindr/backend/internal/shared"
 )
 
-// Helper function to create a test handler
-func createTestVenueHandler() *Handler {
-	venuesRepo := NewMockVenueRepository()
-	authRepo := NewMockAuthRepository()
-	service := NewService(venuesRepo, authRepo)
-	return NewHandler(service, auth.NewService(authRepo))
+// stubOccupancy returns fixed slots for any venue
+type stubOccupancy struct {
+	slots []OccupiedSlot
 }
 
-// Helper function to create test request body
-func createVenueRequestBody(v interface{}) *bytes.Buffer {
-	body := new(bytes.Buffer)
-	json.NewEncoder(body).Encode(v)
-	return body
-}
-
-// Helper function to set chi URL params
-func setVenueChiParam(req *http.Request, key, value string) *http.Request {
-	rctx := chi.NewRouteContext()
-	rctx.URLParams.Add(key, value)
-	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
-}
-
-// TestGetAllApprovedVenues_Public tests the public endpoint for getting approved venues
-func TestGetAllApprovedVenues_Public(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	// Add some test data
-	venue := &Venue{Name: "Madison Square Garden", Address: "33 E 33rd St, NYC", Lat: 40.7505, Lng: -73.9934, Status: VenueStatusApproved}
-	handler.service.repo.(*MockVenueRepository).Create(venue)
-
-	req, err := http.NewRequest("GET", "/venues/", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetAllApprovedVenues).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
-	}
-
-	expected := "application/json"
-	if ct := rr.Header().Get("Content-Type"); ct != expected {
-		t.Errorf("handler returned wrong content type: got %v want %v", ct, expected)
-	}
-
-	var resp GetVenuesResponse
-	err = json.NewDecoder(rr.Body).Decode(&resp)
-	if err != nil {
-		t.Errorf("failed to decode response: %v", err)
-	}
-
-	if len(resp.Venues) != 1 {
-		t.Errorf("expected 1 venue, got %d", len(resp.Venues))
-	}
-}
-
-// TestGetAllApprovedVenues_Empty tests getting approved venues when none exist
-func TestGetAllApprovedVenues_Empty(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	req, err := http.NewRequest("GET", "/venues/", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetAllApprovedVenues).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
-	}
-
-	var resp GetVenuesResponse
-	json.NewDecoder(rr.Body).Decode(&resp)
-
-	if len(resp.Venues) != 0 {
-		t.Errorf("expected 0 venues, got %d", len(resp.Venues))
-	}
-}
-
-// TestGetVenueByID_Handler_Success tests getting a venue by valid ID
-func TestGetVenueByID_Handler_Success(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	venue := &Venue{Name: "Test Arena", Address: "123 Test St", Lat: 40.5, Lng: -73.5, Status: VenueStatusApproved}
-	handler.service.repo.(*MockVenueRepository).Create(venue)
-
-	req, err := http.NewRequest("GET", "/venues/1", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	req = setVenueChiParam(req, "id", "1")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetVenueByID).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
-	}
-
-	var resp Venue
-	json.NewDecoder(rr.Body).Decode(&resp)
-
-	if resp.Name != "Test Arena" {
-		t.Errorf("expected Test Arena, got %s", resp.Name)
-	}
+func (s stubOccupancy) GetVenueOccupancy(ctx context.Context, venueID int64, from time.Time) ([]OccupiedSlot, error) {
+	return s.slots, nil
 }
 
-// TestGetVenueByID_Handler_InvalidID tests getting a venue with invalid ID format
-func TestGetVenueByID_Handler_InvalidID(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	req, err := http.NewRequest("GET", "/venues/invalid", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	req = setVenueChiParam(req, "id", "invalid")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetVenueByID).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusBadRequest {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
-	}
-}
-
-// TestGetVenueByID_Handler_NotFound tests getting a non-existent venue
-func TestGetVenueByID_Handler_NotFound(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	req, err := http.NewRequest("GET", "/venues/999", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	req = setVenueChiParam(req, "id", "999")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetVenueByID).ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusNotFound {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
-	}
-}
-
-// TestCreateVenue_Success tests creating a venue with valid data
-func TestCreateVenue_Success(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	// Create a test user
-	handler.service.authRepo.(*MockAuthRepository).CreateUser("user_123", "test@example.com", "Test Org", auth.RoleUser)
-
-	req := CreateVenueRequest{
-		Name:      "New Arena",
-		Address:   "456 New St",
-		Lat:  40.7128,
-		Lng: -74.0060,
-	}
-	body := createVenueRequestBody(req)
-
-	httpReq, err := http.NewRequest("POST", "/venues/", body)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// Add user ID header
-	httpReq.Header.Set("X-Clerk-User-ID", "user_123")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.CreateVenue).ServeHTTP(rr, httpReq)
-
-	if status := rr.Code; status != http.StatusCreated {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusCreated)
-	}
-
-	var resp CreateVenueResponse
-	json.NewDecoder(rr.Body).Decode(&resp)
-
-	if resp.Venue.Name != "New Arena" {
-		t.Errorf("expected New Arena, got %s", resp.Venue.Name)
-	}
-
-	if resp.Venue.Status != VenueStatusPending {
-		t.Errorf("expected status pending, got %s", resp.Venue.Status)
-	}
-}
-
-// TestCreateVenue_Handler_AdminAutoApproves tests that admin venues are auto-approved
-func TestCreateVenue_Handler_AdminAutoApproves(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	// Create admin user
-	handler.service.authRepo.(*MockAuthRepository).CreateUser("admin_123", "admin@example.com", "Admin Org", auth.RoleAdmin)
-
-	req := CreateVenueRequest{
-		Name:      "Admin Arena",
-		Address:   "789 Admin St",
-		Lat:  40.7580,
-		Lng: -73.9855,
-	}
-	body := createVenueRequestBody(req)
-
-	httpReq, err := http.NewRequest("POST", "/venues/", body)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	httpReq.Header.Set("X-Clerk-User-ID", "admin_123")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.CreateVenue).ServeHTTP(rr, httpReq)
-
-	if status := rr.Code; status != http.StatusCreated {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusCreated)
-	}
-
-	var resp CreateVenueResponse
-	json.NewDecoder(rr.Body).Decode(&resp)
-
-	if resp.Venue.Status != VenueStatusApproved {
-		t.Errorf("expected status approved, got %s", resp.Venue.Status)
-	}
+// newTestRouter serves the public venue routes backed by an in-memory repository
+func newTestRouter(seed ...Venue) (*Handler, http.Handler) {
+	handler := NewHandler(newTestService(seed...))
+	r := chi.NewRouter()
+	handler.RegisterRoutes(r)
+	return handler, r
 }
 
-// TestCreateVenue_NoUserID tests creating a venue without authorization
-func TestCreateVenue_NoUserID(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	req := CreateVenueRequest{
-		Name:      "Unauthorized Venue",
-		Address:   "999 No Auth St",
-		Lat:  40.0,
-		Lng: -73.0,
-	}
-	body := createVenueRequestBody(req)
-
-	httpReq, err := http.NewRequest("POST", "/venues/", body)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	// No user ID header
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.CreateVenue).ServeHTTP(rr, httpReq)
-
-	if status := rr.Code; status != http.StatusUnauthorized {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusUnauthorized)
-	}
+func createRequestBody(v interface{}) *bytes.Buffer {
+	body := new(bytes.Buffer)
+	json.NewEncoder(body).Encode(v)
+	return body
 }
 
-// TestCreateVenue_InvalidBody tests creating a venue with invalid JSON
-func TestCreateVenue_InvalidBody(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	handler.service.authRepo.(*MockAuthRepository).CreateUser("user_123", "test@example.com", "Test Org", auth.RoleUser)
-
-	httpReq, err := http.NewRequest("POST", "/venues/", bytes.NewBufferString("invalid json"))
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	httpReq.Header.Set("X-Clerk-User-ID", "user_123")
-
+func serve(router http.Handler, method, target string) *httptest.ResponseRecorder {
 	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.CreateVenue).ServeHTTP(rr, httpReq)
-
-	if status := rr.Code; status != http.StatusBadRequest {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
-	}
+	router.ServeHTTP(rr, httptest.NewRequest(method, target, nil))
+	return rr
 }
 
-// TestCreateVenue_MissingName tests creating a venue without name
-func TestCreateVenue_MissingName(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	handler.service.authRepo.(*MockAuthRepository).CreateUser("user_123", "test@example.com", "Test Org", auth.RoleUser)
+func TestGetAllVenues_Handler(t *testing.T) {
+	_, router := newTestRouter(greenLake, magnuson)
 
-	req := CreateVenueRequest{
-		Name:      "",
-		Address:   "123 Test St",
-		Lat:  40.0,
-		Lng: -73.0,
+	rr := serve(router, http.MethodGet, "/venues/")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rr.Code)
 	}
-	body := createVenueRequestBody(req)
-
-	httpReq, err := http.NewRequest("POST", "/venues/", body)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	httpReq.Header.Set("X-Clerk-User-ID", "user_123")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.CreateVenue).ServeHTTP(rr, httpReq)
-
-	if status := rr.Code; status != http.StatusBadRequest {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+	var response GetVenuesResponse
+	json.NewDecoder(rr.Body).Decode(&response)
+	if len(response.Venues) != 2 {
+		t.Errorf("expected 2 venues, got %+v", response.Venues)
 	}
 }
 
-// TestCreateVenue_Handler_StoresCoordinates tests that coordinates are properly stored
-func TestCreateVenue_Handler_StoresCoordinates(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	handler.service.authRepo.(*MockAuthRepository).CreateUser("user_123", "test@example.com", "Test Org", auth.RoleUser)
-
-	req := CreateVenueRequest{
-		Name:      "Coordinate Test Venue",
-		Address:   "555 Coord Ave",
-		Lat:  37.7749,
-		Lng: -122.4194,
-	}
-	body := createVenueRequestBody(req)
-
-	httpReq, err := http.NewRequest("POST", "/venues/", body)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	httpReq.Header.Set("X-Clerk-User-ID", "user_123")
+func TestGetVenueByID_Handler(t *testing.T) {
+	_, router := newTestRouter(greenLake)
 
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.CreateVenue).ServeHTTP(rr, httpReq)
-
-	if status := rr.Code; status != http.StatusCreated {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusCreated)
+	if rr := serve(router, http.MethodGet, "/venues/1"); rr.Code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", rr.Code)
 	}
-
-	var resp CreateVenueResponse
-	json.NewDecoder(rr.Body).Decode(&resp)
-
-	if resp.Venue.Lat != 37.7749 {
-		t.Errorf("expected lat 37.7749, got %f", resp.Venue.Lat)
+	if rr := serve(router, http.MethodGet, "/venues/abc"); rr.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400 for a malformed ID, got %d", rr.Code)
 	}
-
-	if resp.Venue.Lng != -122.4194 {
-		t.Errorf("expected lng -122.4194, got %f", resp.Venue.Lng)
+	if rr := serve(router, http.MethodGet, "/venues/99"); rr.Code != http.StatusNotFound {
+		t.Errorf("expected status 404 for an unknown ID, got %d", rr.Code)
 	}
 }
 
-// TestApproveVenue_Handler_Success tests approving a venue
-func TestApproveVenue_Handler_Success(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	// Create a pending venue
-	venue := &Venue{Name: "Pending Venue", Address: "123 Pending St", Lat: 40.0, Lng: -73.0, Status: VenueStatusPending}
-	handler.service.repo.(*MockVenueRepository).Create(venue)
-
-	httpReq, err := http.NewRequest("PUT", "/venues/1/approve", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	httpReq = setVenueChiParam(httpReq, "id", "1")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.ApproveVenue).ServeHTTP(rr, httpReq)
+func TestGetNearbyVenues_Handler(t *testing.T) {
+	_, router := newTestRouter(greenLake, magnuson, portland)
 
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+	rr := serve(router, http.MethodGet, "/venues/nearby?near=47.68,-122.33&radius_km=25")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
 	}
-
-	var resp map[string]string
-	json.NewDecoder(rr.Body).Decode(&resp)
-
-	if resp["status"] != "approved" {
-		t.Errorf("expected status approved, got %s", resp["status"])
+	var response GetNearbyVenuesResponse
+	json.NewDecoder(rr.Body).Decode(&response)
+	if len(response.Venues) != 2 || response.RadiusKm != 25 {
+		t.Errorf("expected 2 venues within 25km, got %+v", response)
 	}
 }
 
-// TestApproveVenue_InvalidID tests approving with invalid venue ID
-func TestApproveVenue_InvalidID(t *testing.T) {
-	handler := createTestVenueHandler()
+func TestGetNearbyVenues_Handler_InvalidParams(t *testing.T) {
+	_, router := newTestRouter()
 
-	httpReq, err := http.NewRequest("PUT", "/venues/invalid/approve", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	httpReq = setVenueChiParam(httpReq, "id", "invalid")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.ApproveVenue).ServeHTTP(rr, httpReq)
-
-	if status := rr.Code; status != http.StatusBadRequest {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+	for _, target := range []string{"/venues/nearby", "/venues/nearby?near=north", "/venues/nearby?near=47.68,-122.33&radius_km=0"} {
+		if rr := serve(router, http.MethodGet, target); rr.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", target, rr.Code)
+		}
 	}
 }
 
-// TestRejectVenue_Handler_Success tests rejecting a venue
-func TestRejectVenue_Handler_Success(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	// Create a pending venue
-	venue := &Venue{Name: "Pending Venue", Address: "123 Pending St", Lat: 40.0, Lng: -73.0, Status: VenueStatusPending}
-	handler.service.repo.(*MockVenueRepository).Create(venue)
+func TestGetVenueOccupancy_Handler(t *testing.T) {
+	handler, router := newTestRouter(greenLake)
 
-	req := RejectVenueRequest{RejectionReason: "Duplicate venue"}
-	body := createVenueRequestBody(req)
-
-	httpReq, err := http.NewRequest("PUT", "/venues/1/reject", body)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	httpReq = setVenueChiParam(httpReq, "id", "1")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.RejectVenue).ServeHTTP(rr, httpReq)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+	if rr := serve(router, http.MethodGet, "/venues/1/occupancy"); rr.Code != http.StatusNotImplemented {
+		t.Errorf("expected status 501 without an occupancy provider, got %d", rr.Code)
 	}
 
-	var resp map[string]string
-	json.NewDecoder(rr.Body).Decode(&resp)
-
-	if resp["status"] != "rejected" {
-		t.Errorf("expected status rejected, got %s", resp["status"])
-	}
-}
-
-// TestRejectVenue_Handler_InvalidBody tests rejecting with invalid body
-func TestRejectVenue_Handler_InvalidBody(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	venue := &Venue{Name: "Pending Venue", Address: "123 Pending St", Lat: 40.0, Lng: -73.0, Status: VenueStatusPending}
-	handler.service.repo.(*MockVenueRepository).Create(venue)
-
-	httpReq, err := http.NewRequest("PUT", "/venues/1/reject", bytes.NewBufferString("invalid json"))
-	if err != nil {
-		t.Fatal(err)
+	handler.SetOccupancyProvider(stubOccupancy{slots: []OccupiedSlot{{Day: "Monday", StartTime: "19:00", EndTime: "21:00", LeagueID: "a"}}})
+	rr := serve(router, http.MethodGet, "/venues/1/occupancy?from=2025-01-06")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rr.Code)
 	}
-
-	httpReq = setVenueChiParam(httpReq, "id", "1")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.RejectVenue).ServeHTTP(rr, httpReq)
-
-	if status := rr.Code; status != http.StatusBadRequest {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+	var response GetVenueOccupancyResponse
+	json.NewDecoder(rr.Body).Decode(&response)
+	if response.From != "2025-01-06" || len(response.Slots) != 1 || response.Venue.ID != greenLake.ID {
+		t.Errorf("unexpected occupancy response %+v", response)
 	}
 }
 
-// TestRejectVenue_Handler_EmptyReason tests rejecting with empty reason
-func TestRejectVenue_Handler_EmptyReason(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	venue := &Venue{Name: "Pending Venue", Address: "123 Pending St", Lat: 40.0, Lng: -73.0, Status: VenueStatusPending}
-	handler.service.repo.(*MockVenueRepository).Create(venue)
+func TestCheckVenueExists_Handler(t *testing.T) {
+	_, router := newTestRouter(greenLake)
 
-	req := RejectVenueRequest{RejectionReason: ""}
-	body := createVenueRequestBody(req)
-
-	httpReq, err := http.NewRequest("PUT", "/venues/1/reject", body)
-	if err != nil {
-		t.Fatal(err)
+	rr := serve(router, http.MethodGet, "/venues/exists?address=7201+E+Green+Lake+Dr+N,+Seattle")
+	var response CheckVenueExistsResponse
+	json.NewDecoder(rr.Body).Decode(&response)
+	if rr.Code != http.StatusOK || !response.Exists {
+		t.Errorf("expected the venue to exist, got %d %+v", rr.Code, response)
 	}
 
-	httpReq = setVenueChiParam(httpReq, "id", "1")
-
-	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.RejectVenue).ServeHTTP(rr, httpReq)
-
-	if status := rr.Code; status != http.StatusBadRequest {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+	if rr := serve(router, http.MethodGet, "/venues/exists"); rr.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400 without an address, got %d", rr.Code)
 	}
 }
 
-// TestGetAllVenues_Admin tests admin endpoint for getting all venues
-func TestGetAllVenues_Admin(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	// Create venues with different statuses
-	venue1 := &Venue{Name: "Approved Venue", Address: "123 Main St", Lat: 40.0, Lng: -73.0, Status: VenueStatusApproved}
-	venue2 := &Venue{Name: "Pending Venue", Address: "456 Pending St", Lat: 40.5, Lng: -73.5, Status: VenueStatusPending}
-	handler.service.repo.(*MockVenueRepository).Create(venue1)
-	handler.service.repo.(*MockVenueRepository).Create(venue2)
-
-	req, err := http.NewRequest("GET", "/venues/admin/all", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
+func TestCreateVenue_Handler_Success(t *testing.T) {
+	handler, _ := newTestRouter()
 
 	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetAllVenues).ServeHTTP(rr, req)
+	body := createRequestBody(CreateVenueRequest{Name: "Magnuson Park", Address: magnuson.Address, Lat: magnuson.Lat, Lng: magnuson.Lng})
+	handler.CreateVenue(rr, httptest.NewRequest(http.MethodPost, "/venues", body))
 
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d", rr.Code)
 	}
-
-	var resp GetVenuesResponse
-	json.NewDecoder(rr.Body).Decode(&resp)
-
-	if len(resp.Venues) != 2 {
-		t.Errorf("expected 2 venues, got %d", len(resp.Venues))
+	var response CreateVenueResponse
+	json.NewDecoder(rr.Body).Decode(&response)
+	if response.Venue.ID == 0 || response.Venue.Name != "Magnuson Park" {
+		t.Errorf("expected the created venue, got %+v", response.Venue)
 	}
 }
 
-// TestGetPendingVenues_Admin tests admin endpoint for getting pending venues
-func TestGetPendingVenues_Admin(t *testing.T) {
-	handler := createTestVenueHandler()
-
-	// Create venues with different statuses
-	venue1 := &Venue{Name: "Approved Venue", Address: "123 Main St", Lat: 40.0, Lng: -73.0, Status: VenueStatusApproved}
-	venue2 := &Venue{Name: "Pending Venue", Address: "456 Pending St", Lat: 40.5, Lng: -73.5, Status: VenueStatusPending}
-	handler.service.repo.(*MockVenueRepository).Create(venue1)
-	handler.service.repo.(*MockVenueRepository).Create(venue2)
-
-	req, err := http.NewRequest("GET", "/venues/admin/pending", nil)
-	if err != nil {
-		t.Fatal(err)
-	}
+func TestCreateVenue_Handler_Validation(t *testing.T) {
+	handler, _ := newTestRouter()
 
 	rr := httptest.NewRecorder()
-	http.HandlerFunc(handler.GetPendingVenues).ServeHTTP(rr, req)
+	handler.CreateVenue(rr, httptest.NewRequest(http.MethodPost, "/venues", createRequestBody(CreateVenueRequest{Name: "No Address"})))
 
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rr.Code)
 	}
-
-	var resp GetVenuesResponse
-	json.NewDecoder(rr.Body).Decode(&resp)
-
-	if len(resp.Venues) != 1 {
-		t.Errorf("expected 1 pending venue, got %d", len(resp.Venues))
-	}
-
-	if resp.Venues[0].Status != VenueStatusPending {
-		t.Errorf("expected pending status, got %s", resp.Venues[0].Status)
+	var problem shared.Problem
+	json.NewDecoder(rr.Body).Decode(&problem)
+	if problem.Code != shared.CodeValidation {
+		t.Errorf("expected a validation problem, got %+v", problem)
 	}
 }
@@ -0,0 +1,44 @@
+package venues
+
+import "testing"
+
+func TestFindVenueByAddress(t *testing.T) {
+	venues := []Venue{
+		{ID: 1, Name: "Green Lake Park", Address: "7201 E Green Lake Dr N, Seattle, WA"},
+		{ID: 2, Name: "Magnuson Park", Address: "7400 Sand Point Way NE, Seattle, WA"},
+	}
+
+	venue := findVenueByAddress(venues, "  7400 sand point way ne seattle wa ")
+	if venue == nil || venue.ID != 2 {
+		t.Errorf("expected normalized address to match venue 2, got %+v", venue)
+	}
+
+	if venue := findVenueByAddress(venues, "7400 Sand Point Way"); venue != nil {
+		t.Errorf("expected partial address not to match, got %+v", venue)
+	}
+	if venue := findVenueByAddress(venues, "%"); venue != nil {
+		t.Errorf("expected wildcard-only input not to match, got %+v", venue)
+	}
+}
+
+func TestRankSimilarVenues(t *testing.T) {
+	venues := []Venue{
+		{ID: 1, Name: "Green Lake Park", Address: "7201 E Green Lake Dr N"},
+		{ID: 2, Name: "Magnuson Park", Address: "7400 Sand Point Way NE"},
+		{ID: 3, Name: "Greenwood Gym", Address: "100 Greenwood Ave"},
+	}
+
+	similar := rankSimilarVenues(venues, "Grean Lake", 5)
+	if len(similar) != 1 || similar[0].ID != 1 {
+		t.Errorf("expected typo to suggest Green Lake Park, got %+v", similar)
+	}
+
+	similar = rankSimilarVenues(venues, "park", 1)
+	if len(similar) != 1 {
+		t.Errorf("expected suggestions to be capped at 1, got %d", len(similar))
+	}
+
+	if similar := rankSimilarVenues(venues, "stadium", 5); similar == nil || len(similar) != 0 {
+		t.Errorf("expected empty non-nil slice, got %v", similar)
+	}
+}
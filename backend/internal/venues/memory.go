@@ -0,0 +1,89 @@
+package venues
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+// MemoryRepository is a thread-safe in-memory RepositoryInterface used in tests and local development
+type MemoryRepository struct {
+	mu     sync.RWMutex
+	venues []Venue
+	nextID int64
+}
+
+// NewMemoryRepository creates an in-memory repository holding the given venues
+// Venues created later are numbered after the highest seeded ID
+func NewMemoryRepository(seed ...Venue) *MemoryRepository {
+	m := &MemoryRepository{nextID: 1}
+	for _, venue := range seed {
+		m.venues = append(m.venues, venue)
+		m.nextID = max(m.nextID, venue.ID+1)
+	}
+	return m
+}
+
+// GetAll retrieves all venues in ID order
+func (m *MemoryRepository) GetAll(ctx context.Context) ([]Venue, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	venues := make([]Venue, len(m.venues))
+	copy(venues, m.venues)
+	return venues, nil
+}
+
+// GetByID retrieves a venue by ID
+func (m *MemoryRepository) GetByID(ctx context.Context, id int) (*Venue, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, venue := range m.venues {
+		if venue.ID == int64(id) {
+			return &venue, nil
+		}
+	}
+	return nil, shared.NotFound("venue not found")
+}
+
+// GetByAddress retrieves a venue by address (case-insensitive), or nil when there is none
+func (m *MemoryRepository) GetByAddress(ctx context.Context, address string) (*Venue, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, venue := range m.venues {
+		if strings.EqualFold(venue.Address, address) {
+			return &venue, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetWithinBounds retrieves venues whose coordinates fall inside the box
+func (m *MemoryRepository) GetWithinBounds(ctx context.Context, box shared.BoundingBox) ([]Venue, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	venues := []Venue{}
+	for _, venue := range m.venues {
+		if venue.Lat >= box.MinLat && venue.Lat <= box.MaxLat && venue.Lng >= box.MinLng && venue.Lng <= box.MaxLng {
+			venues = append(venues, venue)
+		}
+	}
+	return venues, nil
+}
+
+// Create stores a new venue with the next ID
+func (m *MemoryRepository) Create(ctx context.Context, venue *Venue) (*Venue, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	venue.ID = m.nextID
+	venue.DistanceKm = nil
+	m.nextID++
+	m.venues = append(m.venues, *venue)
+	return venue, nil
+}
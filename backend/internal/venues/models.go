@@ -7,6 +7,8 @@ type Venue struct {
 	Address string  `json:"address"`
 	Lat     float64 `json:"lat"`
 	Lng     float64 `json:"lng"`
+
+	DistanceKm *float64 `json:"distance_km,omitempty"` // Set by proximity searches, not stored
 }
 
 // CreateVenueRequest represents the request to create/submit a new venue
@@ -27,6 +29,12 @@ type GetVenuesResponse struct {
 	Venues []Venue `json:"venues"`
 }
 
+// GetNearbyVenuesResponse represents the response when searching venues by location
+type GetNearbyVenuesResponse struct {
+	Venues   []Venue `json:"venues"`
+	RadiusKm float64 `json:"radius_km"`
+}
+
 // ErrorResponse represents an error response
 type ErrorResponse struct {
 	Error string `json:"error"`
@@ -34,5 +42,27 @@ type ErrorResponse struct {
 
 // CheckVenueExistsResponse represents the response when checking if a venue exists
 type CheckVenueExistsResponse struct {
-	Exists bool `json:"exists"`
+	Exists      bool    `json:"exists"`
+	Venue       *Venue  `json:"venue,omitempty"`       // The matching venue when it exists
+	Suggestions []Venue `json:"suggestions,omitempty"` // Close matches when it doesn't (e.g. typos)
+}
+
+// OccupiedSlot is a weekly time slot booked at a venue by an approved league
+type OccupiedSlot struct {
+	Day             string   `json:"day"`
+	StartTime       string   `json:"start_time"`
+	EndTime         string   `json:"end_time"`
+	LeagueID        string   `json:"league_id"`
+	LeagueName      string   `json:"league_name"`
+	OrgID           string   `json:"org_id"`
+	SeasonStartDate string   `json:"season_start_date"` // Date of the league's first game
+	SeasonEndDate   string   `json:"season_end_date"`   // Date of the league's last game
+	Conflicts       []string `json:"conflicts"`         // IDs of other leagues booked at the same time in the same weeks
+}
+
+// GetVenueOccupancyResponse represents the response when getting the booked slots of a venue
+type GetVenueOccupancyResponse struct {
+	Venue Venue          `json:"venue"`
+	From  string         `json:"from"` // Seasons that ended before this date are left out
+	Slots []OccupiedSlot `json:"slots"`
 }
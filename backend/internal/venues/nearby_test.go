@@ -0,0 +1,62 @@
+package venues
+
+import (
+	"testing"
+
+	"github.com/leaguefindr/backend/internal/shared"
+)
+
+func TestFilterByRadius(t *testing.T) {
+	center := shared.GeoPoint{Lat: 47.6062, Lng: -122.3321}
+	venues := []Venue{
+		{ID: 1, Name: "Far Field", Lat: 47.70, Lng: -122.33},         // ~10.4 km north
+		{ID: 2, Name: "Close Gym", Lat: 47.6100, Lng: -122.3300},     // ~0.5 km
+		{ID: 3, Name: "Portland Park", Lat: 45.5152, Lng: -122.6784}, // ~233 km
+		{ID: 4, Name: "Mid Court", Lat: 47.65, Lng: -122.35},         // ~5 km
+	}
+
+	nearby := filterByRadius(venues, center, 12)
+
+	if len(nearby) != 3 {
+		t.Fatalf("expected 3 venues within 12 km, got %d", len(nearby))
+	}
+
+	wantOrder := []int64{2, 4, 1}
+	for i, id := range wantOrder {
+		if nearby[i].ID != id {
+			t.Errorf("position %d: expected venue %d, got %d", i, id, nearby[i].ID)
+		}
+		if nearby[i].DistanceKm == nil {
+			t.Errorf("venue %d: expected distance_km to be set", nearby[i].ID)
+		}
+	}
+
+	if venues[0].DistanceKm != nil {
+		t.Errorf("expected input venues to be left unchanged")
+	}
+}
+
+func TestFilterByRadiusNoMatches(t *testing.T) {
+	nearby := filterByRadius([]Venue{{ID: 1, Lat: 10, Lng: 10}}, shared.GeoPoint{}, 5)
+	if nearby == nil || len(nearby) != 0 {
+		t.Errorf("expected empty non-nil slice, got %v", nearby)
+	}
+}
+
+func TestParseRadiusKm(t *testing.T) {
+	radius, err := ParseRadiusKm("")
+	if err != nil || radius != DefaultNearbyRadiusKm {
+		t.Errorf("expected default radius, got %v (err %v)", radius, err)
+	}
+
+	radius, err = ParseRadiusKm("2.5")
+	if err != nil || radius != 2.5 {
+		t.Errorf("expected 2.5, got %v (err %v)", radius, err)
+	}
+
+	for _, input := range []string{"0", "-1", "abc", "NaN", "1000"} {
+		if _, err := ParseRadiusKm(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"strconv"
 
+	"github.com/leaguefindr/backend/internal/shared"
 	"github.com/supabase-community/postgrest-go"
 )
 
@@ -14,6 +15,7 @@ type RepositoryInterface interface {
 	GetAll(ctx context.Context) ([]Venue, error)
 	GetByID(ctx context.Context, id int) (*Venue, error)
 	GetByAddress(ctx context.Context, address string) (*Venue, error)
+	GetWithinBounds(ctx context.Context, box shared.BoundingBox) ([]Venue, error)
 	Create(ctx context.Context, venue *Venue) (*Venue, error)
 }
 
@@ -52,7 +54,7 @@ func (r *Repository) GetByID(ctx context.Context, id int) (*Venue, error) {
 		ExecuteToWithContext(ctx, &venues)
 
 	if err != nil || len(venues) == 0 {
-		return nil, fmt.Errorf("venue not found")
+		return nil, shared.NotFound("venue not found").Wrap(err)
 	}
 
 	return &venues[0], nil
@@ -73,6 +75,29 @@ func (r *Repository) GetByAddress(ctx context.Context, address string) (*Venue,
 	return &venues[0], nil
 }
 
+// GetWithinBounds retrieves venues whose coordinates fall inside the box
+// Venues without coordinates are never returned
+func (r *Repository) GetWithinBounds(ctx context.Context, box shared.BoundingBox) ([]Venue, error) {
+	var venues []Venue
+	_, err := r.client.From("venues").
+		Select("*", "", false).
+		Gte("lat", strconv.FormatFloat(box.MinLat, 'f', -1, 64)).
+		Lte("lat", strconv.FormatFloat(box.MaxLat, 'f', -1, 64)).
+		Gte("lng", strconv.FormatFloat(box.MinLng, 'f', -1, 64)).
+		Lte("lng", strconv.FormatFloat(box.MaxLng, 'f', -1, 64)).
+		ExecuteToWithContext(ctx, &venues)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query venues: %w", err)
+	}
+
+	if venues == nil {
+		venues = []Venue{}
+	}
+
+	return venues, nil
+}
+
 // Create creates a new venue in the database
 func (r *Repository) Create(ctx context.Context, venue *Venue) (*Venue, error) {
 	insertData := map[string]interface{}{
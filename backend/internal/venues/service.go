@@ -3,22 +3,47 @@ package venues
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/leaguefindr/backend/internal/shared"
 	"github.com/supabase-community/postgrest-go"
 )
 
+const (
+	// DefaultNearbyRadiusKm is used when a proximity search doesn't specify a radius
+	DefaultNearbyRadiusKm = 10.0
+	// MaxNearbyRadiusKm caps proximity searches so they stay local
+	MaxNearbyRadiusKm = 250.0
+	// maxVenueSuggestions caps the similar venues returned when an address doesn't match exactly
+	maxVenueSuggestions = 5
+)
+
 type Service struct {
 	baseClient *postgrest.Client
 	baseURL    string
 	anonKey    string
+	repository func(ctx context.Context) RepositoryInterface // Repository for the caller of a request
 }
 
 func NewService(baseClient *postgrest.Client, baseURL string, anonKey string) *Service {
-	return &Service{
+	s := &Service{
 		baseClient: baseClient,
 		baseURL:    baseURL,
 		anonKey:    anonKey,
 	}
+	s.repository = func(ctx context.Context) RepositoryInterface {
+		return NewRepository(s.getClientWithAuth(ctx))
+	}
+	return s
+}
+
+// NewServiceWithRepository creates a service that keeps its venues in repo instead of Supabase
+func NewServiceWithRepository(repo RepositoryInterface) *Service {
+	return &Service{
+		repository: func(ctx context.Context) RepositoryInterface {
+			return repo
+		},
+	}
 }
 
 // getClientWithAuth creates a new PostgREST client with JWT from context
@@ -50,39 +75,97 @@ func (s *Service) getClientWithAuth(ctx context.Context) *postgrest.Client {
 
 // GetAllVenues retrieves all venues
 func (s *Service) GetAllVenues(ctx context.Context) ([]Venue, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetAll(ctx)
 }
 
 // GetVenueByID retrieves a venue by ID
 func (s *Service) GetVenueByID(ctx context.Context, id int) (*Venue, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 	return repo.GetByID(ctx, id)
 }
 
+// GetVenuesNearby retrieves venues within radiusKm of center, nearest first
+// Each returned venue has DistanceKm set
+func (s *Service) GetVenuesNearby(ctx context.Context, center shared.GeoPoint, radiusKm float64) ([]Venue, error) {
+	repo := s.repository(ctx)
+
+	// Narrow the query with a bounding box, then compute exact distances
+	candidates, err := repo.GetWithinBounds(ctx, shared.BoundingBoxAround(center, radiusKm))
+	if err != nil {
+		return nil, err
+	}
+
+	return filterByRadius(candidates, center, radiusKm), nil
+}
+
+// GetVenuesInBounds retrieves venues inside the bounding box
+func (s *Service) GetVenuesInBounds(ctx context.Context, box shared.BoundingBox) ([]Venue, error) {
+	repo := s.repository(ctx)
+	return repo.GetWithinBounds(ctx, box)
+}
+
+// filterByRadius keeps venues within radiusKm of center, sets their distance and sorts them nearest first
+func filterByRadius(venues []Venue, center shared.GeoPoint, radiusKm float64) []Venue {
+	nearby := []Venue{}
+	for _, venue := range venues {
+		distance := shared.HaversineKm(center, shared.GeoPoint{Lat: venue.Lat, Lng: venue.Lng})
+		if distance > radiusKm {
+			continue
+		}
+		venue.DistanceKm = &distance
+		nearby = append(nearby, venue)
+	}
+
+	sort.SliceStable(nearby, func(i, j int) bool {
+		if *nearby[i].DistanceKm != *nearby[j].DistanceKm {
+			return *nearby[i].DistanceKm < *nearby[j].DistanceKm
+		}
+		return nearby[i].ID < nearby[j].ID
+	})
+
+	return nearby
+}
+
 // CheckVenueExists checks if a venue exists by address
+// Addresses are compared after normalizing case, punctuation and whitespace ("123 Main St." matches "123 main st")
 func (s *Service) CheckVenueExists(ctx context.Context, address string) (*Venue, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
-	return repo.GetByAddress(ctx, address)
+	repo := s.repository(ctx)
+
+	venues, err := repo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check venue existence: %w", err)
+	}
+
+	return findVenueByAddress(venues, address), nil
+}
+
+// FindSimilarVenues returns venues whose name or address closely match the query, best match first
+// Tolerates typos so "Grean Lake Park" suggests "Green Lake Park"
+func (s *Service) FindSimilarVenues(ctx context.Context, query string) ([]Venue, error) {
+	repo := s.repository(ctx)
+
+	venues, err := repo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find similar venues: %w", err)
+	}
+
+	return rankSimilarVenues(venues, query, maxVenueSuggestions), nil
 }
 
 // CreateVenue creates a new venue (auto-creates if doesn't exist)
 // Returns the existing venue if it already exists (by address), or the newly created one
 func (s *Service) CreateVenue(ctx context.Context, req *CreateVenueRequest) (*Venue, error) {
-	client := s.getClientWithAuth(ctx)
-	repo := NewRepository(client)
+	repo := s.repository(ctx)
 
 	// Check if venue with this address already exists
-	existingVenue, err := repo.GetByAddress(ctx, req.Address)
+	venues, err := repo.GetAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check venue existence: %w", err)
 	}
 
 	// If venue address already exists, return it
-	if existingVenue != nil {
+	if existingVenue := findVenueByAddress(venues, req.Address); existingVenue != nil {
 		return existingVenue, nil
 	}
 
@@ -101,3 +184,49 @@ func (s *Service) CreateVenue(ctx context.Context, req *CreateVenueRequest) (*Ve
 
 	return createdVenue, nil
 }
+
+// findVenueByAddress returns the venue whose normalized address equals the normalized input, or nil
+func findVenueByAddress(venues []Venue, address string) *Venue {
+	normalized := shared.NormalizeText(address)
+	if normalized == "" {
+		return nil
+	}
+
+	for i := range venues {
+		if shared.NormalizeText(venues[i].Address) == normalized {
+			return &venues[i]
+		}
+	}
+
+	return nil
+}
+
+// rankSimilarVenues returns up to limit venues fuzzily matching the query on name or address, best match first
+func rankSimilarVenues(venues []Venue, query string, limit int) []Venue {
+	type candidate struct {
+		venue Venue
+		score float64
+	}
+
+	var candidates []candidate
+	for _, venue := range venues {
+		score := max(shared.FuzzyMatchScore(query, venue.Name), shared.FuzzyMatchScore(query, venue.Address))
+		if score > 0 {
+			candidates = append(candidates, candidate{venue: venue, score: score})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].venue.ID < candidates[j].venue.ID
+	})
+
+	similar := []Venue{}
+	for i := 0; i < len(candidates) && i < limit; i++ {
+		similar = append(similar, candidates[i].venue)
+	}
+
+	return similar
+}
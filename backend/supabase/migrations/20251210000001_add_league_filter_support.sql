@@ -0,0 +1,40 @@
+-- Support faceted filtering on the public leagues listing
+-- Adds a computed column exposing the lowercase game days of a league so PostgREST
+-- can filter on them, plus indexes for the columns used by range filters
+
+-- ============================================================================
+-- COMPUTED COLUMN: league_game_days
+-- ============================================================================
+
+-- Game days live in form_data.game_occurrences (API submissions and CSV imports both
+-- populate it). Returned lowercase so filters are case-insensitive.
+DROP FUNCTION IF EXISTS league_game_days(leagues);
+CREATE FUNCTION league_game_days(leagues)
+RETURNS text[] AS $$
+  SELECT COALESCE(
+    ARRAY(
+      SELECT DISTINCT lower(trim(occurrence->>'day'))
+      FROM jsonb_array_elements(
+        CASE
+          WHEN jsonb_typeof($1.form_data->'game_occurrences') = 'array'
+          THEN $1.form_data->'game_occurrences'
+          ELSE '[]'::jsonb
+        END
+      ) AS occurrence
+      WHERE occurrence->>'day' IS NOT NULL
+    ),
+    ARRAY[]::text[]
+  );
+$$ LANGUAGE SQL IMMUTABLE;
+
+COMMENT ON FUNCTION league_game_days(leagues) IS 'Computed column: lowercase weekday names from form_data.game_occurrences, used for day-of-week filtering';
+
+-- ============================================================================
+-- INDEXES FOR FILTERED LISTINGS
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_leagues_venue_id ON leagues(venue_id);
+CREATE INDEX IF NOT EXISTS idx_leagues_registration_deadline ON leagues(registration_deadline);
+CREATE INDEX IF NOT EXISTS idx_leagues_season_start_date ON leagues(season_start_date);
+CREATE INDEX IF NOT EXISTS idx_leagues_pricing_per_player ON leagues(pricing_per_player);
+CREATE INDEX IF NOT EXISTS idx_leagues_gender ON leagues(gender);
@@ -0,0 +1,9 @@
+-- Support proximity and bounding-box searches on venues
+-- Radius searches pre-filter with a lat/lng bounding box before computing haversine distances in the API
+
+-- ============================================================================
+-- INDEXES FOR LOCATION SEARCH
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_venues_lat_lng ON venues(lat, lng)
+  WHERE lat IS NOT NULL AND lng IS NOT NULL;
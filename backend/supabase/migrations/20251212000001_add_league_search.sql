@@ -0,0 +1,98 @@
+-- Full-text search for the public leagues listing
+-- search_leagues() ranks approved leagues against a free-text query across league, organization,
+-- sport and venue fields, tolerating typos in query tokens
+--
+-- Scoring mirrors the in-memory searcher in internal/leagues/search.go and shared.TokenMatchScore:
+--   exact word 1.0, prefix 0.8, one typo 0.6, two typos 0.5 (tokens of 6+ characters only)
+--   tokens under 3 characters must match exactly or as a prefix
+--   field weights: league name 3, sport 2, organization 2, division 1.5, venue 1.5, season details 1
+--   every query token must match some field; the score is the sum of each token's best weighted match
+
+-- ============================================================================
+-- EXTENSIONS
+-- ============================================================================
+
+CREATE EXTENSION IF NOT EXISTS fuzzystrmatch;
+
+-- ============================================================================
+-- HELPER FUNCTIONS
+-- ============================================================================
+
+-- Splits text into lowercase words of letters and digits
+CREATE OR REPLACE FUNCTION search_tokens(input TEXT)
+RETURNS TEXT[] AS $$
+  SELECT COALESCE(array_agg(token), ARRAY[]::TEXT[])
+  FROM regexp_split_to_table(lower(COALESCE(input, '')), '[^[:alnum:]]+') AS token
+  WHERE token <> '';
+$$ LANGUAGE SQL IMMUTABLE;
+
+COMMENT ON FUNCTION search_tokens(TEXT) IS 'Splits text into lowercase alphanumeric words for league search';
+
+-- Scores how well one query token matches the best of the given words (0 = no match)
+CREATE OR REPLACE FUNCTION search_token_score(query_token TEXT, words TEXT[])
+RETURNS REAL AS $$
+  SELECT COALESCE(MAX(
+    CASE
+      WHEN word = query_token THEN 1.0
+      WHEN length(query_token) >= 2 AND starts_with(word, query_token) THEN 0.8
+      WHEN length(query_token) >= 3
+        AND levenshtein_less_equal(query_token, word, 1) = 1 THEN 0.6
+      WHEN length(query_token) >= 6
+        AND levenshtein_less_equal(query_token, word, 2) = 2 THEN 0.5
+      ELSE 0
+    END
+  ), 0)::REAL
+  FROM unnest(words) AS word;
+$$ LANGUAGE SQL IMMUTABLE;
+
+COMMENT ON FUNCTION search_token_score(TEXT, TEXT[]) IS 'Typo-tolerant match score of a single query token against a list of words';
+
+-- ============================================================================
+-- SEARCH FUNCTION
+-- ============================================================================
+
+CREATE OR REPLACE FUNCTION search_leagues(search_query TEXT, max_results INTEGER DEFAULT 100)
+RETURNS TABLE (league_id UUID, score REAL) AS $$
+  WITH query_tokens AS (
+    SELECT DISTINCT unnest(search_tokens(search_query)) AS token
+  ),
+  documents AS (
+    SELECT
+      l.id,
+      search_tokens(l.league_name) AS name_words,
+      search_tokens(COALESCE(s.name, l.form_data->>'sport_name')) AS sport_words,
+      search_tokens(o.org_name) AS org_words,
+      search_tokens(l.division) AS division_words,
+      search_tokens(concat_ws(' ',
+        COALESCE(v.name, l.form_data->>'venue_name'),
+        COALESCE(v.address, l.form_data->>'venue_address'))) AS venue_words,
+      search_tokens(l.season_details) AS details_words
+    FROM leagues l
+    LEFT JOIN organizations o ON o.id = l.org_id
+    LEFT JOIN sports s ON s.id = l.sport_id
+    LEFT JOIN venues v ON v.id = l.venue_id
+    WHERE l.status = 'approved'
+  ),
+  token_scores AS (
+    SELECT
+      d.id,
+      GREATEST(
+        3.0 * search_token_score(q.token, d.name_words),
+        2.0 * search_token_score(q.token, d.sport_words),
+        2.0 * search_token_score(q.token, d.org_words),
+        1.5 * search_token_score(q.token, d.division_words),
+        1.5 * search_token_score(q.token, d.venue_words),
+        1.0 * search_token_score(q.token, d.details_words)
+      ) AS token_score
+    FROM documents d
+    CROSS JOIN query_tokens q
+  )
+  SELECT id AS league_id, SUM(token_score)::REAL AS score
+  FROM token_scores
+  GROUP BY id
+  HAVING bool_and(token_score > 0)
+  ORDER BY score DESC, id
+  LIMIT GREATEST(COALESCE(max_results, 100), 0);
+$$ LANGUAGE SQL STABLE SECURITY INVOKER SET search_path = public;
+
+COMMENT ON FUNCTION search_leagues(TEXT, INTEGER) IS 'Ranks approved leagues against a free-text query with typo tolerance; used by GET /v1/leagues?q=';
@@ -0,0 +1,23 @@
+-- Support organizer edits of approved leagues
+-- Edits to an approved league that touch anything beyond low-risk fields are stored as a
+-- pending revision; the live columns keep serving the approved version until an admin approves it
+
+-- ============================================================================
+-- PENDING REVISION COLUMNS
+-- ============================================================================
+
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS pending_changes JSONB;
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS pending_changes_at TIMESTAMP;
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS pending_changes_by TEXT;
+
+COMMENT ON COLUMN leagues.pending_changes IS 'Proposed column values for an approved league awaiting re-review (same keys as the leagues columns, including form_data)';
+COMMENT ON COLUMN leagues.pending_changes_at IS 'When the pending revision was submitted';
+COMMENT ON COLUMN leagues.pending_changes_by IS 'Clerk user ID of the organizer who submitted the pending revision';
+
+-- ============================================================================
+-- INDEXES FOR THE REVIEW QUEUE
+-- ============================================================================
+
+-- The admin pending queue includes approved leagues with a pending revision
+CREATE INDEX IF NOT EXISTS idx_leagues_pending_changes ON leagues(pending_changes_at)
+  WHERE pending_changes IS NOT NULL;
@@ -0,0 +1,129 @@
+-- Immutable revision history for leagues
+-- Every create, edit, approval and rejection stores a full snapshot of the league so admins can
+-- see who changed what and diff any two versions
+
+-- ============================================================================
+-- LEAGUE_REVISIONS TABLE
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS league_revisions (
+  id BIGSERIAL PRIMARY KEY,
+  league_id UUID NOT NULL,
+  revision_number INT NOT NULL,                 -- 1, 2, 3... per league, assigned on insert
+  action TEXT NOT NULL,
+  status league_status,                         -- Moderation status of the league in this snapshot
+  snapshot JSONB NOT NULL,                      -- League columns (including form_data) at this revision
+  changed_fields TEXT[] NOT NULL DEFAULT '{}',
+  note TEXT,                                    -- Rejection reason, if any
+  created_by TEXT,                              -- Clerk user ID of the user who made the change
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT fk_league_revisions_league_id FOREIGN KEY (league_id) REFERENCES leagues(id) ON DELETE CASCADE,
+  CONSTRAINT unique_league_revision_number UNIQUE (league_id, revision_number),
+  CONSTRAINT league_revisions_action_check CHECK (action IN (
+    'created', 'edited', 'resubmitted', 'edit_submitted',
+    'approved', 'rejected', 'edit_approved', 'edit_rejected'
+  ))
+);
+
+CREATE INDEX IF NOT EXISTS idx_league_revisions_league_id ON league_revisions(league_id, revision_number);
+
+COMMENT ON TABLE league_revisions IS 'Append-only history of league changes. Rows cannot be updated; they are only removed with their league.';
+COMMENT ON COLUMN league_revisions.action IS 'created, edited, resubmitted, edit_submitted, approved, rejected, edit_approved or edit_rejected';
+COMMENT ON COLUMN league_revisions.snapshot IS 'For edit_submitted/edit_rejected, the proposed version rather than the live one';
+
+-- ============================================================================
+-- TRIGGERS: Revision numbering and immutability
+-- ============================================================================
+
+CREATE OR REPLACE FUNCTION assign_league_revision_number()
+RETURNS TRIGGER
+SECURITY INVOKER
+SET search_path = public
+AS $$
+BEGIN
+  SELECT COALESCE(MAX(revision_number), 0) + 1
+  INTO NEW.revision_number
+  FROM league_revisions
+  WHERE league_id = NEW.league_id;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION prevent_league_revision_update()
+RETURNS TRIGGER
+SECURITY INVOKER
+SET search_path = public
+AS $$
+BEGIN
+  RAISE EXCEPTION 'league revisions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_assign_league_revision_number ON league_revisions;
+CREATE TRIGGER trg_assign_league_revision_number
+BEFORE INSERT ON league_revisions
+FOR EACH ROW
+EXECUTE FUNCTION assign_league_revision_number();
+
+DROP TRIGGER IF EXISTS trg_prevent_league_revision_update ON league_revisions;
+CREATE TRIGGER trg_prevent_league_revision_update
+BEFORE UPDATE ON league_revisions
+FOR EACH ROW
+EXECUTE FUNCTION prevent_league_revision_update();
+
+-- ============================================================================
+-- RLS POLICIES
+-- ============================================================================
+
+ALTER TABLE league_revisions ENABLE ROW LEVEL SECURITY;
+
+-- SELECT: Admins and members of the league's organization
+CREATE POLICY "Admins and org members see league revisions"
+ON league_revisions FOR SELECT
+USING (
+  ((SELECT auth.jwt()))->>'appRole' = 'admin'
+  OR (((SELECT auth.jwt()))->>'sub')::text IN (
+    SELECT user_id FROM user_organizations
+    WHERE org_id = (SELECT org_id FROM leagues WHERE leagues.id = league_revisions.league_id)
+    AND is_active = true
+  )
+);
+
+-- INSERT: Anyone who can change the league records its revision
+CREATE POLICY "Admins and org members can record league revisions"
+ON league_revisions FOR INSERT
+WITH CHECK (
+  ((SELECT auth.jwt()))->>'appRole' = 'admin'
+  OR EXISTS (
+    SELECT 1 FROM leagues
+    WHERE leagues.id = league_revisions.league_id
+    AND (
+      leagues.created_by = (((SELECT auth.jwt()))->>'sub')::text
+      OR leagues.org_id IN (
+        SELECT org_id FROM user_organizations
+        WHERE user_id = (((SELECT auth.jwt()))->>'sub')::text
+        AND is_active = true
+      )
+    )
+  )
+);
+
+-- No UPDATE or DELETE policies: revisions are append-only
+
+-- ============================================================================
+-- BACKFILL: One revision for each existing league
+-- ============================================================================
+
+INSERT INTO league_revisions (league_id, revision_number, action, status, snapshot, created_by, created_at)
+SELECT
+  l.id,
+  1,
+  'created',
+  l.status,
+  to_jsonb(l) - 'pending_changes' - 'pending_changes_at' - 'pending_changes_by',
+  l.created_by,
+  l.created_at
+FROM leagues l
+WHERE NOT EXISTS (
+  SELECT 1 FROM league_revisions r WHERE r.league_id = l.id
+);
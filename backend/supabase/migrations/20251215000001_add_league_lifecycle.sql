@@ -0,0 +1,59 @@
+-- League lifecycle states
+-- Tracks where a league is in its season alongside the moderation status (pending/approved/rejected)
+-- Allowed transitions are enforced by the API (leagues.LifecycleStatus)
+
+-- ============================================================================
+-- ENUM TYPE
+-- ============================================================================
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'league_lifecycle_status') THEN
+    CREATE TYPE league_lifecycle_status AS ENUM (
+      'registration_open',
+      'registration_closed',
+      'full',
+      'in_season',
+      'completed',
+      'cancelled'
+    );
+  END IF;
+END $$;
+
+-- ============================================================================
+-- LIFECYCLE COLUMNS
+-- ============================================================================
+
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS lifecycle_status league_lifecycle_status NOT NULL DEFAULT 'registration_open';
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS lifecycle_updated_at TIMESTAMP;
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS cancellation_reason TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_leagues_lifecycle_status ON leagues(lifecycle_status);
+
+COMMENT ON COLUMN leagues.lifecycle_status IS 'registration_open, registration_closed, full, in_season, completed or cancelled. Completed and cancelled leagues are hidden from the public listing by default.';
+COMMENT ON COLUMN leagues.cancellation_reason IS 'Reason given by the organizer when cancelling a league';
+
+-- ============================================================================
+-- BACKFILL FROM SEASON DATES
+-- ============================================================================
+
+UPDATE leagues
+SET lifecycle_status = CASE
+    WHEN season_end_date IS NOT NULL AND season_end_date < CURRENT_DATE THEN 'completed'::league_lifecycle_status
+    WHEN season_start_date IS NOT NULL AND season_start_date <= CURRENT_DATE THEN 'in_season'::league_lifecycle_status
+    WHEN registration_deadline IS NOT NULL AND registration_deadline < CURRENT_DATE THEN 'registration_closed'::league_lifecycle_status
+    ELSE 'registration_open'::league_lifecycle_status
+  END,
+  lifecycle_updated_at = CURRENT_TIMESTAMP
+WHERE lifecycle_status = 'registration_open';
+
+-- ============================================================================
+-- REVISION HISTORY
+-- ============================================================================
+
+ALTER TABLE league_revisions DROP CONSTRAINT IF EXISTS league_revisions_action_check;
+ALTER TABLE league_revisions ADD CONSTRAINT league_revisions_action_check CHECK (action IN (
+  'created', 'edited', 'resubmitted', 'edit_submitted',
+  'approved', 'rejected', 'edit_approved', 'edit_rejected',
+  'lifecycle_changed'
+));
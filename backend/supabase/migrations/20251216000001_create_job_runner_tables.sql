@@ -0,0 +1,88 @@
+-- Background job runner support (cmd/worker)
+-- job_locks gives each job a lease so only one worker runs it per interval;
+-- job_runs keeps the history of every run
+
+-- ============================================================================
+-- JOB_LOCKS TABLE
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS job_locks (
+  job_name TEXT PRIMARY KEY,
+  owner TEXT NOT NULL,                          -- Worker ID holding the lease
+  locked_until TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE job_locks IS 'Job leases. A worker may run a job only while it holds an unexpired lease on it.';
+
+-- ============================================================================
+-- JOB_RUNS TABLE
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS job_runs (
+  id BIGSERIAL PRIMARY KEY,
+  job_name TEXT NOT NULL,
+  owner TEXT NOT NULL,                          -- Worker ID that ran the job
+  status TEXT NOT NULL,
+  started_at TIMESTAMPTZ NOT NULL,
+  finished_at TIMESTAMPTZ,
+  affected INT NOT NULL DEFAULT 0,              -- Rows changed by the run
+  message TEXT,
+  error TEXT,
+  CONSTRAINT job_runs_status_check CHECK (status IN ('succeeded', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_runs_job_name_started_at ON job_runs(job_name, started_at DESC);
+
+COMMENT ON TABLE job_runs IS 'History of background job runs';
+
+-- ============================================================================
+-- FUNCTIONS: Lease acquisition and release
+-- ============================================================================
+
+-- Takes or renews the lease on a job. Returns true if the caller now holds it.
+-- The caller passes its own clock so lease expiry follows the worker's (injectable) clock.
+CREATE OR REPLACE FUNCTION try_acquire_job_lock(lock_name TEXT, lock_owner TEXT, lock_now TIMESTAMPTZ, lock_until TIMESTAMPTZ)
+RETURNS BOOLEAN
+SECURITY INVOKER
+SET search_path = public
+AS $$
+DECLARE
+  acquired BOOLEAN;
+BEGIN
+  INSERT INTO job_locks (job_name, owner, locked_until, updated_at)
+  VALUES (lock_name, lock_owner, lock_until, CURRENT_TIMESTAMP)
+  ON CONFLICT (job_name) DO UPDATE
+    SET owner = EXCLUDED.owner,
+        locked_until = EXCLUDED.locked_until,
+        updated_at = CURRENT_TIMESTAMP
+    WHERE job_locks.locked_until <= lock_now
+       OR job_locks.owner = EXCLUDED.owner
+  RETURNING true INTO acquired;
+
+  RETURN COALESCE(acquired, false);
+END;
+$$ LANGUAGE plpgsql;
+
+-- Gives up a lease early (e.g. after a failed run, so the next tick retries)
+CREATE OR REPLACE FUNCTION release_job_lock(lock_name TEXT, lock_owner TEXT)
+RETURNS VOID
+SECURITY INVOKER
+SET search_path = public
+AS $$
+  DELETE FROM job_locks WHERE job_name = lock_name AND owner = lock_owner;
+$$ LANGUAGE SQL;
+
+-- ============================================================================
+-- RLS: Service role only
+-- ============================================================================
+
+ALTER TABLE job_locks ENABLE ROW LEVEL SECURITY;
+ALTER TABLE job_runs ENABLE ROW LEVEL SECURITY;
+
+-- Admins can read run history; the worker uses the service role, which bypasses RLS
+CREATE POLICY "Admins can view job runs"
+ON job_runs FOR SELECT
+USING (
+  ((SELECT auth.jwt()))->>'appRole' = 'admin'
+);
@@ -0,0 +1,24 @@
+-- Link seasons of the same league
+-- A league cloned into a new season points at the season it was cloned from (previous_season_id)
+-- and shares its series_id, so every season of a league can be fetched with one filter
+
+-- ============================================================================
+-- SEASON COLUMNS
+-- ============================================================================
+
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS previous_season_id UUID;
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS series_id UUID;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_leagues_previous_season_id') THEN
+    ALTER TABLE leagues
+      ADD CONSTRAINT fk_leagues_previous_season_id FOREIGN KEY (previous_season_id) REFERENCES leagues(id) ON DELETE SET NULL;
+  END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_leagues_previous_season_id ON leagues(previous_season_id);
+CREATE INDEX IF NOT EXISTS idx_leagues_series_id ON leagues(series_id);
+
+COMMENT ON COLUMN leagues.previous_season_id IS 'League this season was cloned from';
+COMMENT ON COLUMN leagues.series_id IS 'ID of the first season in the chain; shared by all seasons of the same league';
@@ -0,0 +1,126 @@
+-- Concrete league schedules
+-- Schedules are expanded from the weekly game_occurrences pattern at read time; these tables only hold what the
+-- pattern can't express: days off for one league (blackouts), days off for every league of an organization
+-- (holidays), and single games that were cancelled or moved
+
+-- ============================================================================
+-- LEAGUE_SCHEDULE_EXCEPTIONS TABLE
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS league_schedule_exceptions (
+  id BIGSERIAL PRIMARY KEY,
+  league_id UUID NOT NULL,
+  kind TEXT NOT NULL,
+  game_date DATE NOT NULL,                      -- Date the game was planned for (or the blacked out date)
+  start_time TEXT,                              -- HH:MM of the affected game; NULL for every game of the day
+  new_date DATE,                                -- Rescheduled games only
+  new_start_time TEXT,
+  new_end_time TEXT,
+  reason TEXT,
+  created_by TEXT NOT NULL,                     -- Clerk user ID
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT fk_league_schedule_exceptions_league_id FOREIGN KEY (league_id) REFERENCES leagues(id) ON DELETE CASCADE,
+  CONSTRAINT league_schedule_exceptions_kind_check CHECK (kind IN ('blackout', 'cancelled', 'rescheduled')),
+  CONSTRAINT league_schedule_exceptions_blackout_check CHECK (kind <> 'blackout' OR start_time IS NULL),
+  CONSTRAINT league_schedule_exceptions_reschedule_check CHECK (
+    kind <> 'rescheduled' OR (new_date IS NOT NULL AND new_start_time IS NOT NULL AND new_end_time IS NOT NULL)
+  )
+);
+
+-- One exception per game (or per day when start_time is NULL)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_league_schedule_exceptions_game
+ON league_schedule_exceptions(league_id, game_date, COALESCE(start_time, ''));
+
+COMMENT ON TABLE league_schedule_exceptions IS 'Blackout dates and single-game cancellations or reschedules of a league';
+COMMENT ON COLUMN league_schedule_exceptions.kind IS 'blackout (no games, does not count towards the duration), cancelled or rescheduled';
+
+-- ============================================================================
+-- ORGANIZATION_HOLIDAYS TABLE
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS organization_holidays (
+  id BIGSERIAL PRIMARY KEY,
+  org_id UUID NOT NULL,
+  holiday_date DATE NOT NULL,
+  name TEXT NOT NULL,
+  created_by TEXT,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT fk_organization_holidays_org_id FOREIGN KEY (org_id) REFERENCES organizations(id) ON DELETE CASCADE,
+  CONSTRAINT unique_organization_holiday_date UNIQUE (org_id, holiday_date)
+);
+
+COMMENT ON TABLE organization_holidays IS 'Dates on which none of the organization''s leagues play';
+
+-- ============================================================================
+-- RLS POLICIES
+-- ============================================================================
+
+ALTER TABLE league_schedule_exceptions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE organization_holidays ENABLE ROW LEVEL SECURITY;
+
+-- SELECT: Public, schedules are shown with public league listings and calendars
+CREATE POLICY "Anyone can view league schedule exceptions"
+ON league_schedule_exceptions FOR SELECT
+USING (true);
+
+-- INSERT/DELETE: Admins and members of the league's organization
+CREATE POLICY "Admins and org members can add league schedule exceptions"
+ON league_schedule_exceptions FOR INSERT
+WITH CHECK (
+  ((SELECT auth.jwt()))->>'appRole' = 'admin'
+  OR (((SELECT auth.jwt()))->>'sub')::text IN (
+    SELECT user_id FROM user_organizations
+    WHERE org_id = (SELECT org_id FROM leagues WHERE leagues.id = league_schedule_exceptions.league_id)
+    AND is_active = true
+  )
+);
+
+CREATE POLICY "Admins and org members can remove league schedule exceptions"
+ON league_schedule_exceptions FOR DELETE
+USING (
+  ((SELECT auth.jwt()))->>'appRole' = 'admin'
+  OR (((SELECT auth.jwt()))->>'sub')::text IN (
+    SELECT user_id FROM user_organizations
+    WHERE org_id = (SELECT org_id FROM leagues WHERE leagues.id = league_schedule_exceptions.league_id)
+    AND is_active = true
+  )
+);
+
+-- SELECT: Public, like league schedules
+CREATE POLICY "Anyone can view organization holidays"
+ON organization_holidays FOR SELECT
+USING (true);
+
+-- INSERT/UPDATE/DELETE: Admins and members of the organization (upserts need INSERT and UPDATE)
+CREATE POLICY "Admins and org members can add organization holidays"
+ON organization_holidays FOR INSERT
+WITH CHECK (
+  ((SELECT auth.jwt()))->>'appRole' = 'admin'
+  OR (((SELECT auth.jwt()))->>'sub')::text IN (
+    SELECT user_id FROM user_organizations
+    WHERE org_id = organization_holidays.org_id
+    AND is_active = true
+  )
+);
+
+CREATE POLICY "Admins and org members can update organization holidays"
+ON organization_holidays FOR UPDATE
+USING (
+  ((SELECT auth.jwt()))->>'appRole' = 'admin'
+  OR (((SELECT auth.jwt()))->>'sub')::text IN (
+    SELECT user_id FROM user_organizations
+    WHERE org_id = organization_holidays.org_id
+    AND is_active = true
+  )
+);
+
+CREATE POLICY "Admins and org members can remove organization holidays"
+ON organization_holidays FOR DELETE
+USING (
+  ((SELECT auth.jwt()))->>'appRole' = 'admin'
+  OR (((SELECT auth.jwt()))->>'sub')::text IN (
+    SELECT user_id FROM user_organizations
+    WHERE org_id = organization_holidays.org_id
+    AND is_active = true
+  )
+);
@@ -0,0 +1,57 @@
+-- Idempotency keys for create endpoints (Idempotency-Key header)
+-- A retried request with the same key replays the saved response instead of creating a duplicate
+
+-- ============================================================================
+-- IDEMPOTENCY_KEYS TABLE
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+  key TEXT PRIMARY KEY,                         -- Client key, scoped by user
+  fingerprint TEXT NOT NULL,                    -- Hash of the method, path and body of the first request
+  response_status INT,                          -- NULL while the first request is being handled
+  response_content_type TEXT,
+  response_body TEXT,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
+
+COMMENT ON TABLE idempotency_keys IS 'Idempotency keys and the responses saved for replay. Rows past expires_at are replaced on reuse and purged by the worker.';
+
+-- ============================================================================
+-- FUNCTIONS: Key reservation
+-- ============================================================================
+
+-- Claims a key for a request. Returns NULL if the caller now holds it,
+-- otherwise the unexpired row already holding it (as JSON).
+-- The caller passes its own clock so expiry follows the API's (injectable) clock.
+CREATE OR REPLACE FUNCTION reserve_idempotency_key(idem_key TEXT, idem_fingerprint TEXT, idem_now TIMESTAMPTZ, idem_until TIMESTAMPTZ)
+RETURNS JSONB
+SECURITY INVOKER
+SET search_path = public
+AS $$
+DECLARE
+  existing idempotency_keys;
+BEGIN
+  DELETE FROM idempotency_keys WHERE key = idem_key AND expires_at <= idem_now;
+
+  INSERT INTO idempotency_keys (key, fingerprint, expires_at)
+  VALUES (idem_key, idem_fingerprint, idem_until)
+  ON CONFLICT (key) DO NOTHING;
+
+  IF FOUND THEN
+    RETURN NULL;
+  END IF;
+
+  SELECT * INTO existing FROM idempotency_keys WHERE key = idem_key;
+  RETURN to_jsonb(existing);
+END;
+$$ LANGUAGE plpgsql;
+
+-- ============================================================================
+-- RLS: Service role only
+-- ============================================================================
+
+-- No policies: only the service role, which bypasses RLS, can use the table
+ALTER TABLE idempotency_keys ENABLE ROW LEVEL SECURITY;
@@ -0,0 +1,24 @@
+-- Duplicate league detection
+-- New submissions are scored against existing leagues; likely duplicates are stored on the submission
+-- (duplicate_candidates) for the admin review screen. Admins can reject a submission as a duplicate of
+-- another league, which is recorded in duplicate_of_id
+
+-- ============================================================================
+-- DUPLICATE COLUMNS
+-- ============================================================================
+
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS duplicate_candidates JSONB;
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS duplicate_of_id UUID;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_leagues_duplicate_of_id') THEN
+    ALTER TABLE leagues
+      ADD CONSTRAINT fk_leagues_duplicate_of_id FOREIGN KEY (duplicate_of_id) REFERENCES leagues(id) ON DELETE SET NULL;
+  END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_leagues_duplicate_of_id ON leagues(duplicate_of_id);
+
+COMMENT ON COLUMN leagues.duplicate_candidates IS 'Likely duplicates found when the league was submitted, with similarity scores';
+COMMENT ON COLUMN leagues.duplicate_of_id IS 'League this submission was rejected as a duplicate of';
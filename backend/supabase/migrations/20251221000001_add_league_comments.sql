@@ -0,0 +1,78 @@
+-- Changes-requested moderation outcome and review comments
+-- Instead of rejecting a submission outright, admins can send it back with a comment pointing at the
+-- fields to fix. Admins and organizers reply on the same thread; the organizer resubmits by editing
+-- the league, which puts it back in the pending queue
+
+-- ============================================================================
+-- LEAGUE STATUS
+-- ============================================================================
+
+ALTER TYPE league_status ADD VALUE IF NOT EXISTS 'changes_requested';
+
+-- ============================================================================
+-- LEAGUE_COMMENTS TABLE
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS league_comments (
+  id BIGSERIAL PRIMARY KEY,
+  league_id UUID NOT NULL,
+  author_id TEXT NOT NULL,                      -- Clerk user ID of the commenter
+  author_role TEXT NOT NULL,
+  body TEXT NOT NULL,
+  fields TEXT[] NOT NULL DEFAULT '{}',          -- League fields the comment points at
+  status league_status,                         -- Moderation outcome the comment came with, if any
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT fk_league_comments_league_id FOREIGN KEY (league_id) REFERENCES leagues(id) ON DELETE CASCADE,
+  CONSTRAINT league_comments_author_role_check CHECK (author_role IN ('admin', 'organizer'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_league_comments_league_id ON league_comments(league_id, id);
+
+COMMENT ON TABLE league_comments IS 'Review thread between admins and the organization that submitted a league';
+COMMENT ON COLUMN league_comments.status IS 'changes_requested when the comment sent the league back to its organizer';
+
+-- ============================================================================
+-- RLS POLICIES
+-- ============================================================================
+
+ALTER TABLE league_comments ENABLE ROW LEVEL SECURITY;
+
+-- SELECT: Admins and members of the league's organization
+CREATE POLICY "Admins and org members see league comments"
+ON league_comments FOR SELECT
+USING (
+  ((SELECT auth.jwt()))->>'appRole' = 'admin'
+  OR (((SELECT auth.jwt()))->>'sub')::text IN (
+    SELECT user_id FROM user_organizations
+    WHERE org_id = (SELECT org_id FROM leagues WHERE leagues.id = league_comments.league_id)
+    AND is_active = true
+  )
+);
+
+-- INSERT: Admins and members of the league's organization, as themselves
+CREATE POLICY "Admins and org members can comment on leagues"
+ON league_comments FOR INSERT
+WITH CHECK (
+  author_id = (((SELECT auth.jwt()))->>'sub')::text
+  AND (
+    ((SELECT auth.jwt()))->>'appRole' = 'admin'
+    OR (((SELECT auth.jwt()))->>'sub')::text IN (
+      SELECT user_id FROM user_organizations
+      WHERE org_id = (SELECT org_id FROM leagues WHERE leagues.id = league_comments.league_id)
+      AND is_active = true
+    )
+  )
+);
+
+-- No UPDATE or DELETE policies: the thread is append-only
+
+-- ============================================================================
+-- REVISION HISTORY
+-- ============================================================================
+
+ALTER TABLE league_revisions DROP CONSTRAINT IF EXISTS league_revisions_action_check;
+ALTER TABLE league_revisions ADD CONSTRAINT league_revisions_action_check CHECK (action IN (
+  'created', 'edited', 'resubmitted', 'edit_submitted',
+  'approved', 'rejected', 'edit_approved', 'edit_rejected',
+  'lifecycle_changed', 'changes_requested'
+));
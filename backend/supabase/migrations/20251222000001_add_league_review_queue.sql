@@ -0,0 +1,42 @@
+-- Moderation queue claims and review SLA
+-- An admin claims a league while reviewing it so others skip it; the claim expires on its own if the
+-- admin walks away. submitted_at records when the league last entered the queue, which drives the
+-- queue age filter, the backlog metrics and the SLA escalation job (review_escalated_at stops the job
+-- notifying admins about the same wait twice)
+
+-- ============================================================================
+-- CLAIM COLUMNS
+-- ============================================================================
+
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS claimed_by TEXT;
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS claim_expires_at TIMESTAMP;
+
+COMMENT ON COLUMN leagues.claimed_by IS 'Clerk user ID of the admin reviewing the league; ignored once claim_expires_at has passed';
+COMMENT ON COLUMN leagues.claim_expires_at IS 'When the review claim lapses';
+
+CREATE INDEX IF NOT EXISTS idx_leagues_claimed_by ON leagues(claimed_by)
+WHERE claimed_by IS NOT NULL;
+
+-- ============================================================================
+-- QUEUE AGE COLUMNS
+-- ============================================================================
+
+-- Existing leagues entered the queue when they were created, or when their pending edit was submitted
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM information_schema.columns
+    WHERE table_name = 'leagues' AND column_name = 'submitted_at'
+  ) THEN
+    ALTER TABLE leagues ADD COLUMN submitted_at TIMESTAMP;
+    UPDATE leagues SET submitted_at = COALESCE(pending_changes_at, created_at);
+    ALTER TABLE leagues ALTER COLUMN submitted_at SET DEFAULT CURRENT_TIMESTAMP;
+  END IF;
+END $$;
+
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS review_escalated_at TIMESTAMP;
+
+COMMENT ON COLUMN leagues.submitted_at IS 'When the league last entered the review queue';
+COMMENT ON COLUMN leagues.review_escalated_at IS 'When admins were told the league had waited past the review SLA; cleared when it re-enters the queue';
+
+CREATE INDEX IF NOT EXISTS idx_leagues_submitted_at ON leagues(submitted_at);
@@ -0,0 +1,56 @@
+-- Auto-approval policy for league submissions
+-- Admins verify organizations they trust and maintain rules that decide whether a new submission is
+-- approved straight away, queued for review or flagged to the admins. Rules are tried by priority and
+-- the first whose conditions all hold decides; policy_decision records which rule fired and the facts
+-- it saw
+
+-- ============================================================================
+-- ORGANIZATION VERIFICATION
+-- ============================================================================
+
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS verified_at TIMESTAMP;
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS verified_by TEXT;
+
+COMMENT ON COLUMN organizations.verified_at IS 'When an admin verified the organization; NULL when unverified';
+COMMENT ON COLUMN organizations.verified_by IS 'Clerk user ID of the admin who verified the organization';
+
+-- ============================================================================
+-- POLICY RULES
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS league_policy_rules (
+  id BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL,
+  priority INT NOT NULL DEFAULT 100,            -- Lower priorities are tried first
+  outcome TEXT NOT NULL,
+  conditions JSONB NOT NULL DEFAULT '{}',       -- Checks that must all hold; {} matches every submission
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  created_by TEXT,                              -- Clerk user ID of the admin who added the rule
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT league_policy_rules_outcome_check CHECK (outcome IN ('auto_approve', 'queue', 'flag'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_league_policy_rules_priority ON league_policy_rules(priority, id);
+
+COMMENT ON TABLE league_policy_rules IS 'Admin-managed rules deciding whether a league submission skips review';
+
+-- ============================================================================
+-- RLS POLICIES
+-- ============================================================================
+
+ALTER TABLE league_policy_rules ENABLE ROW LEVEL SECURITY;
+
+-- ALL: Admins only; submissions are evaluated with the service role
+CREATE POLICY "Admins manage league policy rules"
+ON league_policy_rules FOR ALL
+USING (((SELECT auth.jwt()))->>'appRole' = 'admin')
+WITH CHECK (((SELECT auth.jwt()))->>'appRole' = 'admin');
+
+-- ============================================================================
+-- DECISIONS
+-- ============================================================================
+
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS policy_decision JSONB;
+
+COMMENT ON COLUMN leagues.policy_decision IS 'Outcome, rule and signals of the auto-approval policy when the league was submitted';